@@ -0,0 +1,9 @@
+# Decodes the result of a getValidator call made with polygonedge_call
+data "polygonedge_abi_decode" "validator" {
+  data  = data.polygonedge_call.get_validator.result
+  types = ["uint256", "uint256", "uint256", "uint256", "uint256", "uint256", "uint256", "uint256", "bool"]
+}
+
+output "validator_stake" {
+  value = data.polygonedge_abi_decode.validator.values[4]
+}
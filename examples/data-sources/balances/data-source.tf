@@ -0,0 +1,9 @@
+data "polygonedge_balances" "premine" {
+  rpc_url     = "http://localhost:8545"
+  addresses   = ["0x85da99c8a7c2c95964c8efd687e95e632fc533d6", "0x228466f2c715cbec05deabfac040ce3619d7cf0b"]
+  concurrency = 4
+}
+
+output "premine_total" {
+  value = data.polygonedge_balances.premine.total
+}
@@ -0,0 +1,4 @@
+# Imports a BLS secret key kept as plain hex in a vault
+data "polygonedge_bls_from_hex" "validator" {
+  bls_key_hex = var.validator_bls_key_hex
+}
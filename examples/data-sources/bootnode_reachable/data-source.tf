@@ -0,0 +1,14 @@
+# Checks every bootnode answers before they are written into the genesis
+data "polygonedge_bootnode_reachable" "bootnode" {
+  for_each = toset(var.bootnodes)
+
+  multiaddr = each.value
+  timeout   = "5s"
+
+  lifecycle {
+    postcondition {
+      condition     = self.reachable
+      error_message = "The bootnode ${each.value} is unreachable."
+    }
+  }
+}
@@ -0,0 +1,25 @@
+resource "polygonedge_secrets" "bootnode" {}
+
+# The bootnode is reachable on its public and private interfaces
+data "polygonedge_bootnodes" "bootnode" {
+  node_id   = polygonedge_secrets.bootnode.node_id
+  addresses = ["203.0.113.10", "2001:db8::10", "bootnode-1.example.com"]
+}
+
+output "bootnodes" {
+  value = data.polygonedge_bootnodes.bootnode.multiaddrs
+}
+
+resource "polygonedge_secrets" "validator" {}
+
+# The validator listens on a private address behind a NAT gateway forwarding port 31478
+data "polygonedge_bootnodes" "validator" {
+  node_id           = polygonedge_secrets.validator.node_id
+  listen_address    = "10.0.1.5"
+  advertise_address = "203.0.113.20"
+  advertise_port    = 31478
+}
+
+output "validator_bootnode" {
+  value = data.polygonedge_bootnodes.validator.advertise_multiaddr
+}
@@ -0,0 +1,11 @@
+# Reads the total supply of a token
+data "polygonedge_call" "total_supply" {
+  rpc_url      = "http://127.0.0.1:8545"
+  to           = var.token_address
+  data         = provider::polygonedge::method_selector("totalSupply()")
+  output_types = ["uint256"]
+}
+
+output "total_supply" {
+  value = data.polygonedge_call.total_supply.decoded[0]
+}
@@ -0,0 +1,8 @@
+# Derives the chain id of a preview network from its name
+data "polygonedge_chain_id_from_name" "preview" {
+  name = "preview-${var.branch}"
+}
+
+output "chain_id" {
+  value = data.polygonedge_chain_id_from_name.preview.chain_id
+}
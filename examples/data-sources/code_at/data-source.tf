@@ -0,0 +1,12 @@
+# Checks that the genesis predeploy holds the expected contract
+data "polygonedge_code_at" "predeploy" {
+  rpc_url = "http://127.0.0.1:8545"
+  address = var.predeploy_address
+}
+
+check "predeploy_code" {
+  assert {
+    condition     = data.polygonedge_code_at.predeploy.code_hash == var.expected_code_hash
+    error_message = "The predeploy does not hold the expected contract."
+  }
+}
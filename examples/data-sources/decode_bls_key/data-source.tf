@@ -0,0 +1,4 @@
+# Checks a BLS key fetched from storage before registering the validator
+data "polygonedge_decode_bls_key" "validator" {
+  validator_bls_key_encoded = var.validator_bls_key
+}
@@ -0,0 +1,4 @@
+# Audits a network key pulled from the secrets manager
+data "polygonedge_decode_network_key" "node" {
+  network_key_encoded = var.network_key
+}
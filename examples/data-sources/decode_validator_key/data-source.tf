@@ -0,0 +1,13 @@
+# Checks that a stored validator key belongs to the expected address
+data "polygonedge_decode_validator_key" "validator" {
+  validator_key_encoded = var.validator_key
+}
+
+output "validator_address" {
+  value = data.polygonedge_decode_validator_key.validator.address
+
+  precondition {
+    condition     = lower(data.polygonedge_decode_validator_key.validator.address) == lower(var.expected_address)
+    error_message = "The validator key does not belong to the expected address."
+  }
+}
@@ -0,0 +1,10 @@
+# Reads the stake a delegator has delegated to a PolyBFT validator
+data "polygonedge_delegation" "treasury" {
+  rpc_url   = "http://127.0.0.1:8545"
+  validator = polygonedge_secrets.validator_1.address
+  delegator = var.treasury_address
+}
+
+output "delegated_ether" {
+  value = data.polygonedge_delegation.treasury.amount_ether
+}
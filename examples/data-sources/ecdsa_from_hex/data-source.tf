@@ -0,0 +1,4 @@
+# Imports a validator private key kept as plain hex in a vault
+data "polygonedge_ecdsa_from_hex" "validator" {
+  validator_key_hex = var.validator_key_hex
+}
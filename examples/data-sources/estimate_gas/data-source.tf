@@ -0,0 +1,7 @@
+# Estimates the gas of a token transfer before sending it
+data "polygonedge_estimate_gas" "transfer" {
+  rpc_url = "http://127.0.0.1:8545"
+  from    = polygonedge_secrets.validator.address
+  to      = var.token_address
+  data    = var.transfer_calldata
+}
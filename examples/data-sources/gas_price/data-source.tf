@@ -0,0 +1,11 @@
+# Prices a contract deployment from the current network conditions
+data "polygonedge_gas_price" "current" {
+  rpc_url = "http://127.0.0.1:8545"
+}
+
+resource "polygonedge_contract" "token" {
+  rpc_url              = "http://127.0.0.1:8545"
+  deployer_key_encoded = var.deployer_key_encoded
+  bytecode             = var.token_bytecode
+  gas_price            = data.polygonedge_gas_price.current.gas_price
+}
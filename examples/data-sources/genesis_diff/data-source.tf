@@ -0,0 +1,9 @@
+# Reviews the changes between the generated genesis and the deployed one
+data "polygonedge_genesis_diff" "review" {
+  old_path    = "${path.module}/deployed/genesis.json"
+  new_content = var.generated_genesis
+}
+
+output "genesis_changes" {
+  value = data.polygonedge_genesis_diff.review.changed ? jsondecode(data.polygonedge_genesis_diff.review.json) : null
+}
@@ -0,0 +1,14 @@
+# Finds the registration of a PolyBFT validator on the child validator set contract
+data "polygonedge_logs" "registered" {
+  rpc_url    = "http://127.0.0.1:8545"
+  address    = "0x0000000000000000000000000000000000000101"
+  event      = "NewValidator(address indexed validator, uint256[4] blsKey)"
+  topics     = [null, provider::polygonedge::hex_pad(polygonedge_secrets.validator_1.address, 32, false)]
+  from_block = "earliest"
+
+  depends_on = [polygonedge_validator_registration.validator_1]
+}
+
+output "validator_registered" {
+  value = length(data.polygonedge_logs.registered.logs) > 0
+}
@@ -0,0 +1,10 @@
+data "polygonedge_net_version" "node" {
+  rpc_url = "http://127.0.0.1:8545"
+
+  lifecycle {
+    postcondition {
+      condition     = self.version == 100
+      error_message = "The node is not on network 100."
+    }
+  }
+}
@@ -0,0 +1,5 @@
+# Waits until the chain produces a block
+data "polygonedge_new_heads" "head" {
+  ws_url  = "ws://127.0.0.1:8545/ws"
+  timeout = "1m"
+}
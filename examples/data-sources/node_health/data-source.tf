@@ -0,0 +1,20 @@
+# Funds the validators only through a healthy node
+data "polygonedge_node_health" "node" {
+  rpc_url       = "http://127.0.0.1:8545"
+  min_peers     = 3
+  max_block_age = "30s"
+}
+
+resource "polygonedge_fund" "validators" {
+  rpc_url            = "http://127.0.0.1:8545"
+  funder_key_encoded = var.funder_key_encoded
+  amount             = "1000000000000000000"
+  recipients         = var.validator_addresses
+
+  lifecycle {
+    precondition {
+      condition     = data.polygonedge_node_health.node.healthy
+      error_message = "The node is unhealthy, failed checks: ${join(", ", data.polygonedge_node_health.node.failed_checks)}."
+    }
+  }
+}
@@ -0,0 +1,22 @@
+# Inventory existing nodes from their public identifiers, without access to their secrets
+variable "node_rpc_urls" {
+  type    = map(string)
+  default = {
+    validator-1 = "http://10.0.0.11:8545"
+    validator-2 = "http://10.0.0.12:8545"
+  }
+}
+
+data "polygonedge_node_identity" "node" {
+  for_each = var.node_rpc_urls
+  rpc_url  = each.value
+}
+
+output "inventory" {
+  value = {
+    for name, node in data.polygonedge_node_identity.node : name => {
+      node_id           = node.node_id
+      validator_address = node.validator_address
+    }
+  }
+}
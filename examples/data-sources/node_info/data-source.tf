@@ -0,0 +1,7 @@
+data "polygonedge_node_info" "bootnode" {
+  rpc_url = "http://10.0.0.10:8545"
+}
+
+output "bootnode_id" {
+  value = data.polygonedge_node_info.bootnode.node_id
+}
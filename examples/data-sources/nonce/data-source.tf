@@ -0,0 +1,6 @@
+# Reads the nonce of the next transaction of the deployer
+data "polygonedge_nonce" "deployer" {
+  rpc_url = "http://127.0.0.1:8545"
+  address = polygonedge_secrets.deployer.address
+  block   = "pending"
+}
@@ -0,0 +1,4 @@
+# Reads the keys of a validator from a JSON secrets file
+data "polygonedge_parse_secrets" "validator" {
+  path = "${path.module}/secrets/validator-1.json"
+}
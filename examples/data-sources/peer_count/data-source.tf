@@ -0,0 +1,10 @@
+data "polygonedge_peer_count" "node" {
+  rpc_url = "http://127.0.0.1:8545"
+
+  lifecycle {
+    postcondition {
+      condition     = self.peer_count > 0
+      error_message = "The node is not connected to any peer."
+    }
+  }
+}
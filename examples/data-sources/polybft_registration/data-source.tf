@@ -0,0 +1,6 @@
+# Computes the calldata to register a PolyBFT validator
+data "polygonedge_polybft_registration" "validator" {
+  validator_key_encoded = var.validator_key
+  bls_key_encoded       = var.validator_bls_key
+  chain_id              = 100
+}
@@ -0,0 +1,22 @@
+# Hashes the expected PolyBFT validator set, each validator staking 1000 tokens
+data "polygonedge_polybft_validator_set_hash" "expected" {
+  validators = [
+    for secrets in polygonedge_secrets.validators : {
+      address    = secrets.address
+      bls_pubkey = secrets.polybft_registration.bls_pubkey
+      stake      = "1000000000000000000000"
+    }
+  ]
+}
+
+# Reads the initial validator set of the deployed genesis
+data "polygonedge_polybft_validator_set_hash" "deployed" {
+  genesis_path = "${path.module}/deployed/genesis.json"
+}
+
+check "genesis_validators" {
+  assert {
+    condition     = data.polygonedge_polybft_validator_set_hash.deployed.hash == data.polygonedge_polybft_validator_set_hash.expected.hash
+    error_message = "The deployed genesis validator set differs from the expected one."
+  }
+}
@@ -0,0 +1,13 @@
+variable "validator_seed" {
+  type      = string
+  sensitive = true
+}
+
+# Derives the same secrets on every plan from the seed.
+data "polygonedge_secrets" "validator" {
+  seed = var.validator_seed
+}
+
+output "validator_address" {
+  value = data.polygonedge_secrets.validator.address
+}
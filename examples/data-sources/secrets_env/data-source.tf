@@ -0,0 +1,6 @@
+# Reads the validator keys injected by the CI pipeline
+data "polygonedge_secrets_env" "validator" {
+  validator_key_env     = "VALIDATOR_KEY"
+  validator_bls_key_env = "VALIDATOR_BLS_KEY"
+  network_key_env       = "VALIDATOR_NETWORK_KEY"
+}
@@ -0,0 +1,12 @@
+resource "polygonedge_secrets" "validator_1" {}
+
+# Compares the identifiers with the output of `polygon-edge secrets output` captured on the node
+data "polygonedge_secrets_output" "validator_1" {
+  address    = polygonedge_secrets.validator_1.address
+  bls_pubkey = polygonedge_secrets.validator_1.bls_pubkey
+  node_id    = polygonedge_secrets.validator_1.node_id
+}
+
+output "matches_node" {
+  value = data.polygonedge_secrets_output.validator_1.output == file("${path.module}/validator-1-secrets-output.txt")
+}
@@ -0,0 +1,13 @@
+# Renders the config file of a validator node
+data "polygonedge_server_config" "validator" {
+  data_dir        = "/var/lib/polygon-edge"
+  genesis_path    = "/etc/polygon-edge/genesis.json"
+  libp2p_addr     = "0.0.0.0:1478"
+  prometheus_addr = "0.0.0.0:5001"
+  log_level       = "DEBUG"
+}
+
+resource "local_file" "config" {
+  filename = "config.yaml"
+  content  = data.polygonedge_server_config.validator.yaml
+}
@@ -0,0 +1,8 @@
+# Reads the validator set of an IBFT proof of stake chain
+data "polygonedge_staking_validators" "current" {
+  rpc_url = "http://127.0.0.1:8545"
+}
+
+output "validator_count" {
+  value = length(data.polygonedge_staking_validators.current.validators)
+}
@@ -0,0 +1,6 @@
+# Reads the first storage slot of a predeployed contract
+data "polygonedge_storage_at" "owner" {
+  rpc_url = "http://127.0.0.1:8545"
+  address = var.predeploy_address
+  slot    = "0"
+}
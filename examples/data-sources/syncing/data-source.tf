@@ -0,0 +1,17 @@
+# Deploys the contract only once the node is fully synced
+data "polygonedge_syncing" "node" {
+  rpc_url = "http://127.0.0.1:8545"
+}
+
+resource "polygonedge_contract" "token" {
+  rpc_url              = "http://127.0.0.1:8545"
+  deployer_key_encoded = var.deployer_key_encoded
+  bytecode             = var.token_bytecode
+
+  lifecycle {
+    precondition {
+      condition     = !data.polygonedge_syncing.node.syncing
+      error_message = "The node is still syncing."
+    }
+  }
+}
@@ -0,0 +1,20 @@
+# Audits a validator key against the address recorded in the inventory
+data "polygonedge_validate_keypair" "validator" {
+  type                = "ecdsa"
+  private_key_encoded = var.validator_key
+  address             = var.validator_address
+
+  lifecycle {
+    postcondition {
+      condition     = self.valid
+      error_message = "The validator key does not match the recorded address."
+    }
+  }
+}
+
+# Audits a validator BLS key against its recorded public key
+data "polygonedge_validate_keypair" "validator_bls" {
+  type                = "bls"
+  private_key_encoded = var.validator_bls_key
+  public_key          = var.validator_bls_pubkey
+}
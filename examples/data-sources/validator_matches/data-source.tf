@@ -0,0 +1,17 @@
+resource "polygonedge_secrets" "validator" {
+  polybft_chain_id = 100
+}
+
+# Reconciles the validator registered on chain with its local secrets, for example after a key rotation
+data "polygonedge_validator_matches" "validator" {
+  rpc_url    = "http://10.0.0.10:8545"
+  address    = polygonedge_secrets.validator.address
+  bls_pubkey = polygonedge_secrets.validator.polybft_registration.bls_pubkey
+}
+
+check "validator_registered_with_local_keys" {
+  assert {
+    condition     = data.polygonedge_validator_matches.validator.matches
+    error_message = "The validator registered on chain does not match the local secrets."
+  }
+}
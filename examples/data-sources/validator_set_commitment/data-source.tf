@@ -0,0 +1,21 @@
+# Computes the commitment of the expected validator set
+data "polygonedge_validator_set_commitment" "expected" {
+  validators = [
+    for secrets in polygonedge_secrets.validators : {
+      address    = secrets.address
+      bls_pubkey = secrets.bls_pubkey
+    }
+  ]
+}
+
+# Decodes the validator set of the deployed genesis
+data "polygonedge_validator_set_commitment" "deployed" {
+  genesis_path = "${path.module}/deployed/genesis.json"
+}
+
+check "genesis_validators" {
+  assert {
+    condition     = data.polygonedge_validator_set_commitment.deployed.commitment == data.polygonedge_validator_set_commitment.expected.commitment
+    error_message = "The deployed genesis validator set differs from the expected one."
+  }
+}
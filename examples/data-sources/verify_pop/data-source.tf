@@ -0,0 +1,11 @@
+# Checks registration data handed over by a validator operator before it is submitted
+data "polygonedge_verify_pop" "validator_1" {
+  bls_pubkey = var.validator_bls_pubkey
+  signature  = var.validator_pop
+  address    = var.validator_address
+  chain_id   = 100
+}
+
+output "validator_1_pop_valid" {
+  value = data.polygonedge_verify_pop.validator_1.valid
+}
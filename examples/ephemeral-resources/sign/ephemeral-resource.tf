@@ -0,0 +1,5 @@
+# Signs an enrollment challenge without storing the signature in state
+ephemeral "polygonedge_sign" "enrollment" {
+  validator_key_encoded = var.validator_key
+  message               = "enroll:${var.challenge}"
+}
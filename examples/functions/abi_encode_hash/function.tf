@@ -0,0 +1,7 @@
+# Computes the digest of an allowlist entry, as keccak256(abi.encode(account, amount)) does on-chain
+locals {
+  allowlist_digest = provider::polygonedge::abi_encode_hash([
+    { type = "address", value = var.account },
+    { type = "uint256", value = "1000000000000000000" },
+  ])
+}
@@ -0,0 +1,11 @@
+# Premines the first accounts of an HD wallet, without storing their keys
+variable "premine_mnemonic" {
+  type      = string
+  sensitive = true
+}
+
+locals {
+  premine_addresses = [
+    for i in range(3) : nonsensitive(provider::polygonedge::address_from_mnemonic(var.premine_mnemonic, "m/44'/60'/0'/0/${i}"))
+  ]
+}
@@ -0,0 +1,4 @@
+# Checks an operator supplied address against their public key before funding it
+locals {
+  operator_address_ok = provider::polygonedge::address_matches_pubkey(var.operator_address, var.operator_public_key)
+}
@@ -0,0 +1,9 @@
+# Checks the owner read from a contract against the configured one, whatever their casing
+resource "terraform_data" "owner" {
+  lifecycle {
+    precondition {
+      condition     = provider::polygonedge::addresses_equal(data.polygonedge_call.owner.decoded[0], var.owner_address)
+      error_message = "The contract is owned by another account."
+    }
+  }
+}
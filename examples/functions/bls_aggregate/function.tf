@@ -0,0 +1,4 @@
+# Aggregates the BLS signatures of several validators over the same attestation
+locals {
+  attestation_signature = provider::polygonedge::bls_aggregate(var.validator_signatures)
+}
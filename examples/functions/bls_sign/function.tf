@@ -0,0 +1,4 @@
+# Signs an attestation with the validator BLS key
+locals {
+  attestation_signature = provider::polygonedge::bls_sign(var.attestation, polygon_edge_secrets.secrets.validator_bls_key_encoded)
+}
@@ -0,0 +1,4 @@
+# Verifies a validator attestation before it is published
+locals {
+  attestation_ok = provider::polygonedge::bls_verify(polygon_edge_secrets.secrets.bls_pubkey, var.attestation, var.attestation_signature)
+}
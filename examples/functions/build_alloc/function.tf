@@ -0,0 +1,7 @@
+# Premines 1000 ether to every account of a module output and a fixed amount of wei to a faucet
+locals {
+  alloc = provider::polygonedge::build_alloc(
+    concat(module.accounts.addresses, [var.faucet_address]),
+    concat([for address in module.accounts.addresses : "1000 ether"], ["1000000000000000000000000"]),
+  )
+}
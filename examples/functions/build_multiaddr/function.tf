@@ -0,0 +1,5 @@
+# Builds the bootnode multiaddr of a node, and its listen address without peer ID
+locals {
+  bootnode    = provider::polygonedge::build_multiaddr("dns", "bootnode-1.example.com", 1478, polygonedge_secrets.bootnode.node_id)
+  libp2p_addr = provider::polygonedge::build_multiaddr("ip4", "0.0.0.0", 1478, null)
+}
@@ -0,0 +1,4 @@
+# Converts a gas price given in gwei to the wei amount the resources expect
+locals {
+  gas_price_wei = provider::polygonedge::convert_units(var.gas_price_gwei, "gwei", "wei")
+}
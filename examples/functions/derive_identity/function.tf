@@ -0,0 +1,8 @@
+# Plans the identities of the validators of a genesis before their secrets exist
+locals {
+  validators = [for i in range(4) : provider::polygonedge::derive_identity("${var.validators_seed}/${i}")]
+}
+
+output "validator_addresses" {
+  value = local.validators[*].address
+}
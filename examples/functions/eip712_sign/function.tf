@@ -0,0 +1,34 @@
+# Signs an EIP-2612 permit, letting a spender move tokens without an approval transaction
+locals {
+  permit_signature = provider::polygonedge::eip712_sign(jsonencode({
+    types = {
+      EIP712Domain = [
+        { name = "name", type = "string" },
+        { name = "version", type = "string" },
+        { name = "chainId", type = "uint256" },
+        { name = "verifyingContract", type = "address" },
+      ]
+      Permit = [
+        { name = "owner", type = "address" },
+        { name = "spender", type = "address" },
+        { name = "value", type = "uint256" },
+        { name = "nonce", type = "uint256" },
+        { name = "deadline", type = "uint256" },
+      ]
+    }
+    primaryType = "Permit"
+    domain = {
+      name              = "Token"
+      version           = "1"
+      chainId           = 100
+      verifyingContract = var.token_address
+    }
+    message = {
+      owner    = polygonedge_secrets.owner.address
+      spender  = var.spender_address
+      value    = "1000000000000000000"
+      nonce    = 0
+      deadline = 4102444800
+    }
+  }), polygonedge_secrets.owner.validator_key_encoded)
+}
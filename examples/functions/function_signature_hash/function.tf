@@ -0,0 +1,4 @@
+# Computes the topic of ERC20 Transfer events, to filter logs by it
+locals {
+  transfer_topic = provider::polygonedge::function_signature_hash("Transfer(address,address,uint256)")
+}
@@ -0,0 +1,4 @@
+# Decodes a hex encoded label read from a contract back to a string
+locals {
+  label = provider::polygonedge::hex_decode(var.label_hex)
+}
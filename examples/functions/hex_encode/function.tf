@@ -0,0 +1,4 @@
+# Encodes a node name as 0x prefixed hex, to pass it as bytes calldata
+locals {
+  node_name_hex = provider::polygonedge::hex_encode(var.node_name, true)
+}
@@ -0,0 +1,4 @@
+# Builds the indexed address topic of a Transfer event log filter
+locals {
+  recipient_topic = provider::polygonedge::hex_pad(var.recipient, 32, false)
+}
@@ -0,0 +1,4 @@
+# Reassembles a signature a relayer stores as separate components
+locals {
+  relayed_signature = provider::polygonedge::join_signature(var.relayed_r, var.relayed_s, var.relayed_v, 27)
+}
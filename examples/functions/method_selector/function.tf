@@ -0,0 +1,7 @@
+# Builds the calldata of an ERC20 transfer
+locals {
+  transfer_calldata = join("", [
+    provider::polygonedge::method_selector("transfer(address,uint256)"),
+    trimprefix(var.encoded_transfer_arguments, "0x"),
+  ])
+}
@@ -0,0 +1,5 @@
+# Recovers the key which signed a message, and checks it belongs to the expected signer
+locals {
+  signer_pubkey = provider::polygonedge::recover_pubkey(var.message_hash, var.signature, true)
+  signer_ok     = provider::polygonedge::address_matches_pubkey(var.signer_address, local.signer_pubkey)
+}
@@ -0,0 +1,8 @@
+# Passes the components of a signature to a contract call which verifies it with ecrecover
+locals {
+  permit_signature = provider::polygonedge::split_signature(var.permit_signature, 27)
+}
+
+output "permit_v" {
+  value = local.permit_signature.v
+}
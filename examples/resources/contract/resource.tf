@@ -0,0 +1,11 @@
+# Deploys a staking manager predeploy on a fresh chain
+resource "polygonedge_contract" "staking_manager" {
+  rpc_url              = "http://127.0.0.1:8545"
+  deployer_key_encoded = var.deployer_key_encoded
+  bytecode             = var.staking_manager_bytecode
+  constructor_args     = var.staking_manager_constructor_args
+
+  confirmation = {
+    confirmations = 2
+  }
+}
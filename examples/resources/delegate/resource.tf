@@ -0,0 +1,7 @@
+# Delegates 10 tokens to a PolyBFT validator
+resource "polygonedge_delegate" "validator_1" {
+  rpc_url               = "http://127.0.0.1:8545"
+  delegator_key_encoded = var.delegator_key
+  validator             = polygonedge_secrets.validator_1.address
+  amount                = "10000000000000000000"
+}
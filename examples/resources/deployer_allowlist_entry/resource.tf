@@ -0,0 +1,6 @@
+# Allows the CI deployer account to deploy contracts on a chain with a deployer allow list
+resource "polygonedge_deployer_allowlist_entry" "ci" {
+  rpc_url           = "http://127.0.0.1:8545"
+  admin_key_encoded = var.allowlist_admin_key
+  address           = "0x85da99c8a7c2c95964c8efd687e95e632fc533d6"
+}
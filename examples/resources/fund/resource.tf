@@ -0,0 +1,11 @@
+# Funds freshly generated validators on a test network
+resource "polygonedge_fund" "validators" {
+  rpc_url            = "http://127.0.0.1:8545"
+  funder_key_encoded = var.funder_key_encoded
+  amount             = "1000000000000000000"
+
+  recipients = [
+    { address = polygonedge_secrets.validator_1.address },
+    { address = polygonedge_secrets.validator_2.address, amount = "5000000000000000000" },
+  ]
+}
@@ -1,2 +1,40 @@
 # Generates polygon edge secrets
 resource "polygon_edge_secrets" "secrets" {}
+
+# Derives identifiers from an existing validator key without storing it in state
+resource "polygon_edge_secrets" "imported" {
+  validator_key_encoded_wo = var.validator_key
+  keys_wo_version          = 1
+}
+
+# Rotates the BLS key of a validator, bump the trigger to rotate it again
+resource "polygon_edge_secrets" "rotated" {
+  rotate_bls_key = 1
+}
+
+# Generates a validator key whose address starts with 0xed6e
+resource "polygon_edge_secrets" "vanity" {
+  address_prefix = "0xed6e"
+}
+
+# Stores the generated keys base64 encoded, for secrets managers which expect base64
+resource "polygon_edge_secrets" "base64" {
+  key_encoding = "base64"
+}
+
+# Generates a PolyBFT BLS key as well, with everything needed to register the validator on chain 100
+resource "polygon_edge_secrets" "polybft" {
+  polybft_chain_id = 100
+}
+
+locals {
+  # The polygon-edge encoded validator key, as the other resources and data sources expect it
+  validator_key = base64decode(polygon_edge_secrets.base64.validator_key_encoded)
+}
+
+# Writes the validator key as an unencrypted keyfile, for `geth account import`
+resource "local_sensitive_file" "validator_keyfile" {
+  filename        = "${path.module}/validator.key"
+  content         = polygon_edge_secrets.secrets.validator_keyfile
+  file_permission = "0600"
+}
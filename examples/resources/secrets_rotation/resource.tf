@@ -0,0 +1,13 @@
+# Rotates the validator secrets whenever the rotation epoch is bumped
+resource "polygonedge_secrets_rotation" "validator" {
+  triggers = {
+    epoch = var.rotation_epoch
+  }
+}
+
+output "validator_swap" {
+  value = {
+    current  = polygonedge_secrets_rotation.validator.address
+    previous = try(polygonedge_secrets_rotation.validator.previous.address, null)
+  }
+}
@@ -0,0 +1,8 @@
+# Takes 10 percent of the rewards of the delegators of a registered PolyBFT validator
+resource "polygonedge_validator_commission" "validator_1" {
+  rpc_url               = "http://127.0.0.1:8545"
+  validator_key_encoded = var.validator_key
+  commission            = 10
+
+  depends_on = [polygonedge_validator_registration.validator_1]
+}
@@ -0,0 +1,7 @@
+# Registers a whitelisted PolyBFT validator, stake can be delegated later
+resource "polygonedge_validator_registration" "validator_1" {
+  rpc_url               = "http://127.0.0.1:8545"
+  validator_key_encoded = var.validator_key
+  bls_key_encoded       = var.validator_bls_key
+  chain_id              = 100
+}
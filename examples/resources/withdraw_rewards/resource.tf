@@ -0,0 +1,10 @@
+# Withdraws the rewards of a PolyBFT validator to a treasury account
+resource "polygonedge_withdraw_rewards" "validator_1" {
+  rpc_url               = "http://127.0.0.1:8545"
+  validator_key_encoded = var.validator_key
+  to                    = var.treasury_address
+
+  confirmation = {
+    timeout = "5m"
+  }
+}
@@ -0,0 +1,101 @@
+package allowlist
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	edgeallowlist "github.com/0xPolygon/polygon-edge/state/runtime/allowlist"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo/abi"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// Roles of an account on an allow list, by the name used in the configuration.
+const (
+	roleNone    = "none"
+	roleEnabled = "enabled"
+	roleAdmin   = "admin"
+)
+
+// roleNames are the names of the roles, by the value the allow list stores for them.
+var roleNames = map[uint64]string{
+	edgeallowlist.NoRole.Uint64():      roleNone,
+	edgeallowlist.EnabledRole.Uint64(): roleEnabled,
+	edgeallowlist.AdminRole.Uint64():   roleAdmin,
+}
+
+// roleSetters are the allow list methods giving an account a role, by role name.
+var roleSetters = map[string]*abi.Method{
+	roleNone:    edgeallowlist.SetNoneFunc,
+	roleEnabled: edgeallowlist.SetEnabledSignatureFunc,
+	roleAdmin:   edgeallowlist.SetAdminFunc,
+}
+
+// readRole returns the name of the role the account has on the contract deployer allow list.
+func readRole(ctx context.Context, client *rpc.Client, account edgetypes.Address) (string, error) {
+	input, err := edgeallowlist.ReadAllowListFunc.Encode([]interface{}{account})
+	if err != nil {
+		return "", fmt.Errorf("unable to encode readAllowList call: %w", err)
+	}
+
+	to := contracts.AllowListContractsAddr
+	result, err := client.CallContract(ctx, rpc.CallMsg{To: &to, Data: input}, "")
+	if reason, reverted := rpc.RevertReason(err); reverted {
+		return "", fmt.Errorf("readAllowList call reverts: %s", reason)
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(result) == 0 {
+		return "", fmt.Errorf("readAllowList call returned no data, check that the genesis configures a contract deployer allow list")
+	}
+
+	role := new(big.Int).SetBytes(result)
+	name, ok := roleNames[role.Uint64()]
+	if !role.IsUint64() || !ok {
+		return "", fmt.Errorf("unknown allow list role %s", role)
+	}
+	return name, nil
+}
+
+// setRole sends a transaction from the admin account giving the account the role, and returns its hash without
+// waiting for it to be included. The gas limit is estimated by the endpoint and the gas price defaults to the suggested one.
+func setRole(ctx context.Context, client *rpc.Client, admin *ecdsa.PrivateKey, chainID uint64, gasPrice *big.Int, account edgetypes.Address, role string) (edgetypes.Hash, error) {
+	input, err := roleSetters[role].Encode([]interface{}{account})
+	if err != nil {
+		return edgetypes.ZeroHash, fmt.Errorf("unable to encode %s call: %w", roleSetters[role].Name, err)
+	}
+
+	if gasPrice == nil {
+		if gasPrice, err = client.GasPrice(ctx); err != nil {
+			return edgetypes.ZeroHash, fmt.Errorf("unable to get gas price: %w", err)
+		}
+	}
+	from := crypto.PubKeyToAddress(&admin.PublicKey)
+	to := contracts.AllowListContractsAddr
+	gas, err := client.EstimateGas(ctx, rpc.CallMsg{From: &from, To: &to, Data: input})
+	if reason, reverted := rpc.RevertReason(err); reverted {
+		return edgetypes.ZeroHash, fmt.Errorf("call reverts: %s", reason)
+	}
+	if err != nil {
+		return edgetypes.ZeroHash, fmt.Errorf("unable to estimate gas: %w", err)
+	}
+	nonce, err := client.PendingNonce(ctx, from)
+	if err != nil {
+		return edgetypes.ZeroHash, fmt.Errorf("unable to get nonce: %w", err)
+	}
+
+	return client.SignAndSend(ctx, admin, chainID, &edgetypes.Transaction{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      gas,
+		To:       &to,
+		Value:    big.NewInt(0),
+		Input:    input,
+	})
+}
@@ -0,0 +1,320 @@
+package allowlist
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &deployerAllowlistEntryResource{}
+	_ resource.ResourceWithConfigure      = &deployerAllowlistEntryResource{}
+	_ resource.ResourceWithValidateConfig = &deployerAllowlistEntryResource{}
+)
+
+// deployerAllowlistEntryResourceModel maps the resource schema data.
+type deployerAllowlistEntryResourceModel struct {
+	RPCURL          types.String `tfsdk:"rpc_url"`
+	AdminKeyEncoded types.String `tfsdk:"admin_key_encoded"`
+	Address         types.String `tfsdk:"address"`
+	Role            types.String `tfsdk:"role"`
+	GasPrice        types.String `tfsdk:"gas_price"`
+
+	ChainID          types.Int64 `tfsdk:"chain_id"`
+	SkipChainIDCheck types.Bool  `tfsdk:"skip_chain_id_check"`
+
+	Confirmation *rpc.ConfirmationModel `tfsdk:"confirmation"`
+
+	TxHash types.String `tfsdk:"tx_hash"`
+}
+
+// NewDeployerAllowlistEntryResource is a helper function to simplify the provider implementation.
+func NewDeployerAllowlistEntryResource() resource.Resource {
+	return &deployerAllowlistEntryResource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// deployerAllowlistEntryResource is the resource implementation.
+type deployerAllowlistEntryResource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the resource type name.
+func (r *deployerAllowlistEntryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployer_allowlist_entry"
+}
+
+// Schema defines the schema for the resource.
+func (r *deployerAllowlistEntryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Adds an account to the contract deployer allow list of a running chain, the runtime complement of the " +
+			"allow list configured in the genesis. The role is set with a transaction to the allow list precompile, sent from " +
+			"an account which must be an admin of the list. Destroying this resource removes the account from the list. " +
+			"The resource is recreated if the account loses its role outside of Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node the transactions are sent to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"admin_key_encoded": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Description: "ECDSA private key of an admin of the allow list, encoded like a polygon-edge validator key. " +
+					"The transactions are sent from its account. It is kept in state to remove the account on destroy.",
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "Address of the account allowed to deploy contracts.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Optional: true,
+				Description: "Role given to the account, `" + roleEnabled + "` to deploy contracts or `" + roleAdmin + "` to deploy contracts " +
+					"and change the roles of other accounts. Defaults to `" + roleEnabled + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = entryRole(req.StateValue) != entryRole(req.PlanValue)
+						},
+						"Changing the role requires replacement.",
+						"Changing the role requires replacement.",
+					),
+				},
+			},
+			"gas_price": schema.StringAttribute{
+				Optional:    true,
+				Description: "Gas price in wei. Defaults to the gas price suggested by the endpoint.",
+				Validators: []validator.String{
+					validators.Wei(),
+				},
+			},
+			"chain_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "Expected chain id of the endpoint, the transactions are not sent if it serves another chain. " +
+					"Defaults to the provider `chain_id`.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"skip_chain_id_check": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip comparing the endpoint chain id with `chain_id`.",
+			},
+			"confirmation": rpc.ConfirmationSchema(),
+			"tx_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the transaction setting the role, null if the account already had it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the resource.
+func (r *deployerAllowlistEntryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = data
+}
+
+// ValidateConfig ensures the role is supported.
+func (r *deployerAllowlistEntryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config deployerAllowlistEntryResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Role.IsUnknown() {
+		return
+	}
+
+	if role := entryRole(config.Role); role != roleEnabled && role != roleAdmin {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role"),
+			"Invalid role",
+			fmt.Sprintf("The role must be %q or %q, got: %q.", roleEnabled, roleAdmin, role),
+		)
+	}
+}
+
+func (r *deployerAllowlistEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan deployerAllowlistEntryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	address := edgetypes.StringToAddress(plan.Address.ValueString())
+	role := entryRole(plan.Role)
+	plan.TxHash = types.StringNull()
+
+	hash, diags := r.setRole(ctx, plan, address, role)
+	resp.Diagnostics.Append(diags...)
+	if hash != nil {
+		// Record the transaction before waiting for it, so that it is kept in state even if it is not confirmed.
+		plan.TxHash = types.StringValue(hash.String())
+	}
+	if hash == nil && resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *deployerAllowlistEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state deployerAllowlistEntryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), r.providerData.RPC)
+	role, err := readRole(ctx, client, edgetypes.StringToAddress(state.Address.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read allow list role", err.Error())
+		return
+	}
+	if role != entryRole(state.Role) {
+		tflog.Debug(ctx, "Allow list role changed outside of Terraform", map[string]interface{}{"address": state.Address.ValueString(), "role": role})
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r *deployerAllowlistEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only settings which do not affect the role can be updated in place.
+	var plan deployerAllowlistEntryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *deployerAllowlistEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state deployerAllowlistEntryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := r.setRole(ctx, state, edgetypes.StringToAddress(state.Address.ValueString()), roleNone)
+	resp.Diagnostics.Append(diags...)
+}
+
+// setRole gives the account the role, unless it already has it, and waits for the transaction to be confirmed.
+// The hash of the transaction is returned once it is sent, even if it is not confirmed.
+func (r *deployerAllowlistEntryResource) setRole(ctx context.Context, model deployerAllowlistEntryResourceModel, address edgetypes.Address, role string) (*edgetypes.Hash, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	confirmation, confirmationDiags := model.Confirmation.Config(path.Root("confirmation"))
+	diags.Append(confirmationDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	adminKey, err := crypto.BytesToECDSAPrivateKey([]byte(model.AdminKeyEncoded.ValueString()))
+	if err != nil {
+		diags.Append(diagnostics.KeyParse(path.Root("admin_key_encoded"), diagnostics.ValidatorKey, err))
+		return nil, diags
+	}
+	admin := crypto.PubKeyToAddress(&adminKey.PublicKey)
+	// The gas price is validated, so parsing cannot fail.
+	gasPrice, _ := new(big.Int).SetString(model.GasPrice.ValueString(), 10)
+
+	client := rpc.NewClient(model.RPCURL.ValueString(), r.providerData.RPC)
+	var expectedChainID uint64
+	if !model.SkipChainIDCheck.ValueBool() {
+		expectedChainID = r.providerData.ChainIDOrDefault(model.ChainID)
+	}
+	chainID, err := client.GuardedChainID(ctx, expectedChainID)
+	if err != nil {
+		diags.AddError("Unable to verify chain id", err.Error())
+		return nil, diags
+	}
+
+	adminRole, err := readRole(ctx, client, admin)
+	if err != nil {
+		diags.AddError("Unable to read allow list role", err.Error())
+		return nil, diags
+	}
+	if adminRole != roleAdmin {
+		diags.AddAttributeError(
+			path.Root("admin_key_encoded"),
+			"Not an allow list admin",
+			fmt.Sprintf("%s has the %q role on the contract deployer allow list, only %q accounts can change roles.", admin, adminRole, roleAdmin),
+		)
+		return nil, diags
+	}
+	current, err := readRole(ctx, client, address)
+	if err != nil {
+		diags.AddError("Unable to read allow list role", err.Error())
+		return nil, diags
+	}
+	if current == role {
+		tflog.Debug(ctx, "Allow list role already set", map[string]interface{}{"address": address.String(), "role": role})
+		return nil, diags
+	}
+
+	tflog.Debug(ctx, "Setting allow list role", map[string]interface{}{"address": address.String(), "role": role})
+	hash, err := setRole(ctx, client, adminKey, chainID, gasPrice, address, role)
+	if err != nil {
+		diags.AddError("Unable to set allow list role", err.Error())
+		return nil, diags
+	}
+
+	receipt, err := client.WaitForConfirmation(ctx, hash, confirmation)
+	if err != nil {
+		diags.AddError("Unable to confirm allow list role", err.Error())
+	} else if !receipt.Succeeded() {
+		diags.AddError("Allow list role not set", fmt.Sprintf("Transaction %s was reverted.", hash))
+	}
+	return &hash, diags
+}
+
+// entryRole returns the configured role, or `enabled` if it is not set.
+func entryRole(value types.String) string {
+	if value.IsNull() || value.IsUnknown() {
+		return roleEnabled
+	}
+	return value.ValueString()
+}
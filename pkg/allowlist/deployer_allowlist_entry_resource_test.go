@@ -0,0 +1,300 @@
+package allowlist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgeallowlist "github.com/0xPolygon/polygon-edge/state/runtime/allowlist"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/umbracle/ethgo"
+)
+
+const (
+	// testAdminKey is an encoded validator key, the first Hardhat development account.
+	testAdminKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	testAdmin    = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	// testDeployer is the account the roles are given to, the second Hardhat development account.
+	testDeployer = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+)
+
+// mockNode is a JSON-RPC endpoint of a chain with a contract deployer allow list, which includes the
+// transactions sent to it at once.
+type mockNode struct {
+	mu    sync.Mutex
+	nonce uint64
+	sent  []*edgetypes.Transaction
+	// roles are the allow list roles of the accounts, the others have none.
+	roles map[edgetypes.Address]edgeallowlist.Role
+}
+
+func newMockNode(t *testing.T) (*mockNode, string) {
+	node := &mockNode{roles: map[edgetypes.Address]edgeallowlist.Role{
+		edgetypes.StringToAddress(testAdmin): edgeallowlist.AdminRole,
+	}}
+	server := httptest.NewServer(http.HandlerFunc(node.serve))
+	t.Cleanup(server.Close)
+	return node, server.URL
+}
+
+func (n *mockNode) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     uint64            `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var result interface{}
+	switch req.Method {
+	case "eth_chainId":
+		result = "0x64"
+	case "eth_gasPrice":
+		result = "0x1"
+	case "eth_estimateGas":
+		result = "0x5208"
+	case "eth_getTransactionCount":
+		result = fmt.Sprintf("0x%x", n.nonce)
+	case "eth_call":
+		var msg struct {
+			Data string `json:"data"`
+		}
+		_ = json.Unmarshal(req.Params[0], &msg)
+		input, _ := hex.DecodeHex(msg.Data)
+		args, err := edgeallowlist.ReadAllowListFunc.Inputs.Decode(input[4:])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// Accounts without role have the zero role, which is NoRole.
+		result = hex.EncodeToHex(n.roles[edgetypes.Address(args.(map[string]interface{})["0"].(ethgo.Address))].Bytes())
+	case "eth_sendRawTransaction":
+		var encoded string
+		_ = json.Unmarshal(req.Params[0], &encoded)
+		raw, _ := hex.DecodeHex(encoded)
+		tx := &edgetypes.Transaction{}
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		tx.ComputeHash()
+		n.sent = append(n.sent, tx)
+		n.nonce++
+		// The allow list gives the role as soon as the transaction is sent.
+		for name, method := range roleSetters {
+			if bytes.Equal(method.ID(), tx.Input[:4]) {
+				args, _ := method.Inputs.Decode(tx.Input[4:])
+				account := edgetypes.Address(args.(map[string]interface{})["0"].(ethgo.Address))
+				n.roles[account] = roles[name]
+			}
+		}
+		result = tx.Hash.String()
+	case "eth_getTransactionReceipt":
+		var hash string
+		_ = json.Unmarshal(req.Params[0], &hash)
+		result = map[string]interface{}{"transactionHash": hash, "blockNumber": "0x1", "status": "0x1", "logs": []interface{}{}}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+// roles are the allow list roles, by name.
+var roles = map[string]edgeallowlist.Role{
+	roleNone:    edgeallowlist.NoRole,
+	roleEnabled: edgeallowlist.EnabledRole,
+	roleAdmin:   edgeallowlist.AdminRole,
+}
+
+// sentSetters returns the names of the allow list methods called by the sent transactions, in order.
+func (n *mockNode) sentSetters(t *testing.T) []string {
+	t.Helper()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	setters := make([]string, len(n.sent))
+	for i, tx := range n.sent {
+		if *tx.To != contracts.AllowListContractsAddr {
+			t.Fatalf("transaction %d sent to %s, want the contract deployer allow list", i, tx.To)
+		}
+		for _, method := range roleSetters {
+			if bytes.Equal(method.ID(), tx.Input[:4]) {
+				setters[i] = method.Name
+			}
+		}
+	}
+	return setters
+}
+
+// createEntry applies a new allow list entry with the given attributes set in its configuration and plan.
+// The other attributes are null in the configuration, and null or unknown if computed in the plan.
+func createEntry(t *testing.T, url string, attrs map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	r := NewDeployerAllowlistEntryResource()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	config := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	plan := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		config[name] = tftypes.NewValue(typ, nil)
+		plan[name] = tftypes.NewValue(typ, nil)
+		if schemaResp.Schema.Attributes[name].IsComputed() {
+			plan[name] = tftypes.NewValue(typ, tftypes.UnknownValue)
+		}
+	}
+	attrs["rpc_url"] = tftypes.NewValue(tftypes.String, url)
+	attrs["address"] = tftypes.NewValue(tftypes.String, testDeployer)
+	attrs["confirmation"] = tftypes.NewValue(objectType.AttributeTypes["confirmation"], map[string]tftypes.Value{
+		"timeout":       tftypes.NewValue(tftypes.String, "2s"),
+		"poll_interval": tftypes.NewValue(tftypes.String, "10ms"),
+		"confirmations": tftypes.NewValue(tftypes.Number, nil),
+	})
+	if _, ok := attrs["admin_key_encoded"]; !ok {
+		attrs["admin_key_encoded"] = tftypes.NewValue(tftypes.String, testAdminKey)
+	}
+	for name, value := range attrs {
+		config[name] = value
+		plan[name] = value
+	}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, config)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, plan)},
+	}, resp)
+	return resp.State, resp.Diagnostics
+}
+
+func TestDeployerAllowlistEntryResource(t *testing.T) {
+	tests := []struct {
+		role       interface{}
+		wantSetter string
+		wantRole   edgeallowlist.Role
+	}{
+		{role: nil, wantSetter: "setEnabled", wantRole: edgeallowlist.EnabledRole},
+		{role: roleEnabled, wantSetter: "setEnabled", wantRole: edgeallowlist.EnabledRole},
+		{role: roleAdmin, wantSetter: "setAdmin", wantRole: edgeallowlist.AdminRole},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.role), func(t *testing.T) {
+			ctx := context.Background()
+			node, url := newMockNode(t)
+
+			state, diags := createEntry(t, url, map[string]tftypes.Value{
+				"role": tftypes.NewValue(tftypes.String, tt.role),
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected create diagnostics: %v", diags)
+			}
+			if setters := node.sentSetters(t); fmt.Sprint(setters) != "["+tt.wantSetter+"]" {
+				t.Fatalf("sent %v, want [%s]", setters, tt.wantSetter)
+			}
+			if got := node.roles[edgetypes.StringToAddress(testDeployer)]; got != tt.wantRole {
+				t.Errorf("role = %d, want %d", got.Uint64(), tt.wantRole.Uint64())
+			}
+			var model deployerAllowlistEntryResourceModel
+			if diags := state.Get(ctx, &model); diags.HasError() {
+				t.Fatalf("unable to get state: %v", diags)
+			}
+			if model.TxHash.ValueString() != node.sent[0].Hash.String() {
+				t.Errorf("tx_hash = %s, want %s", model.TxHash, node.sent[0].Hash)
+			}
+
+			// Destroying the entry takes the role away.
+			deleteResp := &resource.DeleteResponse{}
+			NewDeployerAllowlistEntryResource().Delete(ctx, resource.DeleteRequest{State: state}, deleteResp)
+			if deleteResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected delete diagnostics: %v", deleteResp.Diagnostics)
+			}
+			if setters := node.sentSetters(t); fmt.Sprint(setters) != "["+tt.wantSetter+" setNone]" {
+				t.Errorf("sent %v, want [%s setNone]", setters, tt.wantSetter)
+			}
+		})
+	}
+}
+
+func TestDeployerAllowlistEntryResourceRoleAlreadySet(t *testing.T) {
+	node, url := newMockNode(t)
+	node.roles[edgetypes.StringToAddress(testDeployer)] = edgeallowlist.EnabledRole
+
+	state, diags := createEntry(t, url, map[string]tftypes.Value{})
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+	if len(node.sent) != 0 {
+		t.Errorf("sent %d transactions for a role the account has", len(node.sent))
+	}
+	var model deployerAllowlistEntryResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if !model.TxHash.IsNull() {
+		t.Errorf("tx_hash = %s, want null without transaction", model.TxHash)
+	}
+}
+
+func TestDeployerAllowlistEntryResourceNotAdmin(t *testing.T) {
+	for _, role := range []edgeallowlist.Role{edgeallowlist.NoRole, edgeallowlist.EnabledRole} {
+		t.Run(roleNames[role.Uint64()], func(t *testing.T) {
+			node, url := newMockNode(t)
+			node.roles[edgetypes.StringToAddress(testAdmin)] = role
+
+			state, diags := createEntry(t, url, map[string]tftypes.Value{})
+			if !diags.HasError() {
+				t.Fatal("changing a role without the admin role succeeded")
+			}
+			if got := diags.Errors()[0].Summary(); got != "Not an allow list admin" {
+				t.Errorf("got %q, want the not an admin error", got)
+			}
+			if len(node.sent) != 0 {
+				t.Errorf("sent %d transactions without the admin role", len(node.sent))
+			}
+			if !state.Raw.IsNull() {
+				t.Error("the entry was stored in state")
+			}
+		})
+	}
+}
+
+func TestDeployerAllowlistEntryResourceReadRoleChanged(t *testing.T) {
+	ctx := context.Background()
+	node, url := newMockNode(t)
+	state, diags := createEntry(t, url, map[string]tftypes.Value{})
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+
+	// read returns whether the entry is kept in state.
+	read := func() bool {
+		resp := &resource.ReadResponse{State: state}
+		NewDeployerAllowlistEntryResource().Read(ctx, resource.ReadRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected read diagnostics: %v", resp.Diagnostics)
+		}
+		return !resp.State.Raw.IsNull()
+	}
+	if !read() {
+		t.Error("the entry was removed from state while the account has its role")
+	}
+	node.roles[edgetypes.StringToAddress(testDeployer)] = edgeallowlist.NoRole
+	if read() {
+		t.Error("the entry was kept in state after its role was taken away")
+	}
+}
@@ -0,0 +1,182 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// abiWordSize is the size of the head slot every elementary value takes in an ABI encoding.
+const abiWordSize = 32
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &abiDecodeDataSource{}
+	_ datasource.DataSourceWithConfigure      = &abiDecodeDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &abiDecodeDataSource{}
+)
+
+// abiDecodeDataSourceModel maps the data source schema data.
+type abiDecodeDataSourceModel struct {
+	Data  types.String `tfsdk:"data"`
+	Types types.List   `tfsdk:"types"`
+
+	Values types.List `tfsdk:"values"`
+}
+
+// NewABIDecodeDataSource is a helper function to simplify the provider implementation.
+func NewABIDecodeDataSource() datasource.DataSource {
+	return &abiDecodeDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// abiDecodeDataSource is the data source implementation.
+type abiDecodeDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *abiDecodeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_abi_decode"
+}
+
+// Schema defines the schema for the data source.
+func (d *abiDecodeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "ABI decodes hex encoded data, such as the result of an `eth_call`, into its values. " +
+			"It is the decoding counterpart of the `abi_encode_hash` function, without any call to a node.",
+		Attributes: map[string]schema.Attribute{
+			"data": schema.StringAttribute{
+				Required:    true,
+				Description: "Hex encoded, ABI encoded values, without a method selector.",
+				Validators: []validator.String{
+					validators.Hex(),
+				},
+			},
+			"types": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "ABI types of the encoded values, in order, like `[\"uint256\", \"address\"]`. Only elementary types are supported.",
+			},
+			"values": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Decoded values, in the order of `types`. Integers are decimal, " +
+					"addresses and bytes are 0x prefixed hex and bools are `true` or `false`.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *abiDecodeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// ValidateConfig ensures the types are supported.
+func (d *abiDecodeDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config abiDecodeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Types.IsNull() || config.Types.IsUnknown() {
+		return
+	}
+
+	for i, element := range config.Types.Elements() {
+		typ, ok := element.(types.String)
+		if !ok || typ.IsUnknown() {
+			continue
+		}
+		if !validOutputType(typ.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("types").AtListIndex(i),
+				"Unsupported type",
+				fmt.Sprintf("Type %q is not an elementary ABI type, like uint256, address, bool, bytes32, bytes or string.", typ.ValueString()),
+			)
+		}
+	}
+}
+
+// Read decodes the data.
+func (d *abiDecodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state abiDecodeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var typeNames []string
+	resp.Diagnostics.Append(state.Types.ElementsAs(ctx, &typeNames, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The data is validated hex, so decoding cannot fail.
+	encoded, _ := hex.DecodeHex(state.Data.ValueString())
+	if err := checkEncodedLength(typeNames, encoded); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("data"),
+			"Unable to decode data",
+			fmt.Sprintf("The data does not match the types %s: %s.", strings.Join(typeNames, ", "), err),
+		)
+		return
+	}
+	values, err := decodeOutputs(d.providerData, typeNames, encoded)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("data"),
+			"Unable to decode data",
+			fmt.Sprintf("The data does not decode as %s: %s.", strings.Join(typeNames, ", "), err),
+		)
+		return
+	}
+
+	valueList, diags := types.ListValueFrom(ctx, types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Values = valueList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// checkEncodedLength checks the data has room for the head of every type and, if all types are static,
+// that it holds nothing else. The decoder silently ignores trailing data, which hides a wrong list of types.
+func checkEncodedLength(typeNames []string, data []byte) error {
+	headSize := abiWordSize * len(typeNames)
+	if len(data) < headSize {
+		return fmt.Errorf("%d types need at least %d bytes, got %d", len(typeNames), headSize, len(data))
+	}
+	for _, typ := range typeNames {
+		if typ == "bytes" || typ == "string" {
+			return nil
+		}
+	}
+	if len(data) != headSize {
+		return fmt.Errorf("%d static types encode to exactly %d bytes, got %d", len(typeNames), headSize, len(data))
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package chain
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+)
+
+// encodeTuple returns the hex ABI encoding of the values as a tuple of the types.
+func encodeTuple(t *testing.T, typeNames []string, values ...interface{}) string {
+	t.Helper()
+	encoded, err := abi.Encode(values, abi.MustNewType("tuple("+strings.Join(typeNames, ",")+")"))
+	if err != nil {
+		t.Fatalf("unable to encode %v: %v", values, err)
+	}
+	return hex.EncodeToHex(encoded)
+}
+
+func TestABIDecodeDataSource(t *testing.T) {
+	var hash [32]byte
+	copy(hash[:], ethgo.Keccak256([]byte("polygon-edge")))
+	minusOne := big.NewInt(-1)
+
+	tests := []struct {
+		name   string
+		types  []string
+		values []interface{}
+		want   []string
+	}{
+		{
+			name:   "static values",
+			types:  []string{"uint256", "address", "bool", "bytes32"},
+			values: []interface{}{big.NewInt(42), ethgo.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"), true, hash},
+			want:   []string{"42", "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "true", hex.EncodeToHex(hash[:])},
+		},
+		{
+			name:   "dynamic values",
+			types:  []string{"uint8", "string", "bytes", "int256"},
+			values: []interface{}{uint8(7), "hello", []byte{0xca, 0xfe}, minusOne},
+			want:   []string{"7", "hello", "0xcafe", "-1"},
+		},
+		{
+			name:   "single value",
+			types:  []string{"uint64"},
+			values: []interface{}{uint64(1000)},
+			want:   []string{"1000"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var model abiDecodeDataSourceModel
+			diags := readDataSource(t, NewABIDecodeDataSource(), map[string]tftypes.Value{
+				"data":  tftypes.NewValue(tftypes.String, encodeTuple(t, tt.types, tt.values...)),
+				"types": outputTypes(tt.types...),
+			}, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+			var values []string
+			if diags := model.Values.ElementsAs(context.Background(), &values, false); diags.HasError() {
+				t.Fatalf("unable to read values: %v", diags)
+			}
+			if strings.Join(values, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("values = %v, want %v", values, tt.want)
+			}
+		})
+	}
+}
+
+func TestABIDecodeDataSourceMismatch(t *testing.T) {
+	word := "0x" + strings.Repeat("0", 63) + "1"
+
+	tests := []struct {
+		name  string
+		data  string
+		types []string
+	}{
+		{name: "missing value", data: word, types: []string{"uint256", "uint256"}},
+		{name: "extra value", data: word + strings.Repeat("0", 64), types: []string{"uint256"}},
+		{name: "empty data", data: "0x", types: []string{"address"}},
+		{name: "out of range offset", data: "0x" + strings.Repeat("f", 64), types: []string{"string"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var model abiDecodeDataSourceModel
+			diags := readDataSource(t, NewABIDecodeDataSource(), map[string]tftypes.Value{
+				"data":  tftypes.NewValue(tftypes.String, tt.data),
+				"types": outputTypes(tt.types...),
+			}, &model)
+			if !diags.HasError() {
+				t.Fatalf("decoding %s as %v succeeded: %s", tt.data, tt.types, model.Values)
+			}
+			if got := diags.Errors()[0].Summary(); got != "Unable to decode data" {
+				t.Errorf("got %q, want the decode error", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// defaultBalanceWorkers is the number of balances requested at once, unless configured.
+const defaultBalanceWorkers = 8
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &balancesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &balancesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &balancesDataSource{}
+)
+
+// balancesDataSourceModel maps the data source schema data.
+type balancesDataSourceModel struct {
+	RPCURL      types.String `tfsdk:"rpc_url"`
+	Addresses   types.List   `tfsdk:"addresses"`
+	Block       types.String `tfsdk:"block"`
+	Concurrency types.Int64  `tfsdk:"concurrency"`
+
+	Balances []string     `tfsdk:"balances"`
+	Total    types.String `tfsdk:"total"`
+}
+
+// NewBalancesDataSource is a helper function to simplify the provider implementation.
+func NewBalancesDataSource() datasource.DataSource {
+	return &balancesDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// balancesDataSource is the data source implementation.
+type balancesDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *balancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_balances"
+}
+
+// Schema defines the schema for the data source.
+func (d *balancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the balances of many accounts with `eth_getBalance`, requested concurrently, " +
+			"for example to verify the premine of a genesis without a data source per account.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"addresses": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Addresses of the accounts.",
+			},
+			"block": schema.StringAttribute{
+				Optional:    true,
+				Description: "Block the balances are read at, `latest`, `pending`, `earliest` or a block number. Defaults to `latest`.",
+				Validators: []validator.String{
+					validators.BlockTag(),
+				},
+			},
+			"concurrency": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum number of balances requested at once. Defaults to %d.", defaultBalanceWorkers),
+			},
+			"balances": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Balances in wei, in the order of `addresses`.",
+			},
+			"total": schema.StringAttribute{
+				Computed:    true,
+				Description: "Sum of the balances in wei.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *balancesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// ValidateConfig ensures the addresses are valid and the concurrency is positive.
+func (d *balancesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config balancesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Concurrency.IsNull() && !config.Concurrency.IsUnknown() && config.Concurrency.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("concurrency"),
+			"Invalid concurrency",
+			"The number of balances requested at once must be greater than zero.",
+		)
+	}
+
+	if config.Addresses.IsNull() || config.Addresses.IsUnknown() {
+		return
+	}
+	for i, element := range config.Addresses.Elements() {
+		address, ok := element.(types.String)
+		if !ok {
+			continue
+		}
+		var addressResp validator.StringResponse
+		validators.Address().ValidateString(ctx, validator.StringRequest{
+			Path:        path.Root("addresses").AtListIndex(i),
+			ConfigValue: address,
+		}, &addressResp)
+		resp.Diagnostics.Append(addressResp.Diagnostics...)
+	}
+}
+
+// Read requests the balances.
+func (d *balancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state balancesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var rawAddresses []string
+	resp.Diagnostics.Append(state.Addresses.ElementsAs(ctx, &rawAddresses, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The addresses are validated, so parsing cannot fail.
+	addresses := make([]edgetypes.Address, len(rawAddresses))
+	for i, address := range rawAddresses {
+		addresses[i] = edgetypes.StringToAddress(address)
+	}
+	workers := defaultBalanceWorkers
+	if !state.Concurrency.IsNull() {
+		workers = int(state.Concurrency.ValueInt64())
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	balances, errs := balancesAt(ctx, client, addresses, state.Block.ValueString(), workers)
+	for i, err := range errs {
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("addresses").AtListIndex(i),
+				"Unable to get balance",
+				fmt.Sprintf("Unable to get the balance of %s: %s", addresses[i], err),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	total := new(big.Int)
+	state.Balances = make([]string, len(balances))
+	for i, balance := range balances {
+		state.Balances[i] = balance.String()
+		total.Add(total, balance)
+	}
+	state.Total = types.StringValue(total.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// balancesAt requests the balances of the addresses at the given block, with at most the given number of
+// requests at once. The balances and errors are returned in the order of the addresses.
+func balancesAt(ctx context.Context, client *rpc.Client, addresses []edgetypes.Address, block string, workers int) ([]*big.Int, []error) {
+	balances := make([]*big.Int, len(addresses))
+	errs := make([]error, len(addresses))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(workers, len(addresses)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				balances[i], errs[i] = client.BalanceAt(ctx, addresses[i], block)
+			}
+		}()
+	}
+	for i := range addresses {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return balances, errs
+}
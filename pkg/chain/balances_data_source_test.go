@@ -0,0 +1,119 @@
+package chain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// balanceAddresses returns n addresses, the i-th of which ends with i+1, and their list value.
+func balanceAddresses(n int) ([]edgetypes.Address, tftypes.Value) {
+	addresses := make([]edgetypes.Address, n)
+	values := make([]tftypes.Value, n)
+	for i := range addresses {
+		addresses[i] = edgetypes.BytesToAddress(big.NewInt(int64(i + 1)).Bytes())
+		values[i] = tftypes.NewValue(tftypes.String, addresses[i].String())
+	}
+	return addresses, tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+}
+
+// balanceNode serves eth_getBalance with a balance of 1000 wei times the last byte of the address, after a delay
+// so that concurrent requests overlap. It records the most requests it served at once.
+func balanceNode(t *testing.T, failing map[edgetypes.Address]bool) (url string, maxInFlight func() int) {
+	var mu sync.Mutex
+	var inFlight, max int
+	url = mockNode(t, func(method string, params []json.RawMessage) (interface{}, *rpc.Error) {
+		if method != "eth_getBalance" {
+			return nil, methodNotFound
+		}
+		mu.Lock()
+		inFlight++
+		if inFlight > max {
+			max = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		var address edgetypes.Address
+		if err := json.Unmarshal(params[0], &address); err != nil {
+			return nil, &rpc.Error{Code: -32602, Message: err.Error()}
+		}
+		if failing[address] {
+			return nil, &rpc.Error{Code: -32000, Message: "internal error"}
+		}
+		return fmt.Sprintf("0x%x", 1000*int(address[len(address)-1])), nil
+	})
+	return url, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return max
+	}
+}
+
+func TestBalancesDataSource(t *testing.T) {
+	addresses, addressList := balanceAddresses(20)
+	url, maxInFlight := balanceNode(t, nil)
+
+	var model balancesDataSourceModel
+	diags := readDataSource(t, NewBalancesDataSource(), map[string]tftypes.Value{
+		"rpc_url":     tftypes.NewValue(tftypes.String, url),
+		"addresses":   addressList,
+		"concurrency": tftypes.NewValue(tftypes.Number, 3),
+	}, &model)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	if len(model.Balances) != len(addresses) {
+		t.Fatalf("got %d balances, want %d", len(model.Balances), len(addresses))
+	}
+	for i, balance := range model.Balances {
+		if want := fmt.Sprint(1000 * (i + 1)); balance != want {
+			t.Errorf("balance %d of %s = %s, want %s in the order of the addresses", i, addresses[i], balance, want)
+		}
+	}
+	// 1000 * (1 + 2 + ... + 20)
+	if model.Total.ValueString() != "210000" {
+		t.Errorf("total = %s, want 210000", model.Total)
+	}
+	if got := maxInFlight(); got > 3 || got < 2 {
+		t.Errorf("%d balances were requested at once, want concurrent requests up to the concurrency 3", got)
+	}
+}
+
+func TestBalancesDataSourceErrors(t *testing.T) {
+	addresses, addressList := balanceAddresses(5)
+	url, _ := balanceNode(t, map[edgetypes.Address]bool{addresses[1]: true, addresses[3]: true})
+
+	var model balancesDataSourceModel
+	diags := readDataSource(t, NewBalancesDataSource(), map[string]tftypes.Value{
+		"rpc_url":   tftypes.NewValue(tftypes.String, url),
+		"addresses": addressList,
+	}, &model)
+	if got := diags.ErrorsCount(); got != 2 {
+		t.Fatalf("got %d errors, want one for each of the 2 failing addresses: %v", got, diags)
+	}
+	for i, index := range []int{1, 3} {
+		err := diags.Errors()[i]
+		if err.Summary() != "Unable to get balance" {
+			t.Errorf("got %q, want the balance error", err.Summary())
+		}
+		want := path.Root("addresses").AtListIndex(index)
+		if got := err.(diag.DiagnosticWithPath).Path(); !got.Equal(want) {
+			t.Errorf("error %d at %s, want %s", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// sizedOutputTypeRegexp matches the output types with a size suffix.
+var sizedOutputTypeRegexp = regexp.MustCompile(`^(uint|int|bytes)([1-9][0-9]*)$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &callDataSource{}
+	_ datasource.DataSourceWithConfigure      = &callDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &callDataSource{}
+)
+
+// callDataSourceModel maps the data source schema data.
+type callDataSourceModel struct {
+	RPCURL      types.String `tfsdk:"rpc_url"`
+	From        types.String `tfsdk:"from"`
+	To          types.String `tfsdk:"to"`
+	Data        types.String `tfsdk:"data"`
+	Block       types.String `tfsdk:"block"`
+	OutputTypes types.List   `tfsdk:"output_types"`
+
+	Result  types.String `tfsdk:"result"`
+	Decoded types.List   `tfsdk:"decoded"`
+}
+
+// NewCallDataSource is a helper function to simplify the provider implementation.
+func NewCallDataSource() datasource.DataSource {
+	return &callDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// callDataSource is the data source implementation.
+type callDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *callDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_call"
+}
+
+// Schema defines the schema for the data source.
+func (d *callDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Executes a read-only contract call with `eth_call` and returns its result, " +
+			"to read on-chain state such as the getters of the validator contracts.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"from": schema.StringAttribute{
+				Optional:    true,
+				Description: "Address the call is made from.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"to": schema.StringAttribute{
+				Required:    true,
+				Description: "Address of the called contract.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"data": schema.StringAttribute{
+				Optional:    true,
+				Description: "Hex encoded calldata, the method selector followed by the ABI encoded arguments.",
+				Validators: []validator.String{
+					validators.Hex(),
+				},
+			},
+			"block": schema.StringAttribute{
+				Optional:    true,
+				Description: "Block the call is executed at, `latest`, `pending`, `earliest` or a block number. Defaults to `latest`.",
+				Validators: []validator.String{
+					validators.BlockTag(),
+				},
+			},
+			"output_types": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "ABI types of the returned values, like `[\"uint256\", \"address\"]`. " +
+					"If set, the result is decoded into `decoded`. Only elementary types are supported.",
+			},
+			"result": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hex encoded result of the call.",
+			},
+			"decoded": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Returned values, in the order of `output_types`. Integers are decimal, " +
+					"addresses and bytes are 0x prefixed hex and bools are `true` or `false`. Null if `output_types` is not set.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *callDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// ValidateConfig ensures the output types are supported.
+func (d *callDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config callDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.OutputTypes.IsNull() || config.OutputTypes.IsUnknown() {
+		return
+	}
+
+	for i, element := range config.OutputTypes.Elements() {
+		typ, ok := element.(types.String)
+		if !ok || typ.IsUnknown() {
+			continue
+		}
+		if !validOutputType(typ.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("output_types").AtListIndex(i),
+				"Unsupported output type",
+				fmt.Sprintf("Output type %q is not an elementary ABI type, like uint256, address, bool, bytes32, bytes or string.", typ.ValueString()),
+			)
+		}
+	}
+}
+
+// Read executes the call.
+func (d *callDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state callDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All values are validated, so parsing cannot fail.
+	to := edgetypes.StringToAddress(state.To.ValueString())
+	msg := rpc.CallMsg{To: &to}
+	if !state.From.IsNull() {
+		from := edgetypes.StringToAddress(state.From.ValueString())
+		msg.From = &from
+	}
+	if !state.Data.IsNull() {
+		msg.Data, _ = hex.DecodeHex(state.Data.ValueString())
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	result, err := client.CallContract(ctx, msg, state.Block.ValueString())
+	if reason, reverted := rpc.RevertReason(err); reverted {
+		resp.Diagnostics.AddError("Call reverted", fmt.Sprintf("The call reverts: %s.", reason))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to call contract", err.Error())
+		return
+	}
+	state.Result = types.StringValue(hex.EncodeToHex(result))
+
+	state.Decoded = types.ListNull(types.StringType)
+	if !state.OutputTypes.IsNull() {
+		var outputTypes []string
+		resp.Diagnostics.Append(state.OutputTypes.ElementsAs(ctx, &outputTypes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		decoded, err := decodeOutputs(d.providerData, outputTypes, result)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("output_types"),
+				"Unable to decode call result",
+				fmt.Sprintf("The result %s does not decode as %s: %s.", state.Result.ValueString(), strings.Join(outputTypes, ", "), err),
+			)
+			return
+		}
+		decodedList, diags := types.ListValueFrom(ctx, types.StringType, decoded)
+		resp.Diagnostics.Append(diags...)
+		state.Decoded = decodedList
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// validOutputType reports whether the type is an elementary ABI type the result can be decoded as.
+func validOutputType(typ string) bool {
+	switch typ {
+	case "address", "bool", "bytes", "string":
+		return true
+	}
+
+	match := sizedOutputTypeRegexp.FindStringSubmatch(typ)
+	if match == nil {
+		return false
+	}
+	size, _ := strconv.Atoi(match[2])
+	if match[1] == "bytes" {
+		return size <= 32
+	}
+	return size%8 == 0 && size <= 256
+}
+
+// decodeOutputs ABI decodes the call result as the output types, returning the string form of every value.
+func decodeOutputs(data providerdata.Data, outputTypes []string, result []byte) (values []string, err error) {
+	if len(outputTypes) == 0 {
+		return []string{}, nil
+	}
+
+	// The decoder indexes into the result without checking all lengths, a malformed result must not crash the provider.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("malformed result: %v", r)
+		}
+	}()
+
+	typ, err := abi.NewType("tuple(" + strings.Join(outputTypes, ",") + ")")
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := abi.Decode(typ, result)
+	if err != nil {
+		return nil, err
+	}
+	tuple, _ := decoded.(map[string]interface{})
+
+	values = make([]string, len(outputTypes))
+	for i := range outputTypes {
+		values[i] = formatABIValue(data, tuple[strconv.Itoa(i)])
+	}
+	return values, nil
+}
+
+// formatABIValue returns the string form of a decoded ABI value. Integers are decimal, addresses are in the
+// configured format, bytes are 0x prefixed hex and bools are `true` or `false`. Arrays are JSON lists of the
+// string form of their elements.
+func formatABIValue(data providerdata.Data, value interface{}) string {
+	switch value := value.(type) {
+	case ethgo.Address:
+		return data.FormatAddress(edgetypes.Address(value))
+	case *big.Int:
+		return value.String()
+	case bool:
+		return strconv.FormatBool(value)
+	case string:
+		return value
+	case []byte:
+		return hex.EncodeToHex(value)
+	}
+
+	// Fixed size bytes decode to byte arrays of their size, and integers of up to 64 bits to native integers.
+	list := reflect.ValueOf(value)
+	switch {
+	case list.Kind() == reflect.Array && list.Type().Elem().Kind() == reflect.Uint8:
+		fixed := make([]byte, list.Len())
+		reflect.Copy(reflect.ValueOf(fixed), list)
+		return hex.EncodeToHex(fixed)
+	case list.Kind() == reflect.Array || list.Kind() == reflect.Slice:
+		elements := make([]string, list.Len())
+		for i := range elements {
+			elements[i] = formatABIValue(data, list.Index(i).Interface())
+		}
+		encoded, _ := json.Marshal(elements)
+		return string(encoded)
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,102 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// callResult is the ABI encoding of (uint256 42, address, bool true).
+var callResult = "0x" + strings.Repeat("0", 62) + "2a" +
+	strings.Repeat("0", 24) + "f39fd6e51aad88f6f4ce6ab8827279cfffb92266" +
+	strings.Repeat("0", 63) + "1"
+
+// outputTypes returns the list value of the output types.
+func outputTypes(types ...string) tftypes.Value {
+	values := make([]tftypes.Value, len(types))
+	for i, typ := range types {
+		values[i] = tftypes.NewValue(tftypes.String, typ)
+	}
+	return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+}
+
+func TestCallDataSource(t *testing.T) {
+	var block string
+	url := mockNode(t, func(method string, params []json.RawMessage) (interface{}, *rpc.Error) {
+		if method != "eth_call" {
+			return nil, methodNotFound
+		}
+		_ = json.Unmarshal(params[1], &block)
+		return callResult, nil
+	})
+
+	var model callDataSourceModel
+	diags := readDataSource(t, NewCallDataSource(), map[string]tftypes.Value{
+		"rpc_url":      tftypes.NewValue(tftypes.String, url),
+		"to":           tftypes.NewValue(tftypes.String, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+		"data":         tftypes.NewValue(tftypes.String, "0x18160ddd"),
+		"block":        tftypes.NewValue(tftypes.String, "16"),
+		"output_types": outputTypes("uint256", "address", "bool"),
+	}, &model)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	if block != "0x10" {
+		t.Errorf("called at block %s, want 0x10", block)
+	}
+	if model.Result.ValueString() != callResult {
+		t.Errorf("result = %s, want %s", model.Result.ValueString(), callResult)
+	}
+	var decoded []string
+	if diags := model.Decoded.ElementsAs(context.Background(), &decoded, false); diags.HasError() {
+		t.Fatalf("unable to read decoded: %v", diags)
+	}
+	want := []string{"42", "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "true"}
+	if strings.Join(decoded, ",") != strings.Join(want, ",") {
+		t.Errorf("decoded = %v, want %v", decoded, want)
+	}
+}
+
+func TestCallDataSourceWithoutOutputTypes(t *testing.T) {
+	url := mockNode(t, func(string, []json.RawMessage) (interface{}, *rpc.Error) {
+		return callResult, nil
+	})
+
+	var model callDataSourceModel
+	diags := readDataSource(t, NewCallDataSource(), map[string]tftypes.Value{
+		"rpc_url": tftypes.NewValue(tftypes.String, url),
+		"to":      tftypes.NewValue(tftypes.String, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+	}, &model)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	if !model.Decoded.IsNull() {
+		t.Errorf("decoded = %s without output types, want null", model.Decoded)
+	}
+}
+
+func TestCallDataSourceDecodeError(t *testing.T) {
+	short := hex.EncodeToHex(make([]byte, 32))
+	url := mockNode(t, func(string, []json.RawMessage) (interface{}, *rpc.Error) {
+		return short, nil
+	})
+
+	var model callDataSourceModel
+	diags := readDataSource(t, NewCallDataSource(), map[string]tftypes.Value{
+		"rpc_url":      tftypes.NewValue(tftypes.String, url),
+		"to":           tftypes.NewValue(tftypes.String, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+		"output_types": outputTypes("uint256", "uint256"),
+	}, &model)
+	if !diags.HasError() {
+		t.Fatalf("decoding a short result succeeded: %s", model.Decoded)
+	}
+	if got := diags.Errors()[0].Summary(); got != "Unable to decode call result" {
+		t.Errorf("got %q, want the decode error", got)
+	}
+}
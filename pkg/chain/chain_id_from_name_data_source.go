@@ -0,0 +1,137 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// defaultMinChainID is the default lower bound of derived chain ids, above the ids of well known networks.
+	defaultMinChainID = 10000
+	// maxSafeChainID is the largest chain id wallets accept, so that EIP-155 `v` values, at most
+	// twice the chain id plus 36, stay exact JavaScript numbers.
+	maxSafeChainID = 4503599627370476
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &chainIDFromNameDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &chainIDFromNameDataSource{}
+)
+
+// chainIDFromNameDataSourceModel maps the data source schema data.
+type chainIDFromNameDataSourceModel struct {
+	Name types.String `tfsdk:"name"`
+	Min  types.Int64  `tfsdk:"min"`
+	Max  types.Int64  `tfsdk:"max"`
+
+	ChainID types.Int64 `tfsdk:"chain_id"`
+}
+
+// NewChainIDFromNameDataSource is a helper function to simplify the provider implementation.
+func NewChainIDFromNameDataSource() datasource.DataSource {
+	return &chainIDFromNameDataSource{}
+}
+
+// chainIDFromNameDataSource is the data source implementation.
+type chainIDFromNameDataSource struct{}
+
+// Metadata returns the data source type name.
+func (d *chainIDFromNameDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chain_id_from_name"
+}
+
+// Schema defines the schema for the data source.
+func (d *chainIDFromNameDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Derives a stable chain id from a network name, so that many short-lived networks get distinct chain ids " +
+			"without keeping a registry. The chain id is `min + (keccak256(name) mod (max - min + 1))`, where `name` is " +
+			"hashed as its exact UTF-8 bytes and the hash is read as a big endian unsigned integer. Different names can " +
+			"still collide, the more likely the smaller the range.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the network, hashed as is, without any case or whitespace normalization.",
+			},
+			"min": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Smallest chain id which can be derived, at least 1. Defaults to %d.", defaultMinChainID),
+			},
+			"max": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("Largest chain id which can be derived, at most and by default %d, "+
+					"the largest chain id wallets accept.", maxSafeChainID),
+			},
+			"chain_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Derived chain id.",
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the name is not empty and the range is valid.
+func (d *chainIDFromNameDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config chainIDFromNameDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Name.IsNull() && !config.Name.IsUnknown() && config.Name.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid name", "The network name must not be empty.")
+	}
+	if config.Min.IsUnknown() || config.Max.IsUnknown() {
+		return
+	}
+	minID, maxID := chainIDRange(config.Min, config.Max)
+	switch {
+	case minID < 1:
+		resp.Diagnostics.AddAttributeError(path.Root("min"), "Invalid chain id range", fmt.Sprintf("The smallest chain id must be at least 1, got: %d.", minID))
+	case maxID > maxSafeChainID:
+		resp.Diagnostics.AddAttributeError(path.Root("max"), "Invalid chain id range", fmt.Sprintf("The largest chain id must be at most %d, got: %d.", maxSafeChainID, maxID))
+	case minID > maxID:
+		resp.Diagnostics.AddAttributeError(path.Root("min"), "Invalid chain id range", fmt.Sprintf("The smallest chain id %d is greater than the largest one %d.", minID, maxID))
+	}
+}
+
+// Read derives the chain id.
+func (d *chainIDFromNameDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state chainIDFromNameDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	minID, maxID := chainIDRange(state.Min, state.Max)
+	state.ChainID = types.Int64Value(chainIDFromName(state.Name.ValueString(), minID, maxID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// chainIDRange returns the configured chain id range, or the defaults of its unset bounds.
+func chainIDRange(minValue, maxValue types.Int64) (int64, int64) {
+	minID, maxID := int64(defaultMinChainID), int64(maxSafeChainID)
+	if !minValue.IsNull() {
+		minID = minValue.ValueInt64()
+	}
+	if !maxValue.IsNull() {
+		maxID = maxValue.ValueInt64()
+	}
+	return minID, maxID
+}
+
+// chainIDFromName maps the keccak256 hash of the name into the valid range [minID, maxID].
+func chainIDFromName(name string, minID, maxID int64) int64 {
+	hash := new(big.Int).SetBytes(crypto.Keccak256([]byte(name)))
+	size := new(big.Int).Sub(big.NewInt(maxID), big.NewInt(minID))
+	size.Add(size, big.NewInt(1))
+	return minID + hash.Mod(hash, size).Int64()
+}
@@ -0,0 +1,90 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// readChainIDFromName reads the chain id derived from the name in the range, with null bounds left to their defaults.
+func readChainIDFromName(t *testing.T, name string, minID, maxID interface{}) int64 {
+	t.Helper()
+	var model chainIDFromNameDataSourceModel
+	diags := readDataSource(t, NewChainIDFromNameDataSource(), map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, name),
+		"min":  tftypes.NewValue(tftypes.Number, minID),
+		"max":  tftypes.NewValue(tftypes.Number, maxID),
+	}, &model)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	return model.ChainID.ValueInt64()
+}
+
+func TestChainIDFromNameDataSource(t *testing.T) {
+	// The keccak256 hash of "devnet" modulo the size of the range, plus its lower bound.
+	if got := readChainIDFromName(t, "devnet", nil, nil); got != 732219089773632 {
+		t.Errorf("chain id = %d, want 732219089773632", got)
+	}
+	if got := readChainIDFromName(t, "devnet", 1000, 1999); got != 1398 {
+		t.Errorf("chain id in [1000, 1999] = %d, want 1398", got)
+	}
+
+	// The same name always yields the same id, other names other ids.
+	first, second := readChainIDFromName(t, "staging", nil, nil), readChainIDFromName(t, "staging", nil, nil)
+	if first != second {
+		t.Errorf("chain ids %d and %d derived from the same name", first, second)
+	}
+	if other := readChainIDFromName(t, "staging-2", nil, nil); other == first {
+		t.Errorf("chain id %d derived from another name too", other)
+	}
+
+	// A range of a single id clamps every name to it.
+	for _, name := range []string{"a", "b", "c"} {
+		if got := readChainIDFromName(t, name, 42, 42); got != 42 {
+			t.Errorf("chain id of %q in [42, 42] = %d, want 42", name, got)
+		}
+	}
+}
+
+func TestChainIDFromNameDataSourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	d := NewChainIDFromNameDataSource().(datasource.DataSourceWithValidateConfig)
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	tests := []struct {
+		name    string
+		netName string
+		min     interface{}
+		max     interface{}
+		wantErr bool
+	}{
+		{name: "defaults", netName: "devnet"},
+		{name: "single id", netName: "devnet", min: 42, max: 42},
+		{name: "empty name", netName: "", wantErr: true},
+		{name: "zero min", netName: "devnet", min: 0, wantErr: true},
+		{name: "unsafe max", netName: "devnet", max: maxSafeChainID + 1, wantErr: true},
+		{name: "min above max", netName: "devnet", min: 2000, max: 1000, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &datasource.ValidateConfigResponse{}
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"name":     tftypes.NewValue(tftypes.String, tt.netName),
+					"min":      tftypes.NewValue(tftypes.Number, tt.min),
+					"max":      tftypes.NewValue(tftypes.Number, tt.max),
+					"chain_id": tftypes.NewValue(tftypes.Number, nil),
+				})},
+			}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateConfig() diagnostics = %v, want error %t", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &codeAtDataSource{}
+	_ datasource.DataSourceWithConfigure = &codeAtDataSource{}
+)
+
+// codeAtDataSourceModel maps the data source schema data.
+type codeAtDataSourceModel struct {
+	RPCURL  types.String `tfsdk:"rpc_url"`
+	Address types.String `tfsdk:"address"`
+	Block   types.String `tfsdk:"block"`
+
+	Code     types.String `tfsdk:"code"`
+	CodeHash types.String `tfsdk:"code_hash"`
+}
+
+// NewCodeAtDataSource is a helper function to simplify the provider implementation.
+func NewCodeAtDataSource() datasource.DataSource {
+	return &codeAtDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// codeAtDataSource is the data source implementation.
+type codeAtDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *codeAtDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_code_at"
+}
+
+// Schema defines the schema for the data source.
+func (d *codeAtDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the code of an account with `eth_getCode`, to verify predeployed contracts.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "Address of the account.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"block": schema.StringAttribute{
+				Optional:    true,
+				Description: "Block the code is read at, `latest`, `pending`, `earliest` or a block number. Defaults to `latest`.",
+				Validators: []validator.String{
+					validators.BlockTag(),
+				},
+			},
+			"code": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hex encoded code of the account, `0x` if the account has no code.",
+			},
+			"code_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Keccak256 hash of the code.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *codeAtDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read reads the code of the account.
+func (d *codeAtDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state codeAtDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	code, err := client.CodeAt(ctx, edgetypes.StringToAddress(state.Address.ValueString()), state.Block.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read code", err.Error())
+		return
+	}
+	state.Code = types.StringValue(hex.EncodeToHex(code))
+	state.CodeHash = types.StringValue(hex.EncodeToHex(crypto.Keccak256(code)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
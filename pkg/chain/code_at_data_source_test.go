@@ -0,0 +1,54 @@
+package chain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+func TestCodeAtDataSource(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+	}{
+		{name: "contract", code: "0x6080604052"},
+		{name: "account", code: "0x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var block string
+			url := mockNode(t, func(method string, params []json.RawMessage) (interface{}, *rpc.Error) {
+				if method != "eth_getCode" {
+					return nil, methodNotFound
+				}
+				_ = json.Unmarshal(params[1], &block)
+				return tt.code, nil
+			})
+
+			var model codeAtDataSourceModel
+			diags := readDataSource(t, NewCodeAtDataSource(), map[string]tftypes.Value{
+				"rpc_url": tftypes.NewValue(tftypes.String, url),
+				"address": tftypes.NewValue(tftypes.String, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+				"block":   tftypes.NewValue(tftypes.String, "earliest"),
+			}, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+			if block != "earliest" {
+				t.Errorf("read code at block %s, want earliest", block)
+			}
+			if model.Code.ValueString() != tt.code {
+				t.Errorf("code = %s, want %s", model.Code.ValueString(), tt.code)
+			}
+			code, _ := hex.DecodeHex(tt.code)
+			if want := hex.EncodeToHex(crypto.Keccak256(code)); model.CodeHash.ValueString() != want {
+				t.Errorf("code_hash = %s, want %s", model.CodeHash.ValueString(), want)
+			}
+		})
+	}
+}
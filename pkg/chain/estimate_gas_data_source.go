@@ -0,0 +1,155 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &estimateGasDataSource{}
+	_ datasource.DataSourceWithConfigure = &estimateGasDataSource{}
+)
+
+// estimateGasDataSourceModel maps the data source schema data.
+type estimateGasDataSourceModel struct {
+	RPCURL types.String `tfsdk:"rpc_url"`
+	From   types.String `tfsdk:"from"`
+	To     types.String `tfsdk:"to"`
+	Value  types.String `tfsdk:"value"`
+	Data   types.String `tfsdk:"data"`
+
+	Gas types.Int64 `tfsdk:"gas"`
+}
+
+// NewEstimateGasDataSource is a helper function to simplify the provider implementation.
+func NewEstimateGasDataSource() datasource.DataSource {
+	return &estimateGasDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// estimateGasDataSource is the data source implementation.
+type estimateGasDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *estimateGasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_estimate_gas"
+}
+
+// Schema defines the schema for the data source.
+func (d *estimateGasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Estimates the gas a transaction needs with `eth_estimateGas`, to set the gas limit of write resources. " +
+			"A call which reverts is reported with its revert reason, if the node returns it.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"from": schema.StringAttribute{
+				Optional:    true,
+				Description: "Address the transaction is sent from.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"to": schema.StringAttribute{
+				Optional:    true,
+				Description: "Address the transaction is sent to. A contract deployment is estimated if not set.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Optional:    true,
+				Description: "Amount in wei sent with the transaction.",
+				Validators: []validator.String{
+					validators.Wei(),
+				},
+			},
+			"data": schema.StringAttribute{
+				Optional:    true,
+				Description: "Hex encoded calldata of the transaction, or the creation bytecode of a deployment.",
+				Validators: []validator.String{
+					validators.Hex(),
+				},
+			},
+			"gas": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Estimated gas.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *estimateGasDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read estimates the gas of the transaction.
+func (d *estimateGasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state estimateGasDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All values are validated, so parsing cannot fail.
+	var msg rpc.CallMsg
+	if !state.From.IsNull() {
+		from := edgetypes.StringToAddress(state.From.ValueString())
+		msg.From = &from
+	}
+	if !state.To.IsNull() {
+		to := edgetypes.StringToAddress(state.To.ValueString())
+		msg.To = &to
+	}
+	if !state.Value.IsNull() {
+		msg.Value, _ = new(big.Int).SetString(state.Value.ValueString(), 10)
+	}
+	if !state.Data.IsNull() {
+		msg.Data, _ = hex.DecodeHex(state.Data.ValueString())
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	gas, err := client.EstimateGas(ctx, msg)
+	if reason, reverted := rpc.RevertReason(err); reverted {
+		resp.Diagnostics.AddError("Call reverted", fmt.Sprintf("The estimated call reverts: %s.", reason))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to estimate gas", err.Error())
+		return
+	}
+	state.Gas = types.Int64Value(int64(gas))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,73 @@
+package chain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/umbracle/ethgo/abi"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+func TestEstimateGasDataSource(t *testing.T) {
+	var call map[string]string
+	url := mockNode(t, func(method string, params []json.RawMessage) (interface{}, *rpc.Error) {
+		if method != "eth_estimateGas" {
+			return nil, methodNotFound
+		}
+		_ = json.Unmarshal(params[0], &call)
+		return "0x5208", nil
+	})
+
+	var model estimateGasDataSourceModel
+	diags := readDataSource(t, NewEstimateGasDataSource(), map[string]tftypes.Value{
+		"rpc_url": tftypes.NewValue(tftypes.String, url),
+		"from":    tftypes.NewValue(tftypes.String, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+		"to":      tftypes.NewValue(tftypes.String, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+		"value":   tftypes.NewValue(tftypes.String, "1000"),
+		"data":    tftypes.NewValue(tftypes.String, "0xa9059cbb"),
+	}, &model)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	if model.Gas.ValueInt64() != 21000 {
+		t.Errorf("gas = %d, want 21000", model.Gas.ValueInt64())
+	}
+	want := map[string]string{
+		"from":  "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		"to":    "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		"value": "0x3e8",
+		"data":  "0xa9059cbb",
+	}
+	for name, value := range want {
+		if !strings.EqualFold(call[name], value) {
+			t.Errorf("estimated call %s = %s, want %s", name, call[name], value)
+		}
+	}
+}
+
+func TestEstimateGasDataSourceRevert(t *testing.T) {
+	reason, err := abi.Encode([]interface{}{"insufficient balance"}, abi.MustNewType("tuple(string)"))
+	if err != nil {
+		t.Fatalf("unable to encode the revert reason: %v", err)
+	}
+	data, _ := json.Marshal(hex.EncodeToHex(append([]byte{0x08, 0xc3, 0x79, 0xa0}, reason...)))
+	url := mockNode(t, func(string, []json.RawMessage) (interface{}, *rpc.Error) {
+		return nil, &rpc.Error{Code: 3, Message: "execution reverted", Data: data}
+	})
+
+	var model estimateGasDataSourceModel
+	diags := readDataSource(t, NewEstimateGasDataSource(), map[string]tftypes.Value{
+		"rpc_url": tftypes.NewValue(tftypes.String, url),
+		"to":      tftypes.NewValue(tftypes.String, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+	}, &model)
+	if !diags.HasError() {
+		t.Fatal("estimating a reverting call succeeded")
+	}
+	if got := diags.Errors()[0]; got.Summary() != "Call reverted" || !strings.Contains(got.Detail(), "insufficient balance") {
+		t.Errorf("got %q: %q, want the revert reason", got.Summary(), got.Detail())
+	}
+}
@@ -0,0 +1,154 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &gasPriceDataSource{}
+	_ datasource.DataSourceWithConfigure = &gasPriceDataSource{}
+)
+
+// gasPriceDataSourceModel maps the data source schema data.
+type gasPriceDataSourceModel struct {
+	RPCURL types.String `tfsdk:"rpc_url"`
+
+	GasPrice                types.String `tfsdk:"gas_price"`
+	GasPriceHex             types.String `tfsdk:"gas_price_hex"`
+	MaxPriorityFeePerGas    types.String `tfsdk:"max_priority_fee_per_gas"`
+	MaxPriorityFeePerGasHex types.String `tfsdk:"max_priority_fee_per_gas_hex"`
+	BaseFeePerGas           types.String `tfsdk:"base_fee_per_gas"`
+	BaseFeePerGasHex        types.String `tfsdk:"base_fee_per_gas_hex"`
+}
+
+// NewGasPriceDataSource is a helper function to simplify the provider implementation.
+func NewGasPriceDataSource() datasource.DataSource {
+	return &gasPriceDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// gasPriceDataSource is the data source implementation.
+type gasPriceDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *gasPriceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gas_price"
+}
+
+// Schema defines the schema for the data source.
+func (d *gasPriceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the fees currently suggested by a node, to set the gas parameters of write resources " +
+			"from live network conditions. Amounts are in wei, both as decimal and 0x prefixed hex strings.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"gas_price": schema.StringAttribute{
+				Computed:    true,
+				Description: "Suggested gas price of legacy transactions.",
+			},
+			"gas_price_hex": schema.StringAttribute{
+				Computed:    true,
+				Description: "Suggested gas price of legacy transactions, hex encoded.",
+			},
+			"max_priority_fee_per_gas": schema.StringAttribute{
+				Computed:    true,
+				Description: "Suggested priority fee per gas of dynamic fee transactions. Null if the node does not support it.",
+			},
+			"max_priority_fee_per_gas_hex": schema.StringAttribute{
+				Computed:    true,
+				Description: "Suggested priority fee per gas of dynamic fee transactions, hex encoded. Null if the node does not support it.",
+			},
+			"base_fee_per_gas": schema.StringAttribute{
+				Computed:    true,
+				Description: "Base fee per gas of the latest block. Null if the chain has no base fee.",
+			},
+			"base_fee_per_gas_hex": schema.StringAttribute{
+				Computed:    true,
+				Description: "Base fee per gas of the latest block, hex encoded. Null if the chain has no base fee.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *gasPriceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read queries the suggested fees.
+func (d *gasPriceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state gasPriceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	gasPrice, err := client.GasPrice(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get gas price", err.Error())
+		return
+	}
+
+	// Nodes without dynamic fee support reject the method, which leaves the priority fee unset.
+	priorityFee, err := client.MaxPriorityFeePerGas(ctx)
+	var rpcErr *rpc.Error
+	if errors.As(err, &rpcErr) {
+		tflog.Debug(ctx, "Priority fee is not supported", map[string]interface{}{"error": err.Error()})
+		priorityFee, err = nil, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get max priority fee per gas", err.Error())
+		return
+	}
+	baseFee, err := client.LatestBaseFee(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get base fee per gas", err.Error())
+		return
+	}
+
+	state.GasPrice, state.GasPriceHex = weiValues(gasPrice)
+	state.MaxPriorityFeePerGas, state.MaxPriorityFeePerGasHex = weiValues(priorityFee)
+	state.BaseFeePerGas, state.BaseFeePerGasHex = weiValues(baseFee)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// weiValues returns the decimal and hex forms of an amount, both null if the amount is nil.
+func weiValues(amount *big.Int) (types.String, types.String) {
+	if amount == nil {
+		return types.StringNull(), types.StringNull()
+	}
+	return types.StringValue(amount.String()), types.StringValue(hex.EncodeBig(amount))
+}
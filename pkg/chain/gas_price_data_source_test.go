@@ -0,0 +1,152 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// mockNode serves JSON-RPC requests with handle, which returns either the result or the error of a method.
+func mockNode(t *testing.T, handle func(method string, params []json.RawMessage) (interface{}, *rpc.Error)) string {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     uint64            `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result, rpcErr := handle(req.Method, req.Params)
+		if rpcErr != nil {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "error": rpcErr})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// methodNotFound is the error of a method the node does not serve.
+var methodNotFound = &rpc.Error{Code: -32601, Message: "method not found"}
+
+// readDataSource reads the data source with the given attributes set in its configuration, the others null,
+// into the model, and returns its diagnostics.
+func readDataSource(t *testing.T, d datasource.DataSource, attrs map[string]tftypes.Value, model interface{}) diag.Diagnostics {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, value := range attrs {
+		values[name] = value
+	}
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, model)...)
+	}
+	return resp.Diagnostics
+}
+
+func TestGasPriceDataSource(t *testing.T) {
+	tests := []struct {
+		name        string
+		priorityFee interface{}
+		baseFee     interface{}
+	}{
+		{
+			name:        "dynamic fees",
+			priorityFee: "0x3b9aca00",
+			baseFee:     "0x7",
+		},
+		{
+			name:        "legacy chain",
+			priorityFee: methodNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := mockNode(t, func(method string, _ []json.RawMessage) (interface{}, *rpc.Error) {
+				switch method {
+				case "eth_gasPrice":
+					return "0x4a817c800", nil
+				case "eth_maxPriorityFeePerGas":
+					if err, ok := tt.priorityFee.(*rpc.Error); ok {
+						return nil, err
+					}
+					return tt.priorityFee, nil
+				case "eth_getBlockByNumber":
+					block := map[string]interface{}{"number": "0x10"}
+					if tt.baseFee != nil {
+						block["baseFeePerGas"] = tt.baseFee
+					}
+					return block, nil
+				}
+				return nil, methodNotFound
+			})
+
+			var model gasPriceDataSourceModel
+			diags := readDataSource(t, NewGasPriceDataSource(), map[string]tftypes.Value{
+				"rpc_url": tftypes.NewValue(tftypes.String, url),
+			}, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+
+			if model.GasPrice.ValueString() != "20000000000" || model.GasPriceHex.ValueString() != "0x4a817c800" {
+				t.Errorf("gas price = %s, %s, want 20000000000, 0x4a817c800", model.GasPrice, model.GasPriceHex)
+			}
+			if tt.priorityFee == methodNotFound {
+				if !model.MaxPriorityFeePerGas.IsNull() || !model.MaxPriorityFeePerGasHex.IsNull() {
+					t.Errorf("priority fee = %s, want null when the node does not support it", model.MaxPriorityFeePerGas)
+				}
+			} else if model.MaxPriorityFeePerGas.ValueString() != "1000000000" || model.MaxPriorityFeePerGasHex.ValueString() != "0x3b9aca00" {
+				t.Errorf("priority fee = %s, %s, want 1000000000, 0x3b9aca00", model.MaxPriorityFeePerGas, model.MaxPriorityFeePerGasHex)
+			}
+			if tt.baseFee == nil {
+				if !model.BaseFeePerGas.IsNull() || !model.BaseFeePerGasHex.IsNull() {
+					t.Errorf("base fee = %s, want null without a base fee", model.BaseFeePerGas)
+				}
+			} else if model.BaseFeePerGas.ValueString() != "7" || model.BaseFeePerGasHex.ValueString() != "0x7" {
+				t.Errorf("base fee = %s, %s, want 7, 0x7", model.BaseFeePerGas, model.BaseFeePerGasHex)
+			}
+		})
+	}
+}
+
+func TestGasPriceDataSourceNodeError(t *testing.T) {
+	url := mockNode(t, func(string, []json.RawMessage) (interface{}, *rpc.Error) {
+		return nil, &rpc.Error{Code: -32000, Message: "internal error"}
+	})
+
+	var model gasPriceDataSourceModel
+	diags := readDataSource(t, NewGasPriceDataSource(), map[string]tftypes.Value{
+		"rpc_url": tftypes.NewValue(tftypes.String, url),
+	}, &model)
+	if !diags.HasError() {
+		t.Fatal("reading the gas price of a failing node succeeded")
+	}
+}
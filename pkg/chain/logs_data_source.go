@@ -0,0 +1,388 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// maxLogTopics is the number of topics a log can have, the event id and up to three indexed parameters.
+const maxLogTopics = 4
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &logsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &logsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &logsDataSource{}
+)
+
+// logsDataSourceModel maps the data source schema data.
+type logsDataSourceModel struct {
+	RPCURL    types.String `tfsdk:"rpc_url"`
+	Address   types.String `tfsdk:"address"`
+	Topics    types.List   `tfsdk:"topics"`
+	FromBlock types.String `tfsdk:"from_block"`
+	ToBlock   types.String `tfsdk:"to_block"`
+	Event     types.String `tfsdk:"event"`
+
+	Logs []logModel `tfsdk:"logs"`
+}
+
+// logModel maps a log entry returned by the data source.
+type logModel struct {
+	Address     string    `tfsdk:"address"`
+	Topics      []string  `tfsdk:"topics"`
+	Data        string    `tfsdk:"data"`
+	BlockNumber int64     `tfsdk:"block_number"`
+	TxHash      string    `tfsdk:"tx_hash"`
+	LogIndex    int64     `tfsdk:"log_index"`
+	Decoded     types.Map `tfsdk:"decoded"`
+}
+
+// NewLogsDataSource is a helper function to simplify the provider implementation.
+func NewLogsDataSource() datasource.DataSource {
+	return &logsDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// logsDataSource is the data source implementation.
+type logsDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *logsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_logs"
+}
+
+// Schema defines the schema for the data source.
+func (d *logsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the logs matching a filter with `eth_getLogs`, optionally decoded with the signature of their event, " +
+			"for example to verify that a validator was registered after applying a configuration.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Address of the contract which emitted the logs. Defaults to any contract.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"topics": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("Hex encoded 32 byte topics the logs must have, by position, at most %d. ", maxLogTopics) +
+					"A null topic matches any. The first topic defaults to the id of `event`, if set.",
+			},
+			"from_block": schema.StringAttribute{
+				Optional:    true,
+				Description: "First block searched, `latest`, `pending`, `earliest` or a block number. Defaults to `latest`.",
+				Validators: []validator.String{
+					validators.BlockTag(),
+				},
+			},
+			"to_block": schema.StringAttribute{
+				Optional:    true,
+				Description: "Last block searched, `latest`, `pending`, `earliest` or a block number. Defaults to `latest`.",
+				Validators: []validator.String{
+					validators.BlockTag(),
+				},
+			},
+			"event": schema.StringAttribute{
+				Optional: true,
+				Description: "Signature of the event the logs are decoded as, with named parameters and the indexed ones marked, " +
+					"like `NewValidator(address indexed validator, uint256[4] blsKey)`. Only logs of the event are returned.",
+			},
+			"logs": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching logs, in the order returned by the node.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Computed:    true,
+							Description: "Address of the contract which emitted the log.",
+						},
+						"topics": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Hex encoded topics of the log.",
+						},
+						"data": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hex encoded data of the log.",
+						},
+						"block_number": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of the block holding the log.",
+						},
+						"tx_hash": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash of the transaction which emitted the log.",
+						},
+						"log_index": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Position of the log in its block.",
+						},
+						"decoded": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Parameters of the event by name, null if `event` is not set. Integers are decimal, " +
+								"addresses and bytes are 0x prefixed hex, bools are `true` or `false` and arrays are JSON lists.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *logsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// ValidateConfig ensures the topics are hashes and the event signature can be decoded with.
+func (d *logsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config logsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var event *abi.Event
+	if !config.Event.IsNull() && !config.Event.IsUnknown() {
+		var err error
+		if event, err = parseEvent(config.Event.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("event"), "Invalid event", err.Error())
+		}
+	}
+
+	if config.Topics.IsNull() || config.Topics.IsUnknown() {
+		return
+	}
+	if len(config.Topics.Elements()) > maxLogTopics {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("topics"),
+			"Too many topics",
+			fmt.Sprintf("A log has at most %d topics, got: %d.", maxLogTopics, len(config.Topics.Elements())),
+		)
+	}
+	for i, element := range config.Topics.Elements() {
+		topic, ok := element.(types.String)
+		if !ok || topic.IsNull() || topic.IsUnknown() {
+			continue
+		}
+		hash, ok := parseTopic(topic.ValueString())
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("topics").AtListIndex(i),
+				"Invalid topic",
+				fmt.Sprintf("A topic must be 32 bytes of 0x prefixed hex, got: %s.", topic.ValueString()),
+			)
+			continue
+		}
+		if i == 0 && event != nil && ethgo.Hash(hash) != event.ID() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("topics").AtListIndex(0),
+				"Topic does not match event",
+				fmt.Sprintf("The first topic of the logs of %s is %s, got: %s.", event.Sig(), event.ID(), topic.ValueString()),
+			)
+		}
+	}
+}
+
+// Read requests and decodes the logs.
+func (d *logsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state logsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The address, topics and event are validated, so parsing cannot fail.
+	query := rpc.FilterQuery{
+		FromBlock: state.FromBlock.ValueString(),
+		ToBlock:   state.ToBlock.ValueString(),
+	}
+	if !state.Address.IsNull() {
+		address := edgetypes.StringToAddress(state.Address.ValueString())
+		query.Address = &address
+	}
+	if !state.Topics.IsNull() {
+		var topics []types.String
+		resp.Diagnostics.Append(state.Topics.ElementsAs(ctx, &topics, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		query.Topics = make([]*edgetypes.Hash, len(topics))
+		for i, topic := range topics {
+			if !topic.IsNull() {
+				hash, _ := parseTopic(topic.ValueString())
+				query.Topics[i] = &hash
+			}
+		}
+	}
+	var event *abi.Event
+	if !state.Event.IsNull() {
+		event, _ = parseEvent(state.Event.ValueString())
+		if len(query.Topics) == 0 {
+			query.Topics = make([]*edgetypes.Hash, 1)
+		}
+		if query.Topics[0] == nil {
+			id := edgetypes.Hash(event.ID())
+			query.Topics[0] = &id
+		}
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	logs, err := client.Logs(ctx, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get logs", err.Error())
+		return
+	}
+
+	state.Logs = make([]logModel, len(logs))
+	for i, log := range logs {
+		entry, err := newLogModel(ctx, d.providerData, log, event)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to decode log",
+				fmt.Sprintf("Unable to decode log %s of transaction %s: %s", log.LogIndex, log.TransactionHash, err),
+			)
+			return
+		}
+		state.Logs[i] = entry
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// newLogModel converts a log returned by the node, decoding it as the event if one is given.
+func newLogModel(ctx context.Context, data providerdata.Data, log rpc.Log, event *abi.Event) (logModel, error) {
+	entry := logModel{
+		Address: data.FormatAddressString(log.Address),
+		Topics:  log.Topics,
+		Data:    log.Data,
+		TxHash:  log.TransactionHash,
+		Decoded: types.MapNull(types.StringType),
+	}
+	blockNumber, err := hex.DecodeUint64(log.BlockNumber)
+	if err != nil {
+		return entry, fmt.Errorf("invalid block number %q: %w", log.BlockNumber, err)
+	}
+	logIndex, err := hex.DecodeUint64(log.LogIndex)
+	if err != nil {
+		return entry, fmt.Errorf("invalid log index %q: %w", log.LogIndex, err)
+	}
+	entry.BlockNumber = int64(blockNumber)
+	entry.LogIndex = int64(logIndex)
+	if event == nil {
+		return entry, nil
+	}
+
+	decoded, err := decodeLog(data, event, log)
+	if err != nil {
+		return entry, err
+	}
+	decodedMap, diags := types.MapValueFrom(ctx, types.StringType, decoded)
+	if diags.HasError() {
+		return entry, fmt.Errorf("unable to convert decoded parameters")
+	}
+	entry.Decoded = decodedMap
+	return entry, nil
+}
+
+// decodeLog decodes the topics and data of the log as the parameters of the event, returning their string form by name.
+func decodeLog(data providerdata.Data, event *abi.Event, log rpc.Log) (values map[string]string, err error) {
+	ethLog := &ethgo.Log{Topics: make([]ethgo.Hash, len(log.Topics))}
+	for i, topic := range log.Topics {
+		hash, ok := parseTopic(topic)
+		if !ok {
+			return nil, fmt.Errorf("invalid topic %q", topic)
+		}
+		ethLog.Topics[i] = ethgo.Hash(hash)
+	}
+	if ethLog.Data, err = hex.DecodeHex(log.Data); err != nil {
+		return nil, fmt.Errorf("invalid data: %w", err)
+	}
+
+	// The decoder indexes into the data without checking all lengths, a malformed log must not crash the provider.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("malformed log: %v", r)
+		}
+	}()
+
+	decoded, err := event.ParseLog(ethLog)
+	if err != nil {
+		return nil, fmt.Errorf("the log does not decode as %s: %w", event.Sig(), err)
+	}
+	values = make(map[string]string, len(decoded))
+	for name, value := range decoded {
+		values[name] = formatABIValue(data, value)
+	}
+	return values, nil
+}
+
+// parseEvent parses an event signature with named parameters, with or without the leading `event` keyword.
+func parseEvent(signature string) (*abi.Event, error) {
+	event, err := abi.NewEvent("event " + strings.TrimPrefix(strings.TrimSpace(signature), "event "))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse event signature %q: %w", signature, err)
+	}
+
+	names := make(map[string]bool)
+	for i, input := range event.Inputs.TupleElems() {
+		if input.Name == "" {
+			return nil, fmt.Errorf("parameter %d of event %s has no name, the decoded parameters are keyed by name", i, event.Name)
+		}
+		if names[input.Name] {
+			return nil, fmt.Errorf("event %s has two parameters named %s", event.Name, input.Name)
+		}
+		names[input.Name] = true
+	}
+	return event, nil
+}
+
+// parseTopic parses a 0x prefixed hex topic, reporting whether it is 32 bytes long.
+func parseTopic(topic string) (edgetypes.Hash, bool) {
+	if !strings.HasPrefix(topic, "0x") {
+		return edgetypes.ZeroHash, false
+	}
+	raw, err := hex.DecodeHex(topic)
+	if err != nil || len(raw) != edgetypes.HashLength {
+		return edgetypes.ZeroHash, false
+	}
+	return edgetypes.BytesToHash(raw), true
+}
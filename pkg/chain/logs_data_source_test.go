@@ -0,0 +1,134 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/umbracle/ethgo"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+const (
+	testLogContract  = "0x0000000000000000000000000000000000001001"
+	testLogValidator = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	testLogTxHash    = "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
+)
+
+// newValidatorID is the id of NewValidator(address indexed validator, uint256[4] blsKey), its first topic.
+var newValidatorID = hex.EncodeToHex(ethgo.Keccak256([]byte("NewValidator(address,uint256[4])")))
+
+// logsNode serves eth_getLogs with a NewValidator log of the test validator with the given data, checking the filter.
+func logsNode(t *testing.T, data string) string {
+	return mockNode(t, func(method string, params []json.RawMessage) (interface{}, *rpc.Error) {
+		if method != "eth_getLogs" {
+			return nil, methodNotFound
+		}
+		var filter struct {
+			Address   string        `json:"address"`
+			Topics    []interface{} `json:"topics"`
+			FromBlock string        `json:"fromBlock"`
+			ToBlock   string        `json:"toBlock"`
+		}
+		if err := json.Unmarshal(params[0], &filter); err != nil {
+			return nil, &rpc.Error{Code: -32602, Message: err.Error()}
+		}
+		if !strings.EqualFold(filter.Address, testLogContract) || filter.FromBlock != "0xa" || filter.ToBlock != "latest" {
+			t.Errorf("filter of address %s from %s to %s, want %s from 0xa to latest", filter.Address, filter.FromBlock, filter.ToBlock, testLogContract)
+		}
+		if fmt.Sprint(filter.Topics) != fmt.Sprintf("[%s]", newValidatorID) {
+			t.Errorf("filter topics %v, want the event id %s", filter.Topics, newValidatorID)
+		}
+		return []map[string]interface{}{{
+			"address":         testLogContract,
+			"topics":          []string{newValidatorID, "0x000000000000000000000000" + strings.ToLower(testLogValidator[2:])},
+			"data":            data,
+			"blockNumber":     "0xb",
+			"transactionHash": testLogTxHash,
+			"logIndex":        "0x2",
+		}}, nil
+	})
+}
+
+func TestLogsDataSource(t *testing.T) {
+	blsKey := [4]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)}
+	url := logsNode(t, encodeTuple(t, []string{"uint256[4]"}, blsKey))
+
+	var model logsDataSourceModel
+	diags := readDataSource(t, NewLogsDataSource(), map[string]tftypes.Value{
+		"rpc_url":    tftypes.NewValue(tftypes.String, url),
+		"address":    tftypes.NewValue(tftypes.String, testLogContract),
+		"from_block": tftypes.NewValue(tftypes.String, "10"),
+		"event":      tftypes.NewValue(tftypes.String, "NewValidator(address indexed validator, uint256[4] blsKey)"),
+	}, &model)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	if len(model.Logs) != 1 {
+		t.Fatalf("got %d logs, want 1", len(model.Logs))
+	}
+	log := model.Logs[0]
+	if log.BlockNumber != 11 || log.LogIndex != 2 || log.TxHash != testLogTxHash {
+		t.Errorf("block_number, log_index, tx_hash = %d, %d, %s, want 11, 2, %s", log.BlockNumber, log.LogIndex, log.TxHash, testLogTxHash)
+	}
+	decoded := make(map[string]string)
+	if diags := log.Decoded.ElementsAs(context.Background(), &decoded, false); diags.HasError() {
+		t.Fatalf("unable to read decoded: %v", diags)
+	}
+	if !strings.EqualFold(decoded["validator"], testLogValidator) {
+		t.Errorf("decoded validator = %s, want %s", decoded["validator"], testLogValidator)
+	}
+	if decoded["blsKey"] != `["1","2","3","4"]` {
+		t.Errorf("decoded blsKey = %s, want [\"1\",\"2\",\"3\",\"4\"]", decoded["blsKey"])
+	}
+}
+
+func TestLogsDataSourceWithoutEvent(t *testing.T) {
+	url := mockNode(t, func(string, []json.RawMessage) (interface{}, *rpc.Error) {
+		return []map[string]interface{}{{
+			"address":         testLogContract,
+			"topics":          []string{newValidatorID},
+			"data":            "0x01",
+			"blockNumber":     "0xb",
+			"transactionHash": testLogTxHash,
+			"logIndex":        "0x0",
+		}}, nil
+	})
+
+	var model logsDataSourceModel
+	diags := readDataSource(t, NewLogsDataSource(), map[string]tftypes.Value{
+		"rpc_url": tftypes.NewValue(tftypes.String, url),
+	}, &model)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	if len(model.Logs) != 1 || model.Logs[0].Data != "0x01" || !model.Logs[0].Decoded.IsNull() {
+		t.Errorf("logs = %+v, want the raw log without decoded parameters", model.Logs)
+	}
+}
+
+func TestLogsDataSourceMalformedLog(t *testing.T) {
+	// The data is too short for the four words of the BLS key.
+	url := logsNode(t, "0x01")
+
+	var model logsDataSourceModel
+	diags := readDataSource(t, NewLogsDataSource(), map[string]tftypes.Value{
+		"rpc_url":    tftypes.NewValue(tftypes.String, url),
+		"address":    tftypes.NewValue(tftypes.String, testLogContract),
+		"from_block": tftypes.NewValue(tftypes.String, "10"),
+		"event":      tftypes.NewValue(tftypes.String, "NewValidator(address indexed validator, uint256[4] blsKey)"),
+	}, &model)
+	if !diags.HasError() {
+		t.Fatalf("decoding a malformed log succeeded: %+v", model.Logs)
+	}
+	if got := diags.Errors()[0].Summary(); got != "Unable to decode log" {
+		t.Errorf("got %q, want the decode error", got)
+	}
+}
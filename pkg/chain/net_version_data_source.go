@@ -0,0 +1,97 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &netVersionDataSource{}
+	_ datasource.DataSourceWithConfigure = &netVersionDataSource{}
+)
+
+// netVersionDataSourceModel maps the data source schema data.
+type netVersionDataSourceModel struct {
+	RPCURL types.String `tfsdk:"rpc_url"`
+
+	Version types.Int64 `tfsdk:"version"`
+}
+
+// NewNetVersionDataSource is a helper function to simplify the provider implementation.
+func NewNetVersionDataSource() datasource.DataSource {
+	return &netVersionDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// netVersionDataSource is the data source implementation.
+type netVersionDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *netVersionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_net_version"
+}
+
+// Schema defines the schema for the data source.
+func (d *netVersionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the network id of a node, to check the node is on the expected network, for example in a `precondition`.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Network id of the node, which is the chain id on polygon-edge.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *netVersionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read queries the network id.
+func (d *netVersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state netVersionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	version, err := client.NetVersion(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get network id", err.Error())
+		return
+	}
+
+	state.Version = types.Int64Value(int64(version))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,45 @@
+package chain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+func TestNetVersionDataSource(t *testing.T) {
+	url := mockNode(t, func(method string, _ []json.RawMessage) (interface{}, *rpc.Error) {
+		if method != "net_version" {
+			return nil, methodNotFound
+		}
+		return "100", nil
+	})
+
+	var model netVersionDataSourceModel
+	diags := readDataSource(t, NewNetVersionDataSource(), map[string]tftypes.Value{
+		"rpc_url": tftypes.NewValue(tftypes.String, url),
+	}, &model)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	// The network id is decimal, unlike the hex quantities.
+	if model.Version.ValueInt64() != 100 {
+		t.Errorf("version = %d, want 100", model.Version.ValueInt64())
+	}
+}
+
+func TestNetVersionDataSourceInvalidResult(t *testing.T) {
+	url := mockNode(t, func(string, []json.RawMessage) (interface{}, *rpc.Error) {
+		return "0x64", nil
+	})
+
+	var model netVersionDataSourceModel
+	diags := readDataSource(t, NewNetVersionDataSource(), map[string]tftypes.Value{
+		"rpc_url": tftypes.NewValue(tftypes.String, url),
+	}, &model)
+	if !diags.HasError() {
+		t.Fatalf("reading a hex network id succeeded: %s", model.Version)
+	}
+}
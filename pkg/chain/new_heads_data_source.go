@@ -0,0 +1,136 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// defaultNewHeadTimeout is used when the data source does not configure a timeout.
+const defaultNewHeadTimeout = 30 * time.Second
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &newHeadsDataSource{}
+	_ datasource.DataSourceWithConfigure = &newHeadsDataSource{}
+)
+
+// newHeadsDataSourceModel maps the data source schema data.
+type newHeadsDataSourceModel struct {
+	WSURL   types.String `tfsdk:"ws_url"`
+	Timeout types.String `tfsdk:"timeout"`
+
+	Number types.Int64  `tfsdk:"number"`
+	Hash   types.String `tfsdk:"hash"`
+}
+
+// NewNewHeadsDataSource is a helper function to simplify the provider implementation.
+func NewNewHeadsDataSource() datasource.DataSource {
+	return &newHeadsDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// newHeadsDataSource is the data source implementation.
+type newHeadsDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *newHeadsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_new_heads"
+}
+
+// Schema defines the schema for the data source.
+func (d *newHeadsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Subscribes to new block heads over WebSocket and returns the first one received. " +
+			"Useful to confirm that a chain is live and producing blocks.",
+		Attributes: map[string]schema.Attribute{
+			"ws_url": schema.StringAttribute{
+				Required:    true,
+				Description: "WebSocket JSON-RPC endpoint of the node, e.g. `ws://127.0.0.1:8545/ws`.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for a new head, as a Go duration. Defaults to `30s`.",
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of the first block received.",
+			},
+			"hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the first block received.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *newHeadsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read waits for the first new head.
+func (d *newHeadsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state newHeadsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultNewHeadTimeout
+	if !state.Timeout.IsNull() {
+		var err error
+		if timeout, err = time.ParseDuration(state.Timeout.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid timeout", err.Error())
+			return
+		}
+	}
+
+	subscribeCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	head, err := rpc.FirstNewHead(subscribeCtx, state.WSURL.ValueString(), d.providerData.RPC)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to receive new head", err.Error())
+		return
+	}
+
+	number, err := hex.DecodeUint64(head.Number)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to decode block number", err.Error())
+		return
+	}
+	state.Number = types.Int64Value(int64(number))
+	state.Hash = types.StringValue(head.Hash)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
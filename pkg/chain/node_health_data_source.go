@@ -0,0 +1,259 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Default thresholds of the node health checks.
+const (
+	defaultMinPeers    = 1
+	defaultMaxBlockAge = "1m"
+)
+
+// Names of the node health checks, reported in `failed_checks`.
+const (
+	healthCheckSyncing   = "syncing"
+	healthCheckPeerCount = "peer_count"
+	healthCheckBlockAge  = "block_age"
+)
+
+// nodeHealthDetailsAttrTypes are the attribute types of the `details` object.
+var nodeHealthDetailsAttrTypes = map[string]attr.Type{
+	"syncing":           types.BoolType,
+	"peer_count":        types.Int64Type,
+	"block_number":      types.Int64Type,
+	"block_age_seconds": types.Int64Type,
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &nodeHealthDataSource{}
+	_ datasource.DataSourceWithConfigure      = &nodeHealthDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &nodeHealthDataSource{}
+)
+
+// nodeHealthDataSourceModel maps the data source schema data.
+type nodeHealthDataSourceModel struct {
+	RPCURL      types.String `tfsdk:"rpc_url"`
+	MinPeers    types.Int64  `tfsdk:"min_peers"`
+	MaxBlockAge types.String `tfsdk:"max_block_age"`
+
+	Healthy      types.Bool   `tfsdk:"healthy"`
+	FailedChecks types.List   `tfsdk:"failed_checks"`
+	Details      types.Object `tfsdk:"details"`
+}
+
+// NewNodeHealthDataSource is a helper function to simplify the provider implementation.
+func NewNodeHealthDataSource() datasource.DataSource {
+	return &nodeHealthDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// nodeHealthDataSource is the data source implementation.
+type nodeHealthDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *nodeHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_health"
+}
+
+// Schema defines the schema for the data source.
+func (d *nodeHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks a node is healthy, to use as a single `precondition` before writing to it. " +
+			"The node is healthy if it is not syncing, is connected to at least `min_peers` peers and " +
+			"its latest block is at most `max_block_age` old. An unhealthy node is not an error, " +
+			"`healthy` is false and `failed_checks` lists the failed checks.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"min_peers": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("Minimum number of peers of a healthy node. Defaults to %d, "+
+					"set it to 0 for a single node network.", defaultMinPeers),
+			},
+			"max_block_age": schema.StringAttribute{
+				Optional: true,
+				Description: "Maximum age of the latest block of a healthy node, as a Go duration. " +
+					"Defaults to `" + defaultMaxBlockAge + "`, set it above the block time of the chain.",
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"healthy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether all the checks passed.",
+			},
+			"failed_checks": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Names of the failed checks, `" + healthCheckSyncing + "`, `" + healthCheckPeerCount +
+					"` or `" + healthCheckBlockAge + "`. Empty if the node is healthy.",
+			},
+			"details": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Signals the checks are based on.",
+				Attributes: map[string]schema.Attribute{
+					"syncing": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the node is syncing.",
+					},
+					"peer_count": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of connected peers.",
+					},
+					"block_number": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Number of the latest block.",
+					},
+					"block_age_seconds": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Age of the latest block, in seconds.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *nodeHealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// ValidateConfig ensures the peer threshold is not negative.
+func (d *nodeHealthDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config nodeHealthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.MinPeers.IsNull() && !config.MinPeers.IsUnknown() && config.MinPeers.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_peers"),
+			"Invalid peer threshold",
+			"The minimum number of peers cannot be negative.",
+		)
+	}
+}
+
+// Read runs the health checks.
+func (d *nodeHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nodeHealthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	minPeers := int64(defaultMinPeers)
+	if !state.MinPeers.IsNull() {
+		minPeers = state.MinPeers.ValueInt64()
+	}
+	maxBlockAgeValue := defaultMaxBlockAge
+	if !state.MaxBlockAge.IsNull() {
+		maxBlockAgeValue = state.MaxBlockAge.ValueString()
+	}
+	// The duration is validated by the schema.
+	maxBlockAge, _ := time.ParseDuration(maxBlockAgeValue)
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	progress, err := client.Syncing(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get sync status", err.Error())
+		return
+	}
+	peerCount, err := client.PeerCount(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get peer count", err.Error())
+		return
+	}
+	header, err := client.LatestHeader(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get latest block", err.Error())
+		return
+	}
+	blockNumber, err := hex.DecodeUint64(header.Number)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to decode block number", err.Error())
+		return
+	}
+	timestamp, err := hex.DecodeUint64(header.Timestamp)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to decode block timestamp", err.Error())
+		return
+	}
+	// The clock of the node may be slightly ahead of the local one, which is not a reason to fail.
+	blockAge := time.Since(time.Unix(int64(timestamp), 0))
+	if blockAge < 0 {
+		blockAge = 0
+	}
+
+	failedChecks := []string{}
+	if progress != nil {
+		failedChecks = append(failedChecks, healthCheckSyncing)
+	}
+	if int64(peerCount) < minPeers {
+		failedChecks = append(failedChecks, healthCheckPeerCount)
+	}
+	if blockAge > maxBlockAge {
+		failedChecks = append(failedChecks, healthCheckBlockAge)
+	}
+	if len(failedChecks) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Unhealthy node",
+			fmt.Sprintf("The node failed the %s checks: syncing %t, %d peers, latest block %d is %s old.",
+				strings.Join(failedChecks, ", "), progress != nil, peerCount, blockNumber, blockAge.Truncate(time.Second)),
+		)
+	}
+
+	var diags diag.Diagnostics
+	state.Healthy = types.BoolValue(len(failedChecks) == 0)
+	state.FailedChecks, diags = types.ListValueFrom(ctx, types.StringType, failedChecks)
+	resp.Diagnostics.Append(diags...)
+	state.Details, diags = types.ObjectValue(nodeHealthDetailsAttrTypes, map[string]attr.Value{
+		"syncing":           types.BoolValue(progress != nil),
+		"peer_count":        types.Int64Value(int64(peerCount)),
+		"block_number":      types.Int64Value(int64(blockNumber)),
+		"block_age_seconds": types.Int64Value(int64(blockAge / time.Second)),
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,141 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// healthNode returns a node serving the given sync status, peer count and latest block age.
+func healthNode(t *testing.T, syncing interface{}, peerCount string, blockAge time.Duration) string {
+	t.Helper()
+	return mockNode(t, func(method string, _ []json.RawMessage) (interface{}, *rpc.Error) {
+		switch method {
+		case "eth_syncing":
+			return syncing, nil
+		case "net_peerCount":
+			return peerCount, nil
+		case "eth_getBlockByNumber":
+			return map[string]string{
+				"number":    "0x2a",
+				"timestamp": fmt.Sprintf("0x%x", time.Now().Add(-blockAge).Unix()),
+			}, nil
+		}
+		return nil, methodNotFound
+	})
+}
+
+func TestNodeHealthDataSource(t *testing.T) {
+	syncing := map[string]string{"startingBlock": "0x0", "currentBlock": "0x2a", "highestBlock": "0x64"}
+
+	tests := []struct {
+		name      string
+		url       func(t *testing.T) string
+		attrs     map[string]tftypes.Value
+		wantFails []string
+	}{
+		{
+			name:      "healthy",
+			url:       func(t *testing.T) string { return healthNode(t, false, "0x3", 0) },
+			wantFails: []string{},
+		},
+		{
+			name:      "unhealthy",
+			url:       func(t *testing.T) string { return healthNode(t, syncing, "0x0", 10*time.Minute) },
+			wantFails: []string{healthCheckSyncing, healthCheckPeerCount, healthCheckBlockAge},
+		},
+		{
+			name:      "stale block",
+			url:       func(t *testing.T) string { return healthNode(t, false, "0x3", 2*time.Minute) },
+			wantFails: []string{healthCheckBlockAge},
+		},
+		{
+			name: "relaxed thresholds",
+			url:  func(t *testing.T) string { return healthNode(t, false, "0x0", 10*time.Minute) },
+			attrs: map[string]tftypes.Value{
+				"min_peers":     tftypes.NewValue(tftypes.Number, 0),
+				"max_block_age": tftypes.NewValue(tftypes.String, "1h"),
+			},
+			wantFails: []string{},
+		},
+		{
+			name: "strict thresholds",
+			url:  func(t *testing.T) string { return healthNode(t, false, "0x3", 0) },
+			attrs: map[string]tftypes.Value{
+				"min_peers": tftypes.NewValue(tftypes.Number, 4),
+			},
+			wantFails: []string{healthCheckPeerCount},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := map[string]tftypes.Value{"rpc_url": tftypes.NewValue(tftypes.String, tt.url(t))}
+			for name, value := range tt.attrs {
+				attrs[name] = value
+			}
+
+			var model nodeHealthDataSourceModel
+			diags := readDataSource(t, NewNodeHealthDataSource(), attrs, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+
+			var failed []string
+			if diags := model.FailedChecks.ElementsAs(context.Background(), &failed, false); diags.HasError() {
+				t.Fatalf("unable to read failed_checks: %v", diags)
+			}
+			if strings.Join(failed, ",") != strings.Join(tt.wantFails, ",") {
+				t.Errorf("failed_checks = %v, want %v", failed, tt.wantFails)
+			}
+			if got, want := model.Healthy.ValueBool(), len(tt.wantFails) == 0; got != want {
+				t.Errorf("healthy = %t, want %t", got, want)
+			}
+			// An unhealthy node is reported, naming the failed checks.
+			if len(tt.wantFails) == 0 {
+				if diags.WarningsCount() != 0 {
+					t.Errorf("unexpected warnings for a healthy node: %v", diags.Warnings())
+				}
+				return
+			}
+			if diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), strings.Join(tt.wantFails, ", ")) {
+				t.Errorf("warnings = %v, want one naming %v", diags.Warnings(), tt.wantFails)
+			}
+		})
+	}
+}
+
+func TestNodeHealthDataSourceDetails(t *testing.T) {
+	url := healthNode(t, map[string]string{"startingBlock": "0x0", "currentBlock": "0x2a", "highestBlock": "0x64"}, "0x2", 10*time.Minute)
+
+	var model nodeHealthDataSourceModel
+	diags := readDataSource(t, NewNodeHealthDataSource(), map[string]tftypes.Value{
+		"rpc_url": tftypes.NewValue(tftypes.String, url),
+	}, &model)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	var details struct {
+		Syncing         bool  `tfsdk:"syncing"`
+		PeerCount       int64 `tfsdk:"peer_count"`
+		BlockNumber     int64 `tfsdk:"block_number"`
+		BlockAgeSeconds int64 `tfsdk:"block_age_seconds"`
+	}
+	if diags := model.Details.As(context.Background(), &details, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("unable to read details: %v", diags)
+	}
+	if !details.Syncing || details.PeerCount != 2 || details.BlockNumber != 42 {
+		t.Errorf("details = %+v, want syncing with 2 peers at block 42", details)
+	}
+	if details.BlockAgeSeconds < 600 || details.BlockAgeSeconds > 610 {
+		t.Errorf("block_age_seconds = %d, want about 600", details.BlockAgeSeconds)
+	}
+}
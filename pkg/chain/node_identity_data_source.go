@@ -0,0 +1,129 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeIdentityDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeIdentityDataSource{}
+)
+
+// nodeIdentityDataSourceModel maps the data source schema data.
+type nodeIdentityDataSourceModel struct {
+	RPCURL types.String `tfsdk:"rpc_url"`
+
+	NodeID           types.String `tfsdk:"node_id"`
+	ValidatorAddress types.String `tfsdk:"validator_address"`
+}
+
+// NewNodeIdentityDataSource is a helper function to simplify the provider implementation.
+func NewNodeIdentityDataSource() datasource.DataSource {
+	return &nodeIdentityDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// nodeIdentityDataSource is the data source implementation.
+type nodeIdentityDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *nodeIdentityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_identity"
+}
+
+// Schema defines the schema for the data source.
+func (d *nodeIdentityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Captures the public identifiers of an existing node, its node ID with `admin_nodeInfo` and its " +
+			"validator address with `eth_coinbase`, to build an inventory of nodes without access to their secrets. " +
+			"Only public data is captured: the data source never reads or stores private keys, and the node does not " +
+			"expose them over JSON-RPC. The endpoint must expose the `admin` namespace.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"node_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Node ID, the libp2p peer ID derived from the public network key.",
+			},
+			"validator_address": schema.StringAttribute{
+				Computed: true,
+				Description: "Address of the validator key of the node, derived from the public validator key. " +
+					"Null if the node does not serve `eth_coinbase` or has no validator key.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *nodeIdentityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read queries the public identifiers of the node.
+func (d *nodeIdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nodeIdentityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	info, err := client.NodeInfo(ctx)
+	if rpc.IsMethodNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Admin namespace not available",
+			fmt.Sprintf("The endpoint does not serve admin_nodeInfo: %s. Expose the `admin` JSON-RPC namespace on the node.", err),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get node info", err.Error())
+		return
+	}
+	if info.ID == "" {
+		resp.Diagnostics.AddError("Unable to get node info", "The admin_nodeInfo result has no node id.")
+		return
+	}
+	state.NodeID = types.StringValue(info.ID)
+
+	// A node without a validator key, or one which does not serve eth_coinbase, is still inventoried.
+	state.ValidatorAddress = types.StringNull()
+	coinbase, err := client.Coinbase(ctx)
+	switch {
+	case rpc.IsMethodNotFound(err):
+	case err != nil:
+		resp.Diagnostics.AddError("Unable to get validator address", err.Error())
+		return
+	case coinbase != edgetypes.ZeroAddress:
+		state.ValidatorAddress = types.StringValue(d.providerData.FormatAddress(coinbase))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,107 @@
+package chain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// identityNode serves admin_nodeInfo with the test node id, and eth_coinbase with the given result or error.
+func identityNode(t *testing.T, coinbase interface{}, coinbaseErr *rpc.Error) string {
+	return mockNode(t, func(method string, _ []json.RawMessage) (interface{}, *rpc.Error) {
+		switch method {
+		case "admin_nodeInfo":
+			return map[string]interface{}{"id": testNodeID, "name": "polygon-edge/v0.8.1"}, nil
+		case "eth_coinbase":
+			return coinbase, coinbaseErr
+		}
+		return nil, methodNotFound
+	})
+}
+
+func TestNodeIdentityDataSource(t *testing.T) {
+	tests := []struct {
+		name        string
+		coinbase    interface{}
+		coinbaseErr *rpc.Error
+		want        string
+	}{
+		{name: "validator", coinbase: "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", want: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+		{name: "no validator key", coinbase: "0x0000000000000000000000000000000000000000"},
+		{name: "coinbase not served", coinbaseErr: methodNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := identityNode(t, tt.coinbase, tt.coinbaseErr)
+
+			var model nodeIdentityDataSourceModel
+			diags := readDataSource(t, NewNodeIdentityDataSource(), map[string]tftypes.Value{
+				"rpc_url": tftypes.NewValue(tftypes.String, url),
+			}, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+			if model.NodeID.ValueString() != testNodeID {
+				t.Errorf("node_id = %s, want %s", model.NodeID, testNodeID)
+			}
+			if tt.want == "" {
+				if !model.ValidatorAddress.IsNull() {
+					t.Errorf("validator_address = %s, want null", model.ValidatorAddress)
+				}
+				return
+			}
+			if model.ValidatorAddress.ValueString() != tt.want {
+				t.Errorf("validator_address = %s, want %s", model.ValidatorAddress, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodeIdentityDataSourceErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         func(t *testing.T) string
+		wantSummary string
+	}{
+		{
+			name: "admin namespace not exposed",
+			url: func(t *testing.T) string {
+				return mockNode(t, func(string, []json.RawMessage) (interface{}, *rpc.Error) {
+					return nil, methodNotFound
+				})
+			},
+			wantSummary: "Admin namespace not available",
+		},
+		{
+			name: "coinbase error",
+			url: func(t *testing.T) string {
+				return identityNode(t, nil, &rpc.Error{Code: -32000, Message: "internal error"})
+			},
+			wantSummary: "Unable to get validator address",
+		},
+		{
+			name: "invalid coinbase",
+			url: func(t *testing.T) string {
+				return identityNode(t, "0x1234", nil)
+			},
+			wantSummary: "Unable to get validator address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var model nodeIdentityDataSourceModel
+			diags := readDataSource(t, NewNodeIdentityDataSource(), map[string]tftypes.Value{
+				"rpc_url": tftypes.NewValue(tftypes.String, tt.url(t)),
+			}, &model)
+			if !diags.HasError() {
+				t.Fatalf("reading the node identity succeeded: %s", model.NodeID)
+			}
+			if got := diags.Errors()[0].Summary(); got != tt.wantSummary {
+				t.Errorf("got %q, want %q", got, tt.wantSummary)
+			}
+		})
+	}
+}
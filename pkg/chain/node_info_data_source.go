@@ -0,0 +1,135 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeInfoDataSource{}
+)
+
+// nodeInfoDataSourceModel maps the data source schema data.
+type nodeInfoDataSourceModel struct {
+	RPCURL types.String `tfsdk:"rpc_url"`
+
+	NodeID      types.String `tfsdk:"node_id"`
+	Name        types.String `tfsdk:"name"`
+	Enode       types.String `tfsdk:"enode"`
+	ListenAddrs []string     `tfsdk:"listen_addrs"`
+}
+
+// NewNodeInfoDataSource is a helper function to simplify the provider implementation.
+func NewNodeInfoDataSource() datasource.DataSource {
+	return &nodeInfoDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// nodeInfoDataSource is the data source implementation.
+type nodeInfoDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *nodeInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_info"
+}
+
+// Schema defines the schema for the data source.
+func (d *nodeInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the identity a running node reports about itself with `admin_nodeInfo`, " +
+			"for example to register it as a bootnode of other nodes. The endpoint must expose the `admin` namespace.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"node_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Node ID.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Client name and version of the node. Empty if the node does not report it.",
+			},
+			"enode": schema.StringAttribute{
+				Computed:    true,
+				Description: "Enode URL of the node. Empty if the node does not report it.",
+			},
+			"listen_addrs": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Addresses the node listens on for peers, such as libp2p multiaddrs.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *nodeInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read queries the node identity.
+func (d *nodeInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nodeInfoDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	info, err := client.NodeInfo(ctx)
+	if rpc.IsMethodNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Admin namespace not available",
+			fmt.Sprintf("The endpoint does not serve admin_nodeInfo: %s. Expose the `admin` JSON-RPC namespace on the node, "+
+				"or read the node ID from the node secrets, for example with the `polygonedge_decode_network_key` data source.", err),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get node info", err.Error())
+		return
+	}
+	if info.ID == "" {
+		resp.Diagnostics.AddError("Unable to get node info", "The admin_nodeInfo result has no node id.")
+		return
+	}
+
+	state.NodeID = types.StringValue(info.ID)
+	state.Name = types.StringValue(info.Name)
+	state.Enode = types.StringValue(info.Enode)
+	state.ListenAddrs = info.ListenAddrs
+	if state.ListenAddrs == nil {
+		state.ListenAddrs = []string{}
+	}
+	if info.ListenAddr != "" {
+		state.ListenAddrs = append(state.ListenAddrs, info.ListenAddr)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,107 @@
+package chain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+const testNodeID = "16Uiu2HAmJxxH1tScDX2rLGSU9exnuvZKNM9SoK3v315azp68DLPW"
+
+func TestNodeInfoDataSource(t *testing.T) {
+	tests := []struct {
+		name string
+		info map[string]interface{}
+		want []string
+	}{
+		{
+			name: "listen addrs",
+			info: map[string]interface{}{
+				"id":          testNodeID,
+				"name":        "polygon-edge/v0.8.1",
+				"enode":       "enode://abcd@10.0.0.1:1478",
+				"listenAddrs": []string{"/ip4/10.0.0.1/tcp/1478", "/ip4/127.0.0.1/tcp/1478"},
+			},
+			want: []string{"/ip4/10.0.0.1/tcp/1478", "/ip4/127.0.0.1/tcp/1478"},
+		},
+		{
+			name: "single listen addr",
+			info: map[string]interface{}{
+				"id":         testNodeID,
+				"name":       "polygon-edge/v0.8.1",
+				"enode":      "enode://abcd@10.0.0.1:1478",
+				"listenAddr": "/ip4/10.0.0.1/tcp/1478",
+			},
+			want: []string{"/ip4/10.0.0.1/tcp/1478"},
+		},
+		{
+			name: "no listen addr",
+			info: map[string]interface{}{"id": testNodeID},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := mockNode(t, func(method string, _ []json.RawMessage) (interface{}, *rpc.Error) {
+				if method != "admin_nodeInfo" {
+					return nil, methodNotFound
+				}
+				return tt.info, nil
+			})
+
+			var model nodeInfoDataSourceModel
+			diags := readDataSource(t, NewNodeInfoDataSource(), map[string]tftypes.Value{
+				"rpc_url": tftypes.NewValue(tftypes.String, url),
+			}, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+			if model.NodeID.ValueString() != testNodeID {
+				t.Errorf("node_id = %s, want %s", model.NodeID, testNodeID)
+			}
+			name, _ := tt.info["name"].(string)
+			enode, _ := tt.info["enode"].(string)
+			if model.Name.ValueString() != name || model.Enode.ValueString() != enode {
+				t.Errorf("name, enode = %s, %s, want %q, %q", model.Name, model.Enode, name, enode)
+			}
+			if model.ListenAddrs == nil || strings.Join(model.ListenAddrs, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("listen_addrs = %v, want %v", model.ListenAddrs, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodeInfoDataSourceErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		result      interface{}
+		err         *rpc.Error
+		wantSummary string
+	}{
+		{name: "admin namespace not exposed", err: methodNotFound, wantSummary: "Admin namespace not available"},
+		{name: "node error", err: &rpc.Error{Code: -32000, Message: "internal error"}, wantSummary: "Unable to get node info"},
+		{name: "no node id", result: map[string]interface{}{"name": "polygon-edge"}, wantSummary: "Unable to get node info"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := mockNode(t, func(string, []json.RawMessage) (interface{}, *rpc.Error) {
+				return tt.result, tt.err
+			})
+
+			var model nodeInfoDataSourceModel
+			diags := readDataSource(t, NewNodeInfoDataSource(), map[string]tftypes.Value{
+				"rpc_url": tftypes.NewValue(tftypes.String, url),
+			}, &model)
+			if !diags.HasError() {
+				t.Fatalf("reading the node info succeeded: %s", model.NodeID)
+			}
+			if got := diags.Errors()[0].Summary(); got != tt.wantSummary {
+				t.Errorf("got %q, want %q", got, tt.wantSummary)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// defaultNonceBlock counts the transactions still in the pool, so the nonce is the one of the next transaction.
+const defaultNonceBlock = "pending"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nonceDataSource{}
+	_ datasource.DataSourceWithConfigure = &nonceDataSource{}
+)
+
+// nonceDataSourceModel maps the data source schema data.
+type nonceDataSourceModel struct {
+	RPCURL  types.String `tfsdk:"rpc_url"`
+	Address types.String `tfsdk:"address"`
+	Block   types.String `tfsdk:"block"`
+
+	Nonce types.Int64 `tfsdk:"nonce"`
+}
+
+// NewNonceDataSource is a helper function to simplify the provider implementation.
+func NewNonceDataSource() datasource.DataSource {
+	return &nonceDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// nonceDataSource is the data source implementation.
+type nonceDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *nonceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nonce"
+}
+
+// Schema defines the schema for the data source.
+func (d *nonceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the transaction count of an account with `eth_getTransactionCount`, " +
+			"to set explicit nonces and order deployments deterministically.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "Address of the account.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"block": schema.StringAttribute{
+				Optional: true,
+				Description: "Block the transactions are counted at, `latest`, `pending`, `earliest` or a block number. " +
+					"`pending` also counts the transactions waiting in the pool, so the nonce is the one of the next transaction, " +
+					"`latest` only counts the included ones. Defaults to `" + defaultNonceBlock + "`.",
+				Validators: []validator.String{
+					validators.BlockTag(),
+				},
+			},
+			"nonce": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Transaction count of the account.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *nonceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read reads the transaction count.
+func (d *nonceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nonceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	block := defaultNonceBlock
+	if !state.Block.IsNull() {
+		block = state.Block.ValueString()
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	nonce, err := client.NonceAt(ctx, edgetypes.StringToAddress(state.Address.ValueString()), block)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get nonce", err.Error())
+		return
+	}
+	state.Nonce = types.Int64Value(int64(nonce))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
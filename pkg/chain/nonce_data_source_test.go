@@ -0,0 +1,55 @@
+package chain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+func TestNonceDataSource(t *testing.T) {
+	// The node has 5 included transactions of the address and 2 more waiting in the pool.
+	counts := map[string]string{"latest": "0x5", "pending": "0x7", "0x10": "0x3"}
+	tests := []struct {
+		name  string
+		block interface{}
+		want  int64
+	}{
+		{name: "default", want: 7},
+		{name: "pending", block: "pending", want: 7},
+		{name: "latest", block: "latest", want: 5},
+		{name: "block number", block: "16", want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var address string
+			url := mockNode(t, func(method string, params []json.RawMessage) (interface{}, *rpc.Error) {
+				if method != "eth_getTransactionCount" {
+					return nil, methodNotFound
+				}
+				var block string
+				_ = json.Unmarshal(params[0], &address)
+				_ = json.Unmarshal(params[1], &block)
+				return counts[block], nil
+			})
+
+			var model nonceDataSourceModel
+			diags := readDataSource(t, NewNonceDataSource(), map[string]tftypes.Value{
+				"rpc_url": tftypes.NewValue(tftypes.String, url),
+				"address": tftypes.NewValue(tftypes.String, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+				"block":   tftypes.NewValue(tftypes.String, tt.block),
+			}, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+			if model.Nonce.ValueInt64() != tt.want {
+				t.Errorf("nonce = %d, want %d", model.Nonce.ValueInt64(), tt.want)
+			}
+			if address != "0x70997970C51812dc3A010C7d01b50e0d17dc79C8" {
+				t.Errorf("counted the transactions of %s", address)
+			}
+		})
+	}
+}
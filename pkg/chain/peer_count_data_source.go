@@ -0,0 +1,97 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &peerCountDataSource{}
+	_ datasource.DataSourceWithConfigure = &peerCountDataSource{}
+)
+
+// peerCountDataSourceModel maps the data source schema data.
+type peerCountDataSourceModel struct {
+	RPCURL types.String `tfsdk:"rpc_url"`
+
+	PeerCount types.Int64 `tfsdk:"peer_count"`
+}
+
+// NewPeerCountDataSource is a helper function to simplify the provider implementation.
+func NewPeerCountDataSource() datasource.DataSource {
+	return &peerCountDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// peerCountDataSource is the data source implementation.
+type peerCountDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *peerCountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_peer_count"
+}
+
+// Schema defines the schema for the data source.
+func (d *peerCountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the number of peers a node is connected to, to check the node is part of the network, for example in a `precondition`.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"peer_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of connected peers.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *peerCountDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read queries the peer count.
+func (d *peerCountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state peerCountDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	peerCount, err := client.PeerCount(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get peer count", err.Error())
+		return
+	}
+
+	state.PeerCount = types.Int64Value(int64(peerCount))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
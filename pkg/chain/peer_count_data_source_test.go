@@ -0,0 +1,56 @@
+package chain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+func TestPeerCountDataSource(t *testing.T) {
+	tests := []struct {
+		result string
+		want   int64
+	}{
+		{result: "0x0", want: 0},
+		{result: "0x5", want: 5},
+		{result: "0x1f", want: 31},
+	}
+	for _, tt := range tests {
+		t.Run(tt.result, func(t *testing.T) {
+			url := mockNode(t, func(method string, _ []json.RawMessage) (interface{}, *rpc.Error) {
+				if method != "net_peerCount" {
+					return nil, methodNotFound
+				}
+				return tt.result, nil
+			})
+
+			var model peerCountDataSourceModel
+			diags := readDataSource(t, NewPeerCountDataSource(), map[string]tftypes.Value{
+				"rpc_url": tftypes.NewValue(tftypes.String, url),
+			}, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+			if model.PeerCount.ValueInt64() != tt.want {
+				t.Errorf("peer_count = %d, want %d", model.PeerCount.ValueInt64(), tt.want)
+			}
+		})
+	}
+}
+
+func TestPeerCountDataSourceInvalidResult(t *testing.T) {
+	url := mockNode(t, func(string, []json.RawMessage) (interface{}, *rpc.Error) {
+		return "0xzz", nil
+	})
+
+	var model peerCountDataSourceModel
+	diags := readDataSource(t, NewPeerCountDataSource(), map[string]tftypes.Value{
+		"rpc_url": tftypes.NewValue(tftypes.String, url),
+	}, &model)
+	if !diags.HasError() {
+		t.Fatalf("reading an invalid peer count succeeded: %s", model.PeerCount)
+	}
+}
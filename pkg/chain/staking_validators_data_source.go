@@ -0,0 +1,157 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	"github.com/0xPolygon/polygon-edge/contracts/staking"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// stakingValidatorsMethod is the getter of the staking contract returning the validator addresses.
+const stakingValidatorsMethod = "validators"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &stakingValidatorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &stakingValidatorsDataSource{}
+)
+
+// stakingValidatorsDataSourceModel maps the data source schema data.
+type stakingValidatorsDataSourceModel struct {
+	RPCURL          types.String `tfsdk:"rpc_url"`
+	ContractAddress types.String `tfsdk:"contract_address"`
+	Block           types.String `tfsdk:"block"`
+
+	Validators types.List `tfsdk:"validators"`
+}
+
+// NewStakingValidatorsDataSource is a helper function to simplify the provider implementation.
+func NewStakingValidatorsDataSource() datasource.DataSource {
+	return &stakingValidatorsDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// stakingValidatorsDataSource is the data source implementation.
+type stakingValidatorsDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *stakingValidatorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_staking_validators"
+}
+
+// Schema defines the schema for the data source.
+func (d *stakingValidatorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the validator set of an IBFT proof of stake chain from the staking contract. " +
+			"The `validators()` getter is called with `eth_call` and its result decoded with the polygon-edge staking ABI, " +
+			"so only the standard JSON-RPC interface of the node is needed.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"contract_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Address of the staking contract. Defaults to the polygon-edge staking contract, `" + staking.AddrStakingContract.String() + "`.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"block": schema.StringAttribute{
+				Optional:    true,
+				Description: "Block the validator set is read at, `latest`, `pending`, `earliest` or a block number. Defaults to `latest`.",
+				Validators: []validator.String{
+					validators.BlockTag(),
+				},
+			},
+			"validators": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Addresses of the validators, in the order the contract returns them.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *stakingValidatorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read calls the staking contract and decodes the validator addresses.
+func (d *stakingValidatorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state stakingValidatorsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The address is validated, so parsing cannot fail.
+	contractAddress := staking.AddrStakingContract
+	if !state.ContractAddress.IsNull() {
+		contractAddress = edgetypes.StringToAddress(state.ContractAddress.ValueString())
+	}
+	method := abis.StakingABI.Methods[stakingValidatorsMethod]
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	result, err := client.CallContract(ctx, rpc.CallMsg{To: &contractAddress, Data: method.ID()}, state.Block.ValueString())
+	if reason, reverted := rpc.RevertReason(err); reverted {
+		resp.Diagnostics.AddError("Call reverted", fmt.Sprintf("The validators call reverts: %s.", reason))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get staking validators", err.Error())
+		return
+	}
+	if len(result) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to get staking validators",
+			fmt.Sprintf("The call returned no data, check that %s is a staking contract.", contractAddress),
+		)
+		return
+	}
+
+	addresses, err := staking.DecodeValidators(method, result)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to decode staking validators", err.Error())
+		return
+	}
+
+	validatorAddresses := make([]string, len(addresses))
+	for i, address := range addresses {
+		validatorAddresses[i] = d.providerData.FormatAddress(address)
+	}
+	validatorList, diags := types.ListValueFrom(ctx, types.StringType, validatorAddresses)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Validators = validatorList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,102 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+func TestStakingValidatorsDataSource(t *testing.T) {
+	want := []string{"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"}
+	encoded, err := abi.Encode([]interface{}{[]ethgo.Address{ethgo.HexToAddress(want[0]), ethgo.HexToAddress(want[1])}}, abi.MustNewType("tuple(address[])"))
+	if err != nil {
+		t.Fatalf("unable to encode the validators: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		attrs     map[string]tftypes.Value
+		wantTo    string
+		wantBlock string
+	}{
+		{
+			name:      "staking contract",
+			wantTo:    "0x0000000000000000000000000000000000001001",
+			wantBlock: "latest",
+		},
+		{
+			name: "contract address and block",
+			attrs: map[string]tftypes.Value{
+				"contract_address": tftypes.NewValue(tftypes.String, "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC"),
+				"block":            tftypes.NewValue(tftypes.String, "16"),
+			},
+			wantTo:    "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC",
+			wantBlock: "0x10",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				call  map[string]string
+				block string
+			)
+			url := mockNode(t, func(method string, params []json.RawMessage) (interface{}, *rpc.Error) {
+				if method != "eth_call" {
+					return nil, methodNotFound
+				}
+				_ = json.Unmarshal(params[0], &call)
+				_ = json.Unmarshal(params[1], &block)
+				return hex.EncodeToHex(encoded), nil
+			})
+
+			attrs := map[string]tftypes.Value{"rpc_url": tftypes.NewValue(tftypes.String, url)}
+			for name, value := range tt.attrs {
+				attrs[name] = value
+			}
+			var model stakingValidatorsDataSourceModel
+			if diags := readDataSource(t, NewStakingValidatorsDataSource(), attrs, &model); diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+
+			if !strings.EqualFold(call["to"], tt.wantTo) || block != tt.wantBlock {
+				t.Errorf("called %s at block %s, want %s at %s", call["to"], block, tt.wantTo, tt.wantBlock)
+			}
+			if got, want := call["data"], hex.EncodeToHex(abis.StakingABI.Methods["validators"].ID()); got != want {
+				t.Errorf("call data = %s, want the validators() selector %s", got, want)
+			}
+			var validators []string
+			if diags := model.Validators.ElementsAs(context.Background(), &validators, false); diags.HasError() {
+				t.Fatalf("unable to read validators: %v", diags)
+			}
+			if strings.Join(validators, ",") != strings.Join(want, ",") {
+				t.Errorf("validators = %v, want %v", validators, want)
+			}
+		})
+	}
+}
+
+func TestStakingValidatorsDataSourceNoContract(t *testing.T) {
+	url := mockNode(t, func(string, []json.RawMessage) (interface{}, *rpc.Error) {
+		return "0x", nil
+	})
+
+	var model stakingValidatorsDataSourceModel
+	diags := readDataSource(t, NewStakingValidatorsDataSource(), map[string]tftypes.Value{
+		"rpc_url": tftypes.NewValue(tftypes.String, url),
+	}, &model)
+	if !diags.HasError() {
+		t.Fatalf("reading validators of an account without code succeeded: %s", model.Validators)
+	}
+	if got := diags.Errors()[0].Detail(); !strings.Contains(got, "check that 0x0000000000000000000000000000000000001001 is a staking contract") {
+		t.Errorf("got %q, want the empty result error", got)
+	}
+}
@@ -0,0 +1,127 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &storageAtDataSource{}
+	_ datasource.DataSourceWithConfigure = &storageAtDataSource{}
+)
+
+// storageAtDataSourceModel maps the data source schema data.
+type storageAtDataSourceModel struct {
+	RPCURL  types.String `tfsdk:"rpc_url"`
+	Address types.String `tfsdk:"address"`
+	Slot    types.String `tfsdk:"slot"`
+	Block   types.String `tfsdk:"block"`
+
+	Value types.String `tfsdk:"value"`
+}
+
+// NewStorageAtDataSource is a helper function to simplify the provider implementation.
+func NewStorageAtDataSource() datasource.DataSource {
+	return &storageAtDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// storageAtDataSource is the data source implementation.
+type storageAtDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *storageAtDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_at"
+}
+
+// Schema defines the schema for the data source.
+func (d *storageAtDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a storage slot of an account with `eth_getStorageAt`, to verify contract state after genesis.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "Address of the account.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"slot": schema.StringAttribute{
+				Required:    true,
+				Description: "Storage slot, a decimal or 0x prefixed hex integer.",
+				Validators: []validator.String{
+					validators.StorageSlot(),
+				},
+			},
+			"block": schema.StringAttribute{
+				Optional:    true,
+				Description: "Block the storage is read at, `latest`, `pending`, `earliest` or a block number. Defaults to `latest`.",
+				Validators: []validator.String{
+					validators.BlockTag(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Computed:    true,
+				Description: "Value of the storage slot, as 32 hex encoded bytes.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *storageAtDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read reads the storage slot.
+func (d *storageAtDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state storageAtDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Both values are validated, so parsing cannot fail.
+	address := edgetypes.StringToAddress(state.Address.ValueString())
+	slot, _ := validators.ParseStorageSlot(state.Slot.ValueString())
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	value, err := client.StorageAt(ctx, address, edgetypes.BytesToHash(slot.Bytes()), state.Block.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read storage", err.Error())
+		return
+	}
+	state.Value = types.StringValue(value.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
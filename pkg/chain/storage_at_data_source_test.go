@@ -0,0 +1,58 @@
+package chain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+func TestStorageAtDataSource(t *testing.T) {
+	value := "0x" + strings.Repeat("0", 62) + "2a"
+	tests := []struct {
+		slot      string
+		block     interface{}
+		wantSlot  string
+		wantBlock string
+	}{
+		{slot: "0", wantSlot: "0x" + strings.Repeat("0", 64), wantBlock: "latest"},
+		{slot: "10", block: "pending", wantSlot: "0x" + strings.Repeat("0", 63) + "a", wantBlock: "pending"},
+		{slot: "0x10", block: "16", wantSlot: "0x" + strings.Repeat("0", 62) + "10", wantBlock: "0x10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.slot, func(t *testing.T) {
+			var params []string
+			url := mockNode(t, func(method string, raw []json.RawMessage) (interface{}, *rpc.Error) {
+				if method != "eth_getStorageAt" {
+					return nil, methodNotFound
+				}
+				params = make([]string, len(raw))
+				for i := range raw {
+					_ = json.Unmarshal(raw[i], &params[i])
+				}
+				return value, nil
+			})
+
+			var model storageAtDataSourceModel
+			diags := readDataSource(t, NewStorageAtDataSource(), map[string]tftypes.Value{
+				"rpc_url": tftypes.NewValue(tftypes.String, url),
+				"address": tftypes.NewValue(tftypes.String, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+				"slot":    tftypes.NewValue(tftypes.String, tt.slot),
+				"block":   tftypes.NewValue(tftypes.String, tt.block),
+			}, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+			if model.Value.ValueString() != value {
+				t.Errorf("value = %s, want %s", model.Value.ValueString(), value)
+			}
+			if len(params) != 3 || !strings.EqualFold(params[0], "0x70997970C51812dc3A010C7d01b50e0d17dc79C8") ||
+				params[1] != tt.wantSlot || params[2] != tt.wantBlock {
+				t.Errorf("eth_getStorageAt params = %v, want the address, %s and %s", params, tt.wantSlot, tt.wantBlock)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &syncingDataSource{}
+	_ datasource.DataSourceWithConfigure = &syncingDataSource{}
+)
+
+// syncingDataSourceModel maps the data source schema data.
+type syncingDataSourceModel struct {
+	RPCURL types.String `tfsdk:"rpc_url"`
+
+	Syncing       types.Bool  `tfsdk:"syncing"`
+	StartingBlock types.Int64 `tfsdk:"starting_block"`
+	CurrentBlock  types.Int64 `tfsdk:"current_block"`
+	HighestBlock  types.Int64 `tfsdk:"highest_block"`
+}
+
+// NewSyncingDataSource is a helper function to simplify the provider implementation.
+func NewSyncingDataSource() datasource.DataSource {
+	return &syncingDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// syncingDataSource is the data source implementation.
+type syncingDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *syncingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_syncing"
+}
+
+// Schema defines the schema for the data source.
+func (d *syncingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the sync status of a node, to wait for a node to be fully synced before depending on it, " +
+			"for example in a `precondition`.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"syncing": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the node is syncing.",
+			},
+			"starting_block": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Block the node started syncing from. Null if the node is not syncing.",
+			},
+			"current_block": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Block the node is synced to. Null if the node is not syncing.",
+			},
+			"highest_block": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Highest block known to the node. Null if the node is not syncing.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *syncingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read queries the sync status.
+func (d *syncingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state syncingDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	progress, err := client.Syncing(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get sync status", err.Error())
+		return
+	}
+
+	state.Syncing = types.BoolValue(progress != nil)
+	state.StartingBlock = types.Int64Null()
+	state.CurrentBlock = types.Int64Null()
+	state.HighestBlock = types.Int64Null()
+	if progress != nil {
+		state.StartingBlock = types.Int64Value(int64(progress.StartingBlock))
+		state.CurrentBlock = types.Int64Value(int64(progress.CurrentBlock))
+		state.HighestBlock = types.Int64Value(int64(progress.HighestBlock))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,74 @@
+package chain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+func TestSyncingDataSource(t *testing.T) {
+	tests := []struct {
+		name   string
+		result interface{}
+	}{
+		{
+			name:   "synced",
+			result: false,
+		},
+		{
+			name:   "syncing",
+			result: map[string]string{"startingBlock": "0x10", "currentBlock": "0x20", "highestBlock": "0x64"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := mockNode(t, func(method string, _ []json.RawMessage) (interface{}, *rpc.Error) {
+				if method != "eth_syncing" {
+					return nil, methodNotFound
+				}
+				return tt.result, nil
+			})
+
+			var model syncingDataSourceModel
+			diags := readDataSource(t, NewSyncingDataSource(), map[string]tftypes.Value{
+				"rpc_url": tftypes.NewValue(tftypes.String, url),
+			}, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+
+			if tt.result == false {
+				if model.Syncing.ValueBool() {
+					t.Error("syncing = true, want false")
+				}
+				if !model.StartingBlock.IsNull() || !model.CurrentBlock.IsNull() || !model.HighestBlock.IsNull() {
+					t.Errorf("blocks = %s, %s, %s, want null when synced", model.StartingBlock, model.CurrentBlock, model.HighestBlock)
+				}
+				return
+			}
+			if !model.Syncing.ValueBool() {
+				t.Error("syncing = false, want true")
+			}
+			if model.StartingBlock.ValueInt64() != 16 || model.CurrentBlock.ValueInt64() != 32 || model.HighestBlock.ValueInt64() != 100 {
+				t.Errorf("blocks = %s, %s, %s, want 16, 32, 100", model.StartingBlock, model.CurrentBlock, model.HighestBlock)
+			}
+		})
+	}
+}
+
+func TestSyncingDataSourceInvalidResult(t *testing.T) {
+	url := mockNode(t, func(string, []json.RawMessage) (interface{}, *rpc.Error) {
+		return true, nil
+	})
+
+	var model syncingDataSourceModel
+	diags := readDataSource(t, NewSyncingDataSource(), map[string]tftypes.Value{
+		"rpc_url": tftypes.NewValue(tftypes.String, url),
+	}, &model)
+	if !diags.HasError() {
+		t.Fatalf("reading a true sync status succeeded: %s", model.Syncing)
+	}
+}
@@ -0,0 +1,288 @@
+package contract
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &contractResource{}
+	_ resource.ResourceWithConfigure      = &contractResource{}
+	_ resource.ResourceWithValidateConfig = &contractResource{}
+)
+
+// contractResourceModel maps the resource schema data.
+type contractResourceModel struct {
+	RPCURL             types.String `tfsdk:"rpc_url"`
+	DeployerKeyEncoded types.String `tfsdk:"deployer_key_encoded"`
+	Bytecode           types.String `tfsdk:"bytecode"`
+	ConstructorArgs    types.String `tfsdk:"constructor_args"`
+	GasLimit           types.Int64  `tfsdk:"gas_limit"`
+	GasPrice           types.String `tfsdk:"gas_price"`
+
+	ChainID          types.Int64 `tfsdk:"chain_id"`
+	SkipChainIDCheck types.Bool  `tfsdk:"skip_chain_id_check"`
+
+	Confirmation *rpc.ConfirmationModel `tfsdk:"confirmation"`
+
+	Address types.String `tfsdk:"address"`
+	TxHash  types.String `tfsdk:"tx_hash"`
+}
+
+// NewContractResource is a helper function to simplify the provider implementation.
+func NewContractResource() resource.Resource {
+	return &contractResource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// contractResource is the resource implementation.
+type contractResource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the resource type name.
+func (r *contractResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contract"
+}
+
+// Schema defines the schema for the resource.
+func (r *contractResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Deploys a contract and waits for the deployment to be included. " +
+			"Destroying this resource does not remove the contract from the chain.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node the deployment is sent to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployer_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Encoded validator key of the account deploying the contract.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+			},
+			"bytecode": schema.StringAttribute{
+				Required:    true,
+				Description: "Hex encoded creation bytecode of the contract.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.Hex(),
+				},
+			},
+			"constructor_args": schema.StringAttribute{
+				Optional:    true,
+				Description: "Hex encoded, ABI encoded constructor arguments, appended to the bytecode.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.Hex(),
+				},
+			},
+			"gas_limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Gas limit of the deployment. Estimated by the endpoint if not set.",
+			},
+			"gas_price": schema.StringAttribute{
+				Optional:    true,
+				Description: "Gas price in wei. Defaults to the gas price suggested by the endpoint.",
+				Validators: []validator.String{
+					validators.Wei(),
+				},
+			},
+			"chain_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "Expected chain id of the endpoint. The contract is not deployed if the endpoint serves another chain. " +
+					"Defaults to the provider `chain_id`.",
+			},
+			"skip_chain_id_check": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip comparing the endpoint chain id with `chain_id`.",
+			},
+			"confirmation": rpc.ConfirmationSchema(),
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Address of the deployed contract.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tx_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the deployment transaction.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the resource.
+func (r *contractResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = data
+}
+
+// ValidateConfig ensures the deployment has code to deploy and a usable gas limit.
+func (r *contractResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config contractResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Bytecode.IsUnknown() && config.Bytecode.ValueString() == "0x" {
+		resp.Diagnostics.AddAttributeError(path.Root("bytecode"), "Missing bytecode", "The contract bytecode must not be empty.")
+	}
+	if !config.GasLimit.IsNull() && !config.GasLimit.IsUnknown() && config.GasLimit.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("gas_limit"), "Invalid gas limit", "Gas limit must be positive.")
+	}
+}
+
+func (r *contractResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan contractResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	confirmation, diags := plan.Confirmation.Config(path.Root("confirmation"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployerKey, err := crypto.BytesToECDSAPrivateKey([]byte(plan.DeployerKeyEncoded.ValueString()))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("deployer_key_encoded"), diagnostics.ValidatorKey, err))
+		return
+	}
+	deployer := crypto.PubKeyToAddress(&deployerKey.PublicKey)
+
+	// Both values are validated hex, so decoding cannot fail.
+	input, _ := hex.DecodeHex(plan.Bytecode.ValueString())
+	if !plan.ConstructorArgs.IsNull() {
+		args, _ := hex.DecodeHex(plan.ConstructorArgs.ValueString())
+		input = append(input, args...)
+	}
+
+	client := rpc.NewClient(plan.RPCURL.ValueString(), r.providerData.RPC)
+	var expectedChainID uint64
+	if !plan.SkipChainIDCheck.ValueBool() {
+		expectedChainID = r.providerData.ChainIDOrDefault(plan.ChainID)
+	}
+	chainID, err := client.GuardedChainID(ctx, expectedChainID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to verify chain id", err.Error())
+		return
+	}
+
+	gasPrice, _ := new(big.Int).SetString(plan.GasPrice.ValueString(), 10)
+	if plan.GasPrice.IsNull() {
+		if gasPrice, err = client.GasPrice(ctx); err != nil {
+			resp.Diagnostics.AddError("Unable to get gas price", err.Error())
+			return
+		}
+	}
+	gas := uint64(plan.GasLimit.ValueInt64())
+	if plan.GasLimit.IsNull() {
+		if gas, err = client.EstimateGas(ctx, rpc.CallMsg{From: &deployer, Data: input}); err != nil {
+			resp.Diagnostics.AddError("Unable to estimate deployment gas", err.Error())
+			return
+		}
+	}
+	nonce, err := client.PendingNonce(ctx, deployer)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get deployer nonce", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deploying contract", map[string]interface{}{"from": deployer.String(), "nonce": nonce, "gas": gas})
+	hash, err := client.SignAndSend(ctx, deployerKey, chainID, &edgetypes.Transaction{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      gas,
+		Value:    big.NewInt(0),
+		Input:    input,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to deploy contract", err.Error())
+		return
+	}
+
+	// Record the deployment before waiting for it, so that it is kept in state even if it is not confirmed.
+	plan.TxHash = types.StringValue(hash.String())
+	plan.Address = types.StringValue(r.providerData.FormatAddress(crypto.CreateAddress(deployer, nonce)))
+
+	receipt, err := client.WaitForConfirmation(ctx, hash, confirmation)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to confirm deployment", err.Error())
+	} else if !receipt.Succeeded() {
+		resp.Diagnostics.AddError("Deployment failed", fmt.Sprintf("Transaction %s was reverted.", hash))
+	} else if receipt.ContractAddress != nil {
+		plan.Address = types.StringValue(r.providerData.FormatAddress(edgetypes.StringToAddress(*receipt.ContractAddress)))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *contractResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	// NO-OP: a deployed contract cannot change, the state already holds everything there is to read.
+	tflog.Debug(ctx, "Reading contract from state")
+}
+
+func (r *contractResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only settings which do not affect the deployment can be updated in place.
+	var plan contractResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *contractResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing contract from state")
+}
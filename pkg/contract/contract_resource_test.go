@@ -0,0 +1,219 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	// testDeployerKey is an encoded validator key, the first Hardhat development account.
+	testDeployerKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	testDeployer    = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+)
+
+// mockNode is a JSON-RPC endpoint which includes deployments at once.
+type mockNode struct {
+	mu     sync.Mutex
+	nonce  uint64
+	sent   []*edgetypes.Transaction
+	status string
+}
+
+func newMockNode(t *testing.T) (*mockNode, string) {
+	node := &mockNode{status: "0x1"}
+	server := httptest.NewServer(http.HandlerFunc(node.serve))
+	t.Cleanup(server.Close)
+	return node, server.URL
+}
+
+func (n *mockNode) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     uint64            `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var result interface{}
+	switch req.Method {
+	case "eth_chainId":
+		result = "0x64"
+	case "eth_gasPrice":
+		result = "0x3b9aca00"
+	case "eth_estimateGas":
+		result = "0x186a0"
+	case "eth_getTransactionCount":
+		result = fmt.Sprintf("0x%x", n.nonce)
+	case "eth_blockNumber":
+		result = "0x1"
+	case "eth_sendRawTransaction":
+		var encoded string
+		_ = json.Unmarshal(req.Params[0], &encoded)
+		raw, _ := hex.DecodeHex(encoded)
+		tx := &edgetypes.Transaction{}
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		tx.ComputeHash()
+		n.sent = append(n.sent, tx)
+		result = tx.Hash.String()
+	case "eth_getTransactionReceipt":
+		var hash string
+		_ = json.Unmarshal(req.Params[0], &hash)
+		sender := edgetypes.StringToAddress(testDeployer)
+		result = map[string]interface{}{
+			"transactionHash": hash,
+			"blockNumber":     "0x1",
+			"status":          n.status,
+			"contractAddress": crypto.CreateAddress(sender, n.nonce).String(),
+		}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+// create applies a new contract resource deploying through the node at url.
+func create(t *testing.T, url string, attrs map[string]tftypes.Value) (contractResourceModel, *resource.CreateResponse) {
+	t.Helper()
+	ctx := context.Background()
+	r := NewContractResource()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	confirmationType := objectType.AttributeTypes["confirmation"].(tftypes.Object)
+
+	config := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	plan := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		config[name] = tftypes.NewValue(typ, nil)
+		plan[name] = tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+	attrs["rpc_url"] = tftypes.NewValue(tftypes.String, url)
+	attrs["deployer_key_encoded"] = tftypes.NewValue(tftypes.String, testDeployerKey)
+	attrs["confirmation"] = tftypes.NewValue(confirmationType, map[string]tftypes.Value{
+		"timeout":       tftypes.NewValue(tftypes.String, "2s"),
+		"poll_interval": tftypes.NewValue(tftypes.String, "10ms"),
+		"confirmations": tftypes.NewValue(tftypes.Number, nil),
+	})
+	for name, value := range attrs {
+		config[name] = value
+		plan[name] = value
+	}
+	for _, name := range []string{"constructor_args", "gas_limit", "gas_price", "chain_id", "skip_chain_id_check"} {
+		if _, ok := attrs[name]; !ok {
+			plan[name] = config[name]
+		}
+	}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, config)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, plan)},
+	}, resp)
+
+	var state contractResourceModel
+	if !resp.State.Raw.IsNull() {
+		if diags := resp.State.Get(ctx, &state); diags.HasError() {
+			t.Fatalf("unable to get state: %v", diags)
+		}
+	}
+	return state, resp
+}
+
+func TestContractResourceDeploys(t *testing.T) {
+	node, url := newMockNode(t)
+	node.nonce = 3
+
+	state, resp := create(t, url, map[string]tftypes.Value{
+		"bytecode":         tftypes.NewValue(tftypes.String, "0x6080604052"),
+		"constructor_args": tftypes.NewValue(tftypes.String, "0x"+strings.Repeat("0", 62)+"2a"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(node.sent) != 1 {
+		t.Fatalf("sent %d transactions, want 1", len(node.sent))
+	}
+
+	tx := node.sent[0]
+	if tx.To != nil {
+		t.Errorf("deployment sent to %s, want no recipient", tx.To)
+	}
+	if got, want := hex.EncodeToHex(tx.Input), "0x6080604052"+strings.Repeat("0", 62)+"2a"; got != want {
+		t.Errorf("input = %s, want the bytecode followed by the constructor arguments %s", got, want)
+	}
+	if tx.Nonce != 3 || tx.Gas != 100000 || tx.GasPrice.Uint64() != 1000000000 {
+		t.Errorf("nonce, gas and gas price = %d, %d, %s, want the suggested values", tx.Nonce, tx.Gas, tx.GasPrice)
+	}
+	if got, want := state.TxHash.ValueString(), tx.Hash.String(); got != want {
+		t.Errorf("tx_hash = %s, want %s", got, want)
+	}
+	if got, want := state.Address.ValueString(), crypto.CreateAddress(edgetypes.StringToAddress(testDeployer), 3).String(); got != want {
+		t.Errorf("address = %s, want %s", got, want)
+	}
+}
+
+func TestContractResourceGasParams(t *testing.T) {
+	node, url := newMockNode(t)
+
+	_, resp := create(t, url, map[string]tftypes.Value{
+		"bytecode":  tftypes.NewValue(tftypes.String, "0x6080604052"),
+		"gas_limit": tftypes.NewValue(tftypes.Number, 21000),
+		"gas_price": tftypes.NewValue(tftypes.String, "7"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if tx := node.sent[0]; tx.Gas != 21000 || tx.GasPrice.Uint64() != 7 {
+		t.Errorf("gas and gas price = %d, %s, want 21000, 7", tx.Gas, tx.GasPrice)
+	}
+}
+
+func TestContractResourceRevertedDeployment(t *testing.T) {
+	node, url := newMockNode(t)
+	node.status = "0x0"
+
+	state, resp := create(t, url, map[string]tftypes.Value{
+		"bytecode": tftypes.NewValue(tftypes.String, "0x6080604052"),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("a reverted deployment succeeded")
+	}
+	if got, want := state.TxHash.ValueString(), node.sent[0].Hash.String(); got != want {
+		t.Errorf("tx_hash = %q, want the reverted deployment %s kept in state", got, want)
+	}
+}
+
+func TestContractResourceChainIDMismatch(t *testing.T) {
+	node, url := newMockNode(t)
+
+	_, resp := create(t, url, map[string]tftypes.Value{
+		"bytecode": tftypes.NewValue(tftypes.String, "0x6080604052"),
+		"chain_id": tftypes.NewValue(tftypes.Number, 1),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("deploying to another chain succeeded")
+	}
+	if len(node.sent) != 0 {
+		t.Errorf("sent %d transactions to another chain", len(node.sent))
+	}
+}
@@ -0,0 +1,68 @@
+package diagnostics
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// KeyType names a kind of key material in diagnostics.
+type KeyType string
+
+// Key types handled by the provider.
+const (
+	ValidatorKey  KeyType = "validator ECDSA key"
+	BLSKey        KeyType = "validator BLS key"
+	PolyBFTBLSKey KeyType = "PolyBFT validator BLS key"
+	NetworkKey    KeyType = "network libp2p key"
+)
+
+const (
+	generationHint = "This is usually caused by a temporary failure of the system entropy source, retrying the apply should resolve it."
+	parseHint      = "Check that the value is a polygon-edge encoded %s, as produced by `polygon-edge secrets init` or by the polygonedge_secrets resource."
+	derivationHint = "The key material may be corrupted, try regenerating or re-importing the key."
+	roundTripHint  = "The generated key was not stored. Please report this issue to the provider developers."
+)
+
+// KeyGeneration returns the diagnostic reported when generating a key fails.
+func KeyGeneration(keyType KeyType, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		fmt.Sprintf("Unable to generate %s", keyType),
+		fmt.Sprintf("Generating the %s failed: %s\n\n%s", keyType, err, generationHint),
+	)
+}
+
+// KeyParse returns the diagnostic reported when a supplied key, set at the given attribute, cannot be decoded.
+// The decoding error is not reported, as it can quote characters of the key.
+func KeyParse(attr path.Path, keyType KeyType, err error) diag.Diagnostic {
+	reason := fmt.Sprintf("The value does not decode to a %s as polygon-edge encodes it.", keyType)
+	var invalidByte hex.InvalidByteError
+	if errors.As(err, &invalidByte) || errors.Is(err, hex.ErrLength) {
+		reason = "The value is not valid hex."
+	}
+	return diag.NewAttributeErrorDiagnostic(
+		attr,
+		fmt.Sprintf("Unable to parse %s", keyType),
+		fmt.Sprintf("%s\n\n"+parseHint, reason, keyType),
+	)
+}
+
+// KeyDerivation returns the diagnostic reported when a public value, like an address, cannot be derived from a key.
+func KeyDerivation(keyType KeyType, derived string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		fmt.Sprintf("Unable to derive %s from %s", derived, keyType),
+		fmt.Sprintf("Deriving the %s from the %s failed: %s\n\n%s", derived, keyType, err, derivationHint),
+	)
+}
+
+// KeyRoundTrip returns the diagnostic reported when a public value computed from a generated key differs from
+// the one derived from the key's encoded form.
+func KeyRoundTrip(keyType KeyType, derived, generated, decoded string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		fmt.Sprintf("Invalid encoding of generated %s", keyType),
+		fmt.Sprintf("The %s of the generated %s is %s, but decoding the encoded key derives %s.\n\n%s", derived, keyType, generated, decoded, roundTripHint),
+	)
+}
@@ -0,0 +1,104 @@
+package diagnostics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestKeyDiagnostics(t *testing.T) {
+	injected := errors.New("injected failure")
+
+	tests := []struct {
+		name        string
+		got         diag.Diagnostic
+		wantSummary string
+		wantDetail  []string
+	}{
+		{
+			name:        "generation",
+			got:         KeyGeneration(BLSKey, injected),
+			wantSummary: "Unable to generate validator BLS key",
+			wantDetail:  []string{"Generating the validator BLS key failed: injected failure", generationHint},
+		},
+		{
+			name:        "derivation",
+			got:         KeyDerivation(NetworkKey, "node ID", injected),
+			wantSummary: "Unable to derive node ID from network libp2p key",
+			wantDetail:  []string{"Deriving the node ID from the network libp2p key failed: injected failure", derivationHint},
+		},
+		{
+			name:        "round trip",
+			got:         KeyRoundTrip(ValidatorKey, "address", "0x01", "0x02"),
+			wantSummary: "Invalid encoding of generated validator ECDSA key",
+			wantDetail:  []string{"The address of the generated validator ECDSA key is 0x01, but decoding the encoded key derives 0x02.", roundTripHint},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.Severity() != diag.SeverityError {
+				t.Errorf("severity = %s, want error", tt.got.Severity())
+			}
+			if tt.got.Summary() != tt.wantSummary {
+				t.Errorf("summary = %q, want %q", tt.got.Summary(), tt.wantSummary)
+			}
+			for _, want := range tt.wantDetail {
+				if !strings.Contains(tt.got.Detail(), want) {
+					t.Errorf("detail = %q, want it to contain %q", tt.got.Detail(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestKeyParseAttribute(t *testing.T) {
+	attr := path.Root("validator_bls_key_encoded")
+	d := KeyParse(attr, BLSKey, errors.New("injected failure"))
+	withPath, ok := d.(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(attr) {
+		t.Fatalf("diagnostic %v is not attached to %s", d, attr)
+	}
+	if d.Summary() != "Unable to parse validator BLS key" {
+		t.Errorf("summary = %q", d.Summary())
+	}
+	if !strings.Contains(d.Detail(), "as produced by `polygon-edge secrets init`") {
+		t.Errorf("detail = %q, want the parse hint", d.Detail())
+	}
+}
+
+func TestKeyParseHidesKey(t *testing.T) {
+	// secret is a key with a non hex character, which the decoding error quotes.
+	const secret = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ffg0"
+
+	tests := []struct {
+		name string
+		key  string
+		want string
+	}{
+		{name: "invalid hex", key: secret, want: "The value is not valid hex."},
+		{name: "odd length", key: secret[:63], want: "The value is not valid hex."},
+		{name: "short key", key: secret[:62], want: "The value does not decode to a validator ECDSA key as polygon-edge encodes it."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := crypto.BytesToECDSAPrivateKey([]byte(tt.key))
+			if err == nil {
+				t.Fatal("expected a decoding error")
+			}
+			d := KeyParse(path.Root("validator_key_encoded"), ValidatorKey, err)
+			if d.Summary() != "Unable to parse validator ECDSA key" {
+				t.Errorf("summary = %q", d.Summary())
+			}
+			if !strings.HasPrefix(d.Detail(), tt.want) {
+				t.Errorf("detail = %q, want it to start with %q", d.Detail(), tt.want)
+			}
+			if strings.Contains(d.Detail(), err.Error()) || strings.Contains(d.Detail(), "'g'") {
+				t.Errorf("detail %q quotes the decoding error %q", d.Detail(), err)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package functions
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/umbracle/ethgo/abi"
+)
+
+// abiArgument is a typed argument of an ABI encoding. Values are strings, so Terraform numbers and bools convert to them.
+type abiArgument struct {
+	Type  string `tfsdk:"type"`
+	Value string `tfsdk:"value"`
+}
+
+// abiArgumentType is the object type of an abiArgument.
+var abiArgumentType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":  types.StringType,
+		"value": types.StringType,
+	},
+}
+
+// abiTypes are the supported Solidity types.
+var abiTypes = []string{"address", "uint256", "bytes32", "bool", "bytes", "string"}
+
+// maxUint256 is the largest uint256 value.
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// encodeABIArguments ABI encodes the arguments, like abi.encode in Solidity.
+func encodeABIArguments(args []abiArgument) ([]byte, error) {
+	if len(args) == 0 {
+		return []byte{}, nil
+	}
+
+	typeNames := make([]string, len(args))
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		value, err := parseABIValue(arg.Type, arg.Value)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i, err)
+		}
+		typeNames[i] = arg.Type
+		values[i] = value
+	}
+
+	typ, err := abi.NewType("tuple(" + strings.Join(typeNames, ",") + ")")
+	if err != nil {
+		return nil, err
+	}
+	return abi.Encode(values, typ)
+}
+
+// parseABIValue converts the string form of a value to the Go value the ABI encoder expects for the type.
+func parseABIValue(typ, value string) (interface{}, error) {
+	switch typ {
+	case "address":
+		if !addressRegexp.MatchString(value) {
+			return nil, fmt.Errorf("invalid address %q: must be a 0x prefixed, 20 byte hex address", value)
+		}
+		var address [20]byte
+		b, _ := hex.DecodeHex(value)
+		copy(address[:], b)
+		return address, nil
+	case "uint256":
+		n, ok := parseInteger(value)
+		if !ok || n.Sign() < 0 || n.Cmp(maxUint256) > 0 {
+			return nil, fmt.Errorf("invalid uint256 %q: must be a decimal or 0x prefixed hex integer between 0 and 2^256-1", value)
+		}
+		return n, nil
+	case "bytes32":
+		b, err := hex.DecodeHex(value)
+		if err != nil || len(b) != 32 {
+			return nil, fmt.Errorf("invalid bytes32 %q: must be 32 hex encoded bytes", value)
+		}
+		var fixed [32]byte
+		copy(fixed[:], b)
+		return fixed, nil
+	case "bool":
+		switch value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return nil, fmt.Errorf("invalid bool %q: must be true or false", value)
+	case "bytes":
+		b, err := hex.DecodeHex(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bytes %q: must be hex encoded", value)
+		}
+		return b, nil
+	case "string":
+		return value, nil
+	}
+	return nil, fmt.Errorf("unsupported type %q: must be one of %s", typ, strings.Join(abiTypes, ", "))
+}
+
+// parseInteger parses a decimal or 0x prefixed hex integer.
+func parseInteger(value string) (*big.Int, bool) {
+	if digits, isHex := strings.CutPrefix(value, "0x"); isHex {
+		return new(big.Int).SetString(digits, 16)
+	}
+	return new(big.Int).SetString(value, 10)
+}
@@ -0,0 +1,63 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &abiEncodeHashFunction{}
+)
+
+// NewABIEncodeHashFunction is a helper function to simplify the provider implementation.
+func NewABIEncodeHashFunction() function.Function {
+	return &abiEncodeHashFunction{}
+}
+
+// abiEncodeHashFunction is the function implementation.
+type abiEncodeHashFunction struct{}
+
+// Metadata returns the function name.
+func (f *abiEncodeHashFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "abi_encode_hash"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *abiEncodeHashFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Computes the keccak256 hash of ABI encoded arguments",
+		Description: "Returns the 0x prefixed keccak256 hash of the ABI encoding of the arguments, " +
+			"like `keccak256(abi.encode(...))` in Solidity. Supported types are `" + strings.Join(abiTypes, "`, `") + "`.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name: "arguments",
+				Description: "Arguments to encode, as objects with a `type` and a `value`. Integers are decimal or 0x prefixed hex, " +
+					"`address`, `bytes32` and `bytes` values are 0x prefixed hex.",
+				ElementType: abiArgumentType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run encodes and hashes the arguments.
+func (f *abiEncodeHashFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []abiArgument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	encoded, err := encodeABIArguments(args)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Unable to ABI encode the arguments: "+err.Error()+".")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hex.EncodeToHex(crypto.Keccak256(encoded))))
+}
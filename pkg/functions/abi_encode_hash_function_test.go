@@ -0,0 +1,115 @@
+package functions
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func abiEncodeHash(t *testing.T, args ...abiArgument) (string, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+	list, diags := types.ListValueFrom(ctx, abiArgumentType, args)
+	if diags.HasError() {
+		t.Fatalf("unable to build the arguments: %v", diags)
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewABIEncodeHashFunction().Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{list})}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+// word returns the 32 byte, left padded hex encoding of a value.
+func word(value string) string {
+	return strings.Repeat("0", 64-len(value)) + value
+}
+
+func TestABIEncodeHashFunction(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []abiArgument
+		want    string
+		encoded string
+	}{
+		{
+			name: "no arguments",
+			want: "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		},
+		{
+			name: "uint256 zero",
+			args: []abiArgument{{Type: "uint256", Value: "0"}},
+			want: "0x290decd9548b62a8d60345a988386fc84ba6bc95484008f6362f93160ef3e563",
+		},
+		{
+			name: "uint256 one",
+			args: []abiArgument{{Type: "uint256", Value: "0x1"}},
+			want: "0xb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6",
+		},
+		{
+			name: "static types",
+			args: []abiArgument{
+				{Type: "address", Value: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+				{Type: "uint256", Value: "1000"},
+				{Type: "bool", Value: "true"},
+				{Type: "bytes32", Value: "0x" + strings.Repeat("ab", 32)},
+			},
+			encoded: word("f39fd6e51aad88f6f4ce6ab8827279cfffb92266") + word("3e8") + word("1") + strings.Repeat("ab", 32),
+		},
+		{
+			name: "dynamic types",
+			args: []abiArgument{
+				{Type: "bytes", Value: "0x1234"},
+				{Type: "string", Value: "abc"},
+			},
+			encoded: word("40") + word("80") +
+				word("2") + "1234" + strings.Repeat("0", 60) +
+				word("3") + "616263" + strings.Repeat("0", 58),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.want
+			if tt.encoded != "" {
+				encoded, err := hex.DecodeString(tt.encoded)
+				if err != nil {
+					t.Fatalf("invalid test encoding: %v", err)
+				}
+				want = "0x" + hex.EncodeToString(crypto.Keccak256(encoded))
+			}
+
+			got, funcErr := abiEncodeHash(t, tt.args...)
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr)
+			}
+			if got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestABIEncodeHashFunctionInvalidArguments(t *testing.T) {
+	for name, arg := range map[string]abiArgument{
+		"unsupported type":  {Type: "uint8", Value: "1"},
+		"invalid address":   {Type: "address", Value: "0x1234"},
+		"negative uint256":  {Type: "uint256", Value: "-1"},
+		"overflowing uint":  {Type: "uint256", Value: "0x1" + strings.Repeat("0", 64)},
+		"short bytes32":     {Type: "bytes32", Value: "0x1234"},
+		"invalid bool":      {Type: "bool", Value: "yes"},
+		"invalid bytes hex": {Type: "bytes", Value: "0xzz"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got, funcErr := abiEncodeHash(t, arg); funcErr == nil {
+				t.Errorf("expected an error, got %s", got)
+			}
+		})
+	}
+}
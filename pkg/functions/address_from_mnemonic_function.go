@@ -0,0 +1,127 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// derivationPathRegexp matches a BIP32 derivation path from the master key, such as m/44'/60'/0'/0/0.
+var derivationPathRegexp = regexp.MustCompile(`^m(/[0-9]+['hH]?)*$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &addressFromMnemonicFunction{}
+)
+
+// NewAddressFromMnemonicFunction is a helper function to simplify the provider implementation.
+func NewAddressFromMnemonicFunction() function.Function {
+	return &addressFromMnemonicFunction{}
+}
+
+// addressFromMnemonicFunction is the function implementation.
+type addressFromMnemonicFunction struct{}
+
+// Metadata returns the function name.
+func (f *addressFromMnemonicFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "address_from_mnemonic"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *addressFromMnemonicFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Derives an address from a mnemonic",
+		Description: "Returns the checksummed address of the key derived from a BIP39 mnemonic, without a passphrase, " +
+			"along a BIP32 derivation path, like the accounts of common wallets. The result is sensitive if the mnemonic is.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "mnemonic",
+				Description: "BIP39 mnemonic of the English word list, with a valid checksum.",
+			},
+			function.StringParameter{
+				Name: "path",
+				Description: "BIP32 derivation path, such as `m/44'/60'/0'/0/0` for the first account of common wallets. " +
+					"Hardened indexes are marked with `'` or `h`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run derives the key along the path and returns its address.
+func (f *addressFromMnemonicFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var mnemonic, path string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &mnemonic, &path))
+	if resp.Error != nil {
+		return
+	}
+
+	// The mnemonic is not echoed in the errors, as it is secret.
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	_, err := bip39.EntropyFromMnemonic(mnemonic)
+	if errors.Is(err, bip39.ErrChecksumIncorrect) {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid mnemonic: the checksum is incorrect.")
+		return
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid mnemonic: must be 12, 15, 18, 21 or 24 words of the BIP39 English word list.")
+		return
+	}
+	indexes, err := parseDerivationPath(path)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid derivation path %q: %s.", path, err))
+		return
+	}
+
+	key, err := hdkeychain.NewMaster(bip39.NewSeed(mnemonic, ""), &chaincfg.MainNetParams)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to derive the master key: %s.", err))
+		return
+	}
+	for _, index := range indexes {
+		if key, err = key.Derive(index); err != nil {
+			resp.Error = function.NewFuncError(fmt.Sprintf("Unable to derive the key at %s: %s.", path, err))
+			return
+		}
+	}
+	priv, err := key.ECPrivKey()
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to derive the key at %s: %s.", path, err))
+		return
+	}
+
+	address := crypto.PubKeyToAddress(&priv.ToECDSA().PublicKey).String()
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, address))
+}
+
+// parseDerivationPath returns the child indexes of a BIP32 derivation path, with the hardened indexes offset
+// by hdkeychain.HardenedKeyStart.
+func parseDerivationPath(path string) ([]uint32, error) {
+	if !derivationPathRegexp.MatchString(path) {
+		return nil, fmt.Errorf("must be m followed by /-separated indexes, such as m/44'/60'/0'/0/0")
+	}
+
+	segments := strings.Split(path, "/")[1:]
+	indexes := make([]uint32, len(segments))
+	for i, segment := range segments {
+		hardened := strings.TrimRight(segment, "'hH") != segment
+		index, err := strconv.ParseUint(strings.TrimRight(segment, "'hH"), 10, 32)
+		if err != nil || index >= hdkeychain.HardenedKeyStart {
+			return nil, fmt.Errorf("index %s must be lower than %d", segment, uint32(hdkeychain.HardenedKeyStart))
+		}
+		indexes[i] = uint32(index)
+		if hardened {
+			indexes[i] += hdkeychain.HardenedKeyStart
+		}
+	}
+	return indexes, nil
+}
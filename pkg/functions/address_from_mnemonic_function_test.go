@@ -0,0 +1,86 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testMnemonic is the mnemonic of the Hardhat development accounts.
+const testMnemonic = "test test test test test test test test test test test junk"
+
+func addressFromMnemonic(mnemonic, path string) (string, *function.FuncError) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewAddressFromMnemonicFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(mnemonic), types.StringValue(path)}),
+	}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func TestAddressFromMnemonic(t *testing.T) {
+	// The BIP44 accounts of the Hardhat, Ganache and Truffle development mnemonics, as derived by common wallets.
+	tests := []struct {
+		mnemonic string
+		path     string
+		want     string
+	}{
+		{mnemonic: testMnemonic, path: "m/44'/60'/0'/0/0", want: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+		{mnemonic: testMnemonic, path: "m/44'/60'/0'/0/1", want: "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"},
+		{mnemonic: testMnemonic, path: "m/44h/60h/0h/0/2", want: "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC"},
+		{mnemonic: "  test test test test test test\ntest test test test test junk ", path: "m/44'/60'/0'/0/0", want: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+		{
+			mnemonic: "myth like bonus scare over problem client lizard pioneer submit female collect",
+			path:     "m/44'/60'/0'/0/0",
+			want:     "0x90F8bf6A479f320ead074411a4B0e7944Ea8c9C1",
+		},
+		{
+			mnemonic: "candy maple cake sugar pudding cream honey rich smooth crumble sweet treat",
+			path:     "m/44'/60'/0'/0/0",
+			want:     "0x627306090abaB3A6e1400e9345bC60c78a8BEf57",
+		},
+	}
+	for _, tt := range tests {
+		got, funcErr := addressFromMnemonic(tt.mnemonic, tt.path)
+		if funcErr != nil {
+			t.Fatalf("address_from_mnemonic(%q, %q) error = %s", tt.mnemonic, tt.path, funcErr)
+		}
+		if got != tt.want {
+			t.Errorf("address_from_mnemonic(%q, %q) = %s, want %s", tt.mnemonic, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestAddressFromMnemonicInvalid(t *testing.T) {
+	for name, tt := range map[string]struct {
+		mnemonic string
+		path     string
+		arg      int64
+	}{
+		"incorrect checksum": {strings.Repeat("test ", 12), "m/44'/60'/0'/0/0", 0},
+		"unknown word":       {strings.Replace(testMnemonic, "junk", "junks", 1), "m/44'/60'/0'/0/0", 0},
+		"too few words":      {"test test test junk", "m/44'/60'/0'/0/0", 0},
+		"no master":          {testMnemonic, "44'/60'/0'/0/0", 1},
+		"empty index":        {testMnemonic, "m/44'//0", 1},
+		"index too large":    {testMnemonic, "m/2147483648", 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, funcErr := addressFromMnemonic(tt.mnemonic, tt.path)
+			if funcErr == nil {
+				t.Fatal("expected an error")
+			}
+			if funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != tt.arg {
+				t.Errorf("error on argument %v, want %d: %s", funcErr.FunctionArgument, tt.arg, funcErr)
+			}
+			if strings.Contains(funcErr.Error(), "junk") {
+				t.Errorf("the error echoes the mnemonic: %s", funcErr)
+			}
+		})
+	}
+}
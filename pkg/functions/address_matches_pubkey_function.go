@@ -0,0 +1,80 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// addressRegexp matches a 0x prefixed, 20 byte hex address.
+var addressRegexp = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &addressMatchesPubkeyFunction{}
+)
+
+// NewAddressMatchesPubkeyFunction is a helper function to simplify the provider implementation.
+func NewAddressMatchesPubkeyFunction() function.Function {
+	return &addressMatchesPubkeyFunction{}
+}
+
+// addressMatchesPubkeyFunction is the function implementation.
+type addressMatchesPubkeyFunction struct{}
+
+// Metadata returns the function name.
+func (f *addressMatchesPubkeyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "address_matches_pubkey"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *addressMatchesPubkeyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Checks that an address derives from a public key",
+		Description: "Returns whether the address is the one derived from the secp256k1 public key. Addresses are compared regardless of their checksum casing.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "address",
+				Description: "0x prefixed, 20 byte hex address.",
+			},
+			function.StringParameter{
+				Name:        "public_key",
+				Description: "Hex encoded public key, either compressed (33 bytes) or uncompressed (65 bytes). The 0x prefix is optional.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run derives the address of the public key and compares it with the given address.
+func (f *addressMatchesPubkeyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var address, publicKey string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &address, &publicKey))
+	if resp.Error != nil {
+		return
+	}
+
+	if !addressRegexp.MatchString(address) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid address %q: must be a 0x prefixed, 20 byte hex address.", address))
+		return
+	}
+	raw, err := hex.DecodeHex(publicKey)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid public key: %s.", err))
+		return
+	}
+	pub, err := btcec.ParsePubKey(raw, crypto.S256)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid public key: %s.", err))
+		return
+	}
+
+	derived := crypto.PubKeyToAddress(pub.ToECDSA()).String()
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.EqualFold(derived, address)))
+}
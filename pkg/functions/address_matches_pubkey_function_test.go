@@ -0,0 +1,61 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	testCompressedPublicKey   = "0x038318535b54105d4a7aae60c08fc45f9687181b4fdfc625bd1a753fa7397fed75"
+	testUncompressedPublicKey = "0x048318535b54105d4a7aae60c08fc45f9687181b4fdfc625bd1a753fa7397fed753547f11ca8696646f2f3acb08e31016afac23e630c5d11f59f61fef57b0d2aa5"
+	testAddress               = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+)
+
+func TestAddressMatchesPubkeyFunction(t *testing.T) {
+	tests := []struct {
+		name      string
+		address   string
+		publicKey string
+		want      bool
+		wantErr   bool
+	}{
+		{name: "compressed", address: testAddress, publicKey: testCompressedPublicKey, want: true},
+		{name: "uncompressed", address: testAddress, publicKey: testUncompressedPublicKey, want: true},
+		{name: "without prefix", address: testAddress, publicKey: testCompressedPublicKey[2:], want: true},
+		{name: "lowercase address", address: "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", publicKey: testCompressedPublicKey, want: true},
+		{name: "other address", address: "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", publicKey: testCompressedPublicKey, want: false},
+		{name: "invalid address", address: "0xf39fd6e51aad88f6f4ce6ab8827279cfffb922", publicKey: testCompressedPublicKey, wantErr: true},
+		{name: "invalid hex", address: testAddress, publicKey: "0x03zz", wantErr: true},
+		{name: "invalid format", address: testAddress, publicKey: "0x05" + testCompressedPublicKey[4:], wantErr: true},
+		{name: "truncated", address: testAddress, publicKey: testCompressedPublicKey[:40], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.address),
+					types.StringValue(tt.publicKey),
+				}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+			NewAddressMatchesPubkeyFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %s", resp.Result.Value())
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if got := resp.Result.Value().(types.Bool).ValueBool(); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// unprefixedAddressRegexp matches a 20 byte hex address, with or without its 0x prefix.
+var unprefixedAddressRegexp = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{40}$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &addressesEqualFunction{}
+)
+
+// NewAddressesEqualFunction is a helper function to simplify the provider implementation.
+func NewAddressesEqualFunction() function.Function {
+	return &addressesEqualFunction{}
+}
+
+// addressesEqualFunction is the function implementation.
+type addressesEqualFunction struct{}
+
+// Metadata returns the function name.
+func (f *addressesEqualFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "addresses_equal"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *addressesEqualFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Checks that two addresses are the same",
+		Description: "Returns whether the two addresses are the same address, regardless of their checksum casing " +
+			"and of whether they are 0x prefixed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "a",
+				Description: "20 byte hex address. The 0x prefix is optional.",
+			},
+			function.StringParameter{
+				Name:        "b",
+				Description: "20 byte hex address. The 0x prefix is optional.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run compares the addresses.
+func (f *addressesEqualFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	for i, address := range []string{a, b} {
+		if !unprefixedAddressRegexp.MatchString(address) {
+			resp.Error = function.NewArgumentFuncError(int64(i), fmt.Sprintf("Invalid address %q: must be a 20 byte hex address.", address))
+			return
+		}
+	}
+
+	equal := strings.EqualFold(strings.TrimPrefix(a, "0x"), strings.TrimPrefix(b, "0x"))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, equal))
+}
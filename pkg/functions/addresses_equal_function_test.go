@@ -0,0 +1,70 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func addressesEqual(a, b string) (bool, *function.FuncError) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+	NewAddressesEqualFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(a), types.StringValue(b)}),
+	}, resp)
+	if resp.Error != nil {
+		return false, resp.Error
+	}
+	return resp.Result.Value().(types.Bool).ValueBool(), nil
+}
+
+func TestAddressesEqual(t *testing.T) {
+	const checksummed = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: checksummed, b: checksummed, want: true},
+		{a: checksummed, b: "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", want: true},
+		{a: checksummed, b: "0xF39FD6E51AAD88F6F4CE6AB8827279CFFFB92266", want: true},
+		{a: checksummed, b: "f39fd6e51aad88f6f4ce6ab8827279cfffb92266", want: true},
+		{a: "F39FD6E51AAD88F6F4CE6AB8827279CFFFB92266", b: "f39Fd6e51aad88F6F4ce6aB8827279cffFb92266", want: true},
+		{a: checksummed, b: "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", want: false},
+		{a: checksummed, b: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92267", want: false},
+	}
+	for _, tt := range tests {
+		got, funcErr := addressesEqual(tt.a, tt.b)
+		if funcErr != nil {
+			t.Fatalf("addresses_equal(%q, %q) error = %s", tt.a, tt.b, funcErr)
+		}
+		if got != tt.want {
+			t.Errorf("addresses_equal(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestAddressesEqualInvalid(t *testing.T) {
+	const valid = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	for name, tt := range map[string]struct {
+		a, b string
+		arg  int64
+	}{
+		"short":         {"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb9226", valid, 0},
+		"long":          {valid, valid + "00", 1},
+		"not hex":       {valid, "0xg39Fd6e51aad88F6F4ce6aB8827279cffFb92266", 1},
+		"empty":         {"", valid, 0},
+		"double prefix": {valid, "0x0xf39Fd6e51aad88F6F4ce6aB8827279cffFb922", 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, funcErr := addressesEqual(tt.a, tt.b)
+			if funcErr == nil {
+				t.Fatal("expected an error")
+			}
+			if funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != tt.arg {
+				t.Errorf("error on argument %v, want %d", funcErr.FunctionArgument, tt.arg)
+			}
+		})
+	}
+}
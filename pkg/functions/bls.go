@@ -0,0 +1,25 @@
+package functions
+
+import (
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/coinbase/kryptology/pkg/signatures/bls/bls_sig"
+)
+
+// parseBLSSignature decodes a hex encoded, compressed validator BLS signature.
+func parseBLSSignature(signature string) (*bls_sig.Signature, error) {
+	raw, err := hex.DecodeHex(signature)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.UnmarshalBLSSignature(raw)
+}
+
+// parseBLSPubkey decodes a hex encoded, compressed validator BLS public key.
+func parseBLSPubkey(pubkey string) (*bls_sig.PublicKey, error) {
+	raw, err := hex.DecodeHex(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.UnmarshalBLSPublicKey(raw)
+}
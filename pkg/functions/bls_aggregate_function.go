@@ -0,0 +1,81 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/coinbase/kryptology/pkg/signatures/bls/bls_sig"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &blsAggregateFunction{}
+)
+
+// NewBLSAggregateFunction is a helper function to simplify the provider implementation.
+func NewBLSAggregateFunction() function.Function {
+	return &blsAggregateFunction{}
+}
+
+// blsAggregateFunction is the function implementation.
+type blsAggregateFunction struct{}
+
+// Metadata returns the function name.
+func (f *blsAggregateFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bls_aggregate"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *blsAggregateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Aggregates validator BLS signatures",
+		Description: "Returns the 0x prefixed hex aggregate of validator BLS signatures over the same message, the way IBFT " +
+			"aggregates committed seals. The aggregate verifies against the aggregate of the signers' BLS public keys.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "signatures",
+				Description: "0x prefixed hex validator BLS signatures, like the ones returned by `bls_sign`.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run aggregates the signatures.
+func (f *blsAggregateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var signatures []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &signatures))
+	if resp.Error != nil {
+		return
+	}
+	if len(signatures) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "At least one signature must be given.")
+		return
+	}
+
+	parsed := make([]*bls_sig.Signature, len(signatures))
+	for i, signature := range signatures {
+		var err error
+		if parsed[i], err = parseBLSSignature(signature); err != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid signature at index %d: %s.", i, err))
+			return
+		}
+	}
+
+	aggregate, err := bls_sig.NewSigPop().AggregateSignatures(parsed...)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to aggregate the signatures: %s.", err))
+		return
+	}
+	encoded, err := aggregate.MarshalBinary()
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to encode the aggregate signature: %s.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hex.EncodeToHex(encoded)))
+}
@@ -0,0 +1,126 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/coinbase/kryptology/pkg/signatures/bls/bls_sig"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// blsSigner is a validator BLS key with its public key.
+type blsSigner struct {
+	key    *bls_sig.SecretKey
+	pubkey *bls_sig.PublicKey
+}
+
+func generateBLSSigners(t *testing.T, n int) []blsSigner {
+	t.Helper()
+	signers := make([]blsSigner, n)
+	for i := range signers {
+		key, err := crypto.GenerateBLSKey()
+		if err != nil {
+			t.Fatalf("unable to generate BLS key: %v", err)
+		}
+		pubkey, err := key.GetPublicKey()
+		if err != nil {
+			t.Fatalf("unable to derive BLS public key: %v", err)
+		}
+		signers[i] = blsSigner{key: key, pubkey: pubkey}
+	}
+	return signers
+}
+
+func blsAggregate(t *testing.T, signatures ...string) (string, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+	list, diags := types.ListValueFrom(ctx, types.StringType, signatures)
+	if diags.HasError() {
+		t.Fatalf("unable to build the signatures: %v", diags)
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewBLSAggregateFunction().Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{list})}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func TestBLSAggregateFunction(t *testing.T) {
+	message := []byte("committed seal")
+	signers := generateBLSSigners(t, 3)
+	signatures := make([]string, len(signers))
+	pubkeys := make([]*bls_sig.PublicKey, len(signers))
+	for i, signer := range signers {
+		signature, err := crypto.SignByBLS(signer.key, message)
+		if err != nil {
+			t.Fatalf("unable to sign: %v", err)
+		}
+		signatures[i] = hex.EncodeToHex(signature)
+		pubkeys[i] = signer.pubkey
+	}
+
+	aggregate, funcErr := blsAggregate(t, signatures...)
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+	raw, err := hex.DecodeHex(aggregate)
+	if err != nil {
+		t.Fatalf("aggregate %s is not hex: %v", aggregate, err)
+	}
+	multiSignature := new(bls_sig.MultiSignature)
+	if err := multiSignature.UnmarshalBinary(raw); err != nil {
+		t.Fatalf("unable to decode the aggregate: %v", err)
+	}
+
+	verify := func(pubkeys ...*bls_sig.PublicKey) bool {
+		t.Helper()
+		multiPubkey, err := bls_sig.NewSigPop().AggregatePublicKeys(pubkeys...)
+		if err != nil {
+			t.Fatalf("unable to aggregate public keys: %v", err)
+		}
+		ok, _ := bls_sig.NewSigPop().VerifyMultiSignature(multiPubkey, message, multiSignature)
+		return ok
+	}
+	if !verify(pubkeys...) {
+		t.Error("the aggregate does not verify against the aggregate of the signers' public keys")
+	}
+	if verify(pubkeys[:2]...) {
+		t.Error("the aggregate verifies without one of the signers")
+	}
+
+	single, funcErr := blsAggregate(t, signatures[0])
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+	if single != signatures[0] {
+		t.Errorf("the aggregate of a single signature is %s, want the signature %s", single, signatures[0])
+	}
+}
+
+func TestBLSAggregateFunctionInvalidSignatures(t *testing.T) {
+	signer := generateBLSSigners(t, 1)[0]
+	signature, err := crypto.SignByBLS(signer.key, []byte("committed seal"))
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+	valid := hex.EncodeToHex(signature)
+
+	for name, signatures := range map[string][]string{
+		"no signatures":     {},
+		"invalid hex":       {valid, "0xzz"},
+		"short signature":   {valid, valid[:50]},
+		"not a curve point": {valid, "0x" + strings.Repeat("ff", len(signature))},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if aggregate, funcErr := blsAggregate(t, signatures...); funcErr == nil {
+				t.Errorf("expected an error, got %s", aggregate)
+			}
+		})
+	}
+}
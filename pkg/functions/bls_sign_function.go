@@ -0,0 +1,71 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &blsSignFunction{}
+)
+
+// NewBLSSignFunction is a helper function to simplify the provider implementation.
+func NewBLSSignFunction() function.Function {
+	return &blsSignFunction{}
+}
+
+// blsSignFunction is the function implementation.
+type blsSignFunction struct{}
+
+// Metadata returns the function name.
+func (f *blsSignFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bls_sign"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *blsSignFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Signs a message with a validator BLS key",
+		Description: "Returns the 0x prefixed hex validator BLS signature of the message, the way polygon-edge signs with BLS keys. " +
+			"BLS signatures are deterministic, signing the same message with the same key always returns the same signature.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "message",
+				Description: "Message to sign.",
+			},
+			function.StringParameter{
+				Name:        "validator_bls_key",
+				Description: "Encoded validator BLS key of the signer.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run signs the message.
+func (f *blsSignFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var message, validatorBLSKey string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &message, &validatorBLSKey))
+	if resp.Error != nil {
+		return
+	}
+
+	key, err := crypto.BytesToBLSSecretKey([]byte(validatorBLSKey))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Unable to parse validator BLS key: %s.", err))
+		return
+	}
+
+	signature, err := crypto.SignByBLS(key, []byte(message))
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to sign message: %s.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hex.EncodeToHex(signature)))
+}
@@ -0,0 +1,64 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func blsSign(message, key string) (string, *function.FuncError) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewBLSSignFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(message), types.StringValue(key)}),
+	}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func TestBLSSignFunctionRoundTrip(t *testing.T) {
+	key, pubkey := createSecretsBLSKey(t)
+
+	signature, funcErr := blsSign("attestation", key)
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+	valid, funcErr := blsVerify(pubkey, "attestation", signature)
+	if funcErr != nil {
+		t.Fatalf("unable to verify the signature: %s", funcErr)
+	}
+	if !valid {
+		t.Error("the signature does not verify against the signer's public key")
+	}
+
+	again, funcErr := blsSign("attestation", key)
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+	if again != signature {
+		t.Errorf("signing twice returned %s and %s, want the same signature", signature, again)
+	}
+
+	aggregate, funcErr := blsAggregate(t, signature)
+	if funcErr != nil || aggregate != signature {
+		t.Errorf("bls_aggregate does not accept the signature: %s, %v", aggregate, funcErr)
+	}
+}
+
+func TestBLSSignFunctionInvalidKey(t *testing.T) {
+	for name, key := range map[string]string{
+		"empty":         "",
+		"invalid hex":   "zz",
+		"validator key": testPrivateKey,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if signature, funcErr := blsSign("attestation", key); funcErr == nil {
+				t.Errorf("expected an error, got %s", signature)
+			}
+		})
+	}
+}
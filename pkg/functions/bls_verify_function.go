@@ -0,0 +1,79 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &blsVerifyFunction{}
+)
+
+// NewBLSVerifyFunction is a helper function to simplify the provider implementation.
+func NewBLSVerifyFunction() function.Function {
+	return &blsVerifyFunction{}
+}
+
+// blsVerifyFunction is the function implementation.
+type blsVerifyFunction struct{}
+
+// Metadata returns the function name.
+func (f *blsVerifyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bls_verify"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *blsVerifyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Verifies a validator BLS signature",
+		Description: "Returns whether the validator BLS signature of the message is valid for the BLS public key.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "bls_pubkey",
+				Description: "0x prefixed hex validator BLS public key, like the `bls_pubkey` of the `polygonedge_secrets` resource.",
+			},
+			function.StringParameter{
+				Name:        "message",
+				Description: "Message which was signed.",
+			},
+			function.StringParameter{
+				Name:        "signature",
+				Description: "0x prefixed hex validator BLS signature, like the ones returned by `bls_sign`.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run verifies the signature.
+func (f *blsVerifyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var blsPubkey, message, signature string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &blsPubkey, &message, &signature))
+	if resp.Error != nil {
+		return
+	}
+
+	pubkey, err := parseBLSPubkey(blsPubkey)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid BLS public key: %s.", err))
+		return
+	}
+	sig, err := parseBLSSignature(signature)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Invalid signature: %s.", err))
+		return
+	}
+
+	err = crypto.VerifyBLSSignature(pubkey, sig, []byte(message))
+	if err != nil && !errors.Is(err, crypto.ErrInvalidBLSSignature) {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to verify the signature: %s.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, err == nil))
+}
@@ -0,0 +1,97 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func blsVerify(pubkey, message, signature string) (bool, *function.FuncError) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+	NewBLSVerifyFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(pubkey),
+			types.StringValue(message),
+			types.StringValue(signature),
+		}),
+	}, resp)
+	if resp.Error != nil {
+		return false, resp.Error
+	}
+	return resp.Result.Value().(types.Bool).ValueBool(), nil
+}
+
+// createSecretsBLSKey creates a secrets resource and returns its validator BLS key and public key.
+func createSecretsBLSKey(t *testing.T) (string, string) {
+	t.Helper()
+	state := createSecretsResource(t, nil)
+	return stateString(t, state, "validator_bls_key_encoded"), stateString(t, state, "bls_pubkey")
+}
+
+// signBLS signs the message with the encoded validator BLS key.
+func signBLS(t *testing.T, encodedKey, message string) string {
+	t.Helper()
+	key, err := crypto.BytesToBLSSecretKey([]byte(encodedKey))
+	if err != nil {
+		t.Fatalf("unable to parse BLS key: %v", err)
+	}
+	signature, err := crypto.SignByBLS(key, []byte(message))
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+	return hex.EncodeToHex(signature)
+}
+
+func TestBLSVerifyFunction(t *testing.T) {
+	key, pubkey := createSecretsBLSKey(t)
+	otherKey, otherPubkey := createSecretsBLSKey(t)
+	signature := signBLS(t, key, "attestation")
+
+	tests := []struct {
+		name      string
+		pubkey    string
+		message   string
+		signature string
+		want      bool
+	}{
+		{name: "valid", pubkey: pubkey, message: "attestation", signature: signature, want: true},
+		{name: "tampered message", pubkey: pubkey, message: "attestation!", signature: signature},
+		{name: "other signer", pubkey: pubkey, message: "attestation", signature: signBLS(t, otherKey, "attestation")},
+		{name: "other public key", pubkey: otherPubkey, message: "attestation", signature: signature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, funcErr := blsVerify(tt.pubkey, tt.message, tt.signature)
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBLSVerifyFunctionMalformedInput(t *testing.T) {
+	key, pubkey := createSecretsBLSKey(t)
+	signature := signBLS(t, key, "attestation")
+
+	for name, tt := range map[string]struct{ pubkey, signature string }{
+		"invalid public key hex":  {pubkey: "0xzz", signature: signature},
+		"short public key":        {pubkey: pubkey[:50], signature: signature},
+		"invalid signature hex":   {pubkey: pubkey, signature: "0xzz"},
+		"short signature":         {pubkey: pubkey, signature: signature[:50]},
+		"public key as signature": {pubkey: pubkey, signature: pubkey},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got, funcErr := blsVerify(tt.pubkey, "attestation", tt.signature); funcErr == nil {
+				t.Errorf("expected an error, got %t", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/units"
+)
+
+// balanceRegexp matches a non-negative decimal amount, optionally followed by its unit.
+var balanceRegexp = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*([a-z]*)$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &buildAllocFunction{}
+)
+
+// NewBuildAllocFunction is a helper function to simplify the provider implementation.
+func NewBuildAllocFunction() function.Function {
+	return &buildAllocFunction{}
+}
+
+// buildAllocFunction is the function implementation.
+type buildAllocFunction struct{}
+
+// Metadata returns the function name.
+func (f *buildAllocFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_alloc"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *buildAllocFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the genesis alloc of premined accounts",
+		Description: "Returns the JSON encoded `alloc` object of a genesis file, mapping every address to its balance the way " +
+			"polygon-edge writes it, with checksummed addresses and 0x prefixed hex balances in wei. " +
+			"Addresses given more than once, regardless of their casing, are rejected.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "addresses",
+				Description: "0x prefixed, 20 byte hex addresses of the premined accounts.",
+				ElementType: types.StringType,
+			},
+			function.ListParameter{
+				Name: "balances",
+				Description: "Balances of the accounts, in the order of `addresses`. A balance is a decimal amount of wei, " +
+					"or a decimal amount followed by its unit, `wei`, `gwei` or `ether`, like `1.5 ether`.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the alloc.
+func (f *buildAllocFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var addresses, balances []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &addresses, &balances))
+	if resp.Error != nil {
+		return
+	}
+	if len(addresses) != len(balances) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Got %d balances for %d addresses: every address needs a balance.", len(balances), len(addresses)))
+		return
+	}
+
+	alloc := make(map[string]map[string]string, len(addresses))
+	for i, address := range addresses {
+		if !addressRegexp.MatchString(address) {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid address %q at index %d: must be a 0x prefixed, 20 byte hex address.", address, i))
+			return
+		}
+		key := edgetypes.StringToAddress(address).String()
+		if _, ok := alloc[key]; ok {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Duplicate address %s at index %d.", address, i))
+			return
+		}
+
+		wei, err := parseBalance(balances[i])
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid balance %q at index %d: %s.", balances[i], i, err))
+			return
+		}
+		alloc[key] = map[string]string{"balance": hex.EncodeBig(wei)}
+	}
+
+	encoded, err := json.Marshal(alloc)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to encode the alloc: %s.", err))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, string(encoded)))
+}
+
+// parseBalance parses a decimal amount of wei, or a decimal amount followed by its unit, into wei.
+func parseBalance(balance string) (*big.Int, error) {
+	match := balanceRegexp.FindStringSubmatch(strings.TrimSpace(balance))
+	if match == nil {
+		return nil, fmt.Errorf("must be a non-negative decimal amount, optionally followed by wei, gwei or ether")
+	}
+	unit := match[2]
+	if unit == "" {
+		unit = "wei"
+	}
+	decimals, ok := unitDecimals[unit]
+	if !ok {
+		return nil, fmt.Errorf("unknown unit %q, must be wei, gwei or ether", unit)
+	}
+
+	value, _ := new(big.Rat).SetString(match[1])
+	wei := value.Mul(value, new(big.Rat).SetInt(units.Pow10(decimals)))
+	if !wei.IsInt() {
+		return nil, fmt.Errorf("it is not a whole number of wei")
+	}
+	return wei.Num(), nil
+}
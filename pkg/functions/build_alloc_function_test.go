@@ -0,0 +1,81 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func buildAlloc(addresses, balances []string) (string, *function.FuncError) {
+	list := func(values []string) types.List {
+		elements := make([]attr.Value, len(values))
+		for i, value := range values {
+			elements[i] = types.StringValue(value)
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewBuildAllocFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{list(addresses), list(balances)}),
+	}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func TestBuildAlloc(t *testing.T) {
+	encoded, funcErr := buildAlloc(
+		[]string{
+			"0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266",
+			"0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+			"0x3c44cdddb6a900fa2b585dd299e03d12fa4293bc",
+			"0x90F79bf6EB2c4f870365E785982E1f101E93b906",
+		},
+		[]string{"1000", "1.5 ether", "2gwei", "0 ether"},
+	)
+	if funcErr != nil {
+		t.Fatalf("build_alloc error = %s", funcErr)
+	}
+
+	var alloc map[string]map[string]string
+	if err := json.Unmarshal([]byte(encoded), &alloc); err != nil {
+		t.Fatalf("build_alloc returned invalid JSON %s: %v", encoded, err)
+	}
+	want := map[string]map[string]string{
+		"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266": {"balance": "0x3e8"},
+		"0x70997970C51812dc3A010C7d01b50e0d17dc79C8": {"balance": "0x14d1120d7b160000"},
+		"0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC": {"balance": "0x77359400"},
+		"0x90F79bf6EB2c4f870365E785982E1f101E93b906": {"balance": "0x0"},
+	}
+	if fmt.Sprint(alloc) != fmt.Sprint(want) {
+		t.Errorf("build_alloc = %v, want %v", alloc, want)
+	}
+}
+
+func TestBuildAllocInvalid(t *testing.T) {
+	const address = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	for name, tt := range map[string]struct {
+		addresses []string
+		balances  []string
+	}{
+		"duplicate address":       {[]string{address, "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266"}, []string{"1", "2"}},
+		"invalid address":         {[]string{"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb9226"}, []string{"1"}},
+		"missing balance":         {[]string{address}, []string{}},
+		"fraction of wei":         {[]string{address}, []string{"1.5"}},
+		"fraction of wei in gwei": {[]string{address}, []string{"0.0000000001 gwei"}},
+		"unknown unit":            {[]string{address}, []string{"1 finney"}},
+		"negative balance":        {[]string{address}, []string{"-1 ether"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if alloc, err := buildAlloc(tt.addresses, tt.balances); err == nil {
+				t.Errorf("expected an error, got %s", alloc)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// dnsNameRegexp matches a fully qualified DNS name, the form polygon-edge accepts for DNS bootnodes.
+var dnsNameRegexp = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?\.)+[A-Za-z]{2,}$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &buildMultiaddrFunction{}
+)
+
+// NewBuildMultiaddrFunction is a helper function to simplify the provider implementation.
+func NewBuildMultiaddrFunction() function.Function {
+	return &buildMultiaddrFunction{}
+}
+
+// buildMultiaddrFunction is the function implementation.
+type buildMultiaddrFunction struct{}
+
+// Metadata returns the function name.
+func (f *buildMultiaddrFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_multiaddr"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *buildMultiaddrFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a libp2p multiaddr",
+		Description: "Returns the TCP multiaddr of a host, such as `/ip4/10.0.0.1/tcp/1478/p2p/16Uiu2...`, " +
+			"for the polygon-edge bootnodes and libp2p addresses.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "protocol",
+				Description: "Protocol of the host, `ip4`, `ip6`, `dns`, `dns4` or `dns6`.",
+			},
+			function.StringParameter{
+				Name:        "host",
+				Description: "IPv4 address, IPv6 address or DNS name, matching the protocol.",
+			},
+			function.Int64Parameter{
+				Name:        "port",
+				Description: "TCP port, polygon-edge listens on 1478 by default.",
+			},
+			function.StringParameter{
+				Name:           "peer_id",
+				AllowNullValue: true,
+				Description:    "Node ID appended as the `/p2p/` component, or null for a multiaddr without peer ID.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the multiaddr.
+func (f *buildMultiaddrFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var protocol, host string
+	var port int64
+	var peerID types.String
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &protocol, &host, &port, &peerID))
+	if resp.Error != nil {
+		return
+	}
+
+	ip := net.ParseIP(host)
+	switch protocol {
+	case "ip4":
+		if ip == nil || ip.To4() == nil {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("The host %q is not an IPv4 address.", host))
+			return
+		}
+	case "ip6":
+		if ip == nil || ip.To4() != nil {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("The host %q is not an IPv6 address.", host))
+			return
+		}
+	case "dns", "dns4", "dns6":
+		if ip != nil || !dnsNameRegexp.MatchString(host) {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("The host %q is not a DNS name.", host))
+			return
+		}
+	default:
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The protocol must be ip4, ip6, dns, dns4 or dns6, got: %q.", protocol))
+		return
+	}
+	if port <= 0 || port > 65535 {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("The port must be between 1 and 65535, got: %d.", port))
+		return
+	}
+
+	address := fmt.Sprintf("/%s/%s/tcp/%d", protocol, host, port)
+	if !peerID.IsNull() {
+		id, err := peer.Decode(peerID.ValueString())
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(3, fmt.Sprintf("Unable to decode the peer ID: %s.", err))
+			return
+		}
+		address += "/p2p/" + id.String()
+	}
+
+	built, err := multiaddr.NewMultiaddr(address)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Invalid multiaddr %s: %s.", address, err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, built.String()))
+}
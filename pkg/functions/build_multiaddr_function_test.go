@@ -0,0 +1,88 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func buildMultiaddr(protocol, host string, port int64, peerID types.String) (string, *function.FuncError) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewBuildMultiaddrFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(protocol), types.StringValue(host), types.Int64Value(port), peerID,
+		}),
+	}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func TestBuildMultiaddrFunction(t *testing.T) {
+	nodeID := createSecretsResourceNodeID(t, testSeed)
+
+	tests := []struct {
+		name     string
+		protocol string
+		host     string
+		peerID   types.String
+		want     string
+	}{
+		{name: "ip4", protocol: "ip4", host: "10.0.0.1", peerID: types.StringValue(nodeID), want: "/ip4/10.0.0.1/tcp/1478/p2p/" + nodeID},
+		{name: "ip6", protocol: "ip6", host: "2001:db8::1", peerID: types.StringValue(nodeID), want: "/ip6/2001:db8::1/tcp/1478/p2p/" + nodeID},
+		{name: "dns", protocol: "dns", host: "node-1.example.com", peerID: types.StringValue(nodeID), want: "/dns/node-1.example.com/tcp/1478/p2p/" + nodeID},
+		{name: "dns4", protocol: "dns4", host: "node-1.example.com", peerID: types.StringValue(nodeID), want: "/dns4/node-1.example.com/tcp/1478/p2p/" + nodeID},
+		{name: "dns6", protocol: "dns6", host: "node-1.example.com", peerID: types.StringValue(nodeID), want: "/dns6/node-1.example.com/tcp/1478/p2p/" + nodeID},
+		{name: "ip4 without peer id", protocol: "ip4", host: "10.0.0.1", peerID: types.StringNull(), want: "/ip4/10.0.0.1/tcp/1478"},
+		{name: "dns without peer id", protocol: "dns", host: "node-1.example.com", peerID: types.StringNull(), want: "/dns/node-1.example.com/tcp/1478"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildMultiaddr(tt.protocol, tt.host, 1478, tt.peerID)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildMultiaddrFunctionInvalid(t *testing.T) {
+	nodeID := types.StringValue(createSecretsResourceNodeID(t, testSeed))
+
+	tests := []struct {
+		name     string
+		protocol string
+		host     string
+		port     int64
+		peerID   types.String
+		wantArg  int64
+	}{
+		{name: "unknown protocol", protocol: "tcp", host: "10.0.0.1", port: 1478, peerID: nodeID, wantArg: 0},
+		{name: "ip4 with ipv6 host", protocol: "ip4", host: "2001:db8::1", port: 1478, peerID: nodeID, wantArg: 1},
+		{name: "ip4 with dns host", protocol: "ip4", host: "node-1.example.com", port: 1478, peerID: nodeID, wantArg: 1},
+		{name: "ip6 with ipv4 host", protocol: "ip6", host: "10.0.0.1", port: 1478, peerID: nodeID, wantArg: 1},
+		{name: "dns with ip host", protocol: "dns", host: "10.0.0.1", port: 1478, peerID: nodeID, wantArg: 1},
+		{name: "invalid dns name", protocol: "dns", host: "-node.example.com", port: 1478, peerID: nodeID, wantArg: 1},
+		{name: "zero port", protocol: "ip4", host: "10.0.0.1", port: 0, peerID: nodeID, wantArg: 2},
+		{name: "port out of range", protocol: "ip4", host: "10.0.0.1", port: 65536, peerID: nodeID, wantArg: 2},
+		{name: "invalid peer id", protocol: "ip4", host: "10.0.0.1", port: 1478, peerID: types.StringValue("16Uiu2-invalid"), wantArg: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildMultiaddr(tt.protocol, tt.host, tt.port, tt.peerID)
+			if err == nil {
+				t.Fatalf("expected an error, got %s", got)
+			}
+			if err.FunctionArgument == nil || *err.FunctionArgument != tt.wantArg {
+				t.Errorf("error %q is not on argument %d", err.Text, tt.wantArg)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/units"
+)
+
+// unitDecimals are the supported units, with the power of ten of wei they are worth.
+var unitDecimals = map[string]int64{
+	"wei":   0,
+	"gwei":  9,
+	"ether": units.EtherDecimals,
+}
+
+// decimalAmountRegexp matches a non-negative decimal amount, without exponent.
+var decimalAmountRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &convertUnitsFunction{}
+)
+
+// NewConvertUnitsFunction is a helper function to simplify the provider implementation.
+func NewConvertUnitsFunction() function.Function {
+	return &convertUnitsFunction{}
+}
+
+// convertUnitsFunction is the function implementation.
+type convertUnitsFunction struct{}
+
+// Metadata returns the function name.
+func (f *convertUnitsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "convert_units"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *convertUnitsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts an amount between wei, gwei and ether",
+		Description: "Returns the amount converted to another unit, as an exact decimal string without trailing zeros. " +
+			"Amounts which are not a whole number of wei are rejected.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "amount",
+				Description: "Non-negative decimal amount, like `1.5`.",
+			},
+			function.StringParameter{
+				Name:        "from",
+				Description: "Unit of the amount, `wei`, `gwei` or `ether`.",
+			},
+			function.StringParameter{
+				Name:        "to",
+				Description: "Unit to convert the amount to, `wei`, `gwei` or `ether`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run converts the amount.
+func (f *convertUnitsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var amount, from, to string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &amount, &from, &to))
+	if resp.Error != nil {
+		return
+	}
+
+	fromDecimals, ok := unitDecimals[from]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Unknown unit %q: must be wei, gwei or ether.", from))
+		return
+	}
+	toDecimals, ok := unitDecimals[to]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Unknown unit %q: must be wei, gwei or ether.", to))
+		return
+	}
+	if !decimalAmountRegexp.MatchString(amount) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid amount %q: must be a non-negative decimal number.", amount))
+		return
+	}
+
+	value, _ := new(big.Rat).SetString(amount)
+	wei := value.Mul(value, new(big.Rat).SetInt(units.Pow10(fromDecimals)))
+	if !wei.IsInt() {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid amount %s %s: it is not a whole number of wei.", amount, from))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, units.FormatDecimal(wei.Num(), toDecimals)))
+}
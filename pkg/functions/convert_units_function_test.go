@@ -0,0 +1,62 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConvertUnitsFunction(t *testing.T) {
+	tests := []struct {
+		amount, from, to string
+		want             string
+		wantErr          bool
+	}{
+		{amount: "1", from: "ether", to: "wei", want: "1000000000000000000"},
+		{amount: "1000000000000000000", from: "wei", to: "ether", want: "1"},
+		{amount: "1.5", from: "ether", to: "gwei", want: "1500000000"},
+		{amount: "1500000000", from: "gwei", to: "ether", want: "1.5"},
+		{amount: "20", from: "gwei", to: "wei", want: "20000000000"},
+		{amount: "20000000000", from: "wei", to: "gwei", want: "20"},
+		{amount: "1", from: "wei", to: "ether", want: "0.000000000000000001"},
+		{amount: "1", from: "wei", to: "gwei", want: "0.000000001"},
+		{amount: "0.000000001", from: "gwei", to: "wei", want: "1"},
+		{amount: "0", from: "ether", to: "wei", want: "0"},
+		{amount: "1.50", from: "ether", to: "ether", want: "1.5"},
+		{amount: "0.5", from: "wei", to: "gwei", wantErr: true},
+		{amount: "0.0000000001", from: "gwei", to: "wei", wantErr: true},
+		{amount: "-1", from: "ether", to: "wei", wantErr: true},
+		{amount: "1e18", from: "wei", to: "ether", wantErr: true},
+		{amount: "1", from: "finney", to: "wei", wantErr: true},
+		{amount: "1", from: "ether", to: "szabo", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.amount+" "+tt.from+" to "+tt.to, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.amount),
+					types.StringValue(tt.from),
+					types.StringValue(tt.to),
+				}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			NewConvertUnitsFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %s", resp.Result.Value())
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
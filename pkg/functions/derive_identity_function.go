@@ -0,0 +1,84 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/secrets"
+)
+
+// identity are the public identifiers of a validator.
+type identity struct {
+	Address   string `tfsdk:"address"`
+	NodeID    string `tfsdk:"node_id"`
+	BLSPubkey string `tfsdk:"bls_pubkey"`
+}
+
+// identityAttrTypes are the attribute types of an identity object.
+var identityAttrTypes = map[string]attr.Type{
+	"address":    types.StringType,
+	"node_id":    types.StringType,
+	"bls_pubkey": types.StringType,
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &deriveIdentityFunction{}
+)
+
+// NewDeriveIdentityFunction is a helper function to simplify the provider implementation.
+func NewDeriveIdentityFunction() function.Function {
+	return &deriveIdentityFunction{}
+}
+
+// deriveIdentityFunction is the function implementation.
+type deriveIdentityFunction struct{}
+
+// Metadata returns the function name.
+func (f *deriveIdentityFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "derive_identity"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *deriveIdentityFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Derives the identifiers of the secrets of a seed",
+		Description: "Returns an object with the checksummed `address`, the `node_id` and the hex encoded `bls_pubkey` of the secrets " +
+			"derived from the seed, the same identifiers as the `polygonedge_secrets` data source returns for that `seed`, " +
+			"and the same `node_id` as the `polygonedge_secrets` resource for that `network_key_seed`. " +
+			"No keys are returned, so identities can be planned, for example into a genesis, without handling them.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "seed",
+				Description: "Seed the secrets are derived from, of at least 32 bytes.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: identityAttrTypes,
+		},
+	}
+}
+
+// Run derives the identifiers.
+func (f *deriveIdentityFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var seed string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &seed))
+	if resp.Error != nil {
+		return
+	}
+
+	address, blsPubkey, nodeID, diags := secrets.SeededIdentifiers([]byte(seed))
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, identity{
+		Address:   address,
+		NodeID:    nodeID,
+		BLSPubkey: blsPubkey,
+	}))
+}
@@ -0,0 +1,144 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/secrets"
+)
+
+const testSeed = "0123456789abcdef0123456789abcdef"
+
+func deriveIdentity(t *testing.T, seed string) (identity, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+	resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(identityAttrTypes))}
+	NewDeriveIdentityFunction().Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(seed)}),
+	}, resp)
+	if resp.Error != nil {
+		return identity{}, resp.Error
+	}
+
+	var id identity
+	object := resp.Result.Value().(types.Object)
+	if diags := object.As(ctx, &id, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("unable to read the result: %v", diags)
+	}
+	return id, nil
+}
+
+// testValues returns the values of the object type, the given attributes set and the others null or unknown.
+func testValues(objectType tftypes.Object, attrs map[string]tftypes.Value, unknown func(name string) bool) tftypes.Value {
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+		if unknown(name) {
+			values[name] = tftypes.NewValue(typ, tftypes.UnknownValue)
+		}
+	}
+	for name, value := range attrs {
+		values[name] = value
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// readSecretsDataSource reads the secrets data source for the seed.
+func readSecretsDataSource(t *testing.T, seed string) identity {
+	t.Helper()
+	ctx := context.Background()
+	d := secrets.NewSecretsDataSource()
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: testValues(objectType, map[string]tftypes.Value{
+			"seed": tftypes.NewValue(tftypes.String, seed),
+		}, func(string) bool { return false })},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", resp.Diagnostics)
+	}
+
+	var id identity
+	for name, target := range map[string]*string{"address": &id.Address, "node_id": &id.NodeID, "bls_pubkey": &id.BLSPubkey} {
+		var value types.String
+		if diags := resp.State.GetAttribute(ctx, path.Root(name), &value); diags.HasError() {
+			t.Fatalf("unable to get %s: %v", name, diags)
+		}
+		*target = value.ValueString()
+	}
+	return id
+}
+
+// createSecretsResource creates a secrets resource with the given attributes set and returns its state.
+func createSecretsResource(t *testing.T, attrs map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	r := secrets.NewSecretsResource()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: testValues(objectType, attrs, func(string) bool { return false })},
+		Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: testValues(objectType, attrs, func(name string) bool {
+			return schemaResp.Schema.Attributes[name].IsComputed()
+		})},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", resp.Diagnostics)
+	}
+	return resp.State
+}
+
+// stateString returns the string attribute of the state.
+func stateString(t *testing.T, state tfsdk.State, name string) string {
+	t.Helper()
+	var value types.String
+	if diags := state.GetAttribute(context.Background(), path.Root(name), &value); diags.HasError() {
+		t.Fatalf("unable to get %s: %v", name, diags)
+	}
+	return value.ValueString()
+}
+
+// createSecretsResourceNodeID creates a secrets resource with the seed as network key seed and returns its node ID.
+func createSecretsResourceNodeID(t *testing.T, seed string) string {
+	t.Helper()
+	state := createSecretsResource(t, map[string]tftypes.Value{"network_key_seed": tftypes.NewValue(tftypes.String, seed)})
+	return stateString(t, state, "node_id")
+}
+
+func TestDeriveIdentityMatchesSecrets(t *testing.T) {
+	for _, seed := range []string{testSeed, "an other seed which is long enough to derive keys"} {
+		got, err := deriveIdentity(t, seed)
+		if err != nil {
+			t.Fatalf("derive_identity(%q) error = %v", seed, err)
+		}
+		if want := readSecretsDataSource(t, seed); got != want {
+			t.Errorf("derive_identity(%q) = %+v, want the secrets data source identifiers %+v", seed, got, want)
+		}
+		if want := createSecretsResourceNodeID(t, seed); got.NodeID != want {
+			t.Errorf("derive_identity(%q).node_id = %s, want the secrets resource node ID %s", seed, got.NodeID, want)
+		}
+	}
+}
+
+func TestDeriveIdentityInvalidSeed(t *testing.T) {
+	if _, err := deriveIdentity(t, "too short"); err == nil {
+		t.Error("derive_identity() succeeded with a seed shorter than 32 bytes")
+	}
+}
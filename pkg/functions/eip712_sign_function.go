@@ -0,0 +1,79 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/sign"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &eip712SignFunction{}
+)
+
+// NewEIP712SignFunction is a helper function to simplify the provider implementation.
+func NewEIP712SignFunction() function.Function {
+	return &eip712SignFunction{}
+}
+
+// eip712SignFunction is the function implementation.
+type eip712SignFunction struct{}
+
+// Metadata returns the function name.
+func (f *eip712SignFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "eip712_sign"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *eip712SignFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Signs EIP-712 typed data",
+		Description: "Returns the signature of the EIP-712 digest of the typed data, the same way `eth_signTypedData_v4` does, " +
+			"for permit and meta-transaction flows. The signature is 0x prefixed hex of r || s || v, with v being 27 or 28.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "typed_data",
+				Description: "Typed data JSON document with the `types`, `primaryType`, `domain` and `message` fields. " +
+					"Integers are JSON numbers or decimal or 0x prefixed hex strings, addresses and bytes are 0x prefixed hex strings.",
+			},
+			function.StringParameter{
+				Name:        "validator_key",
+				Description: "Encoded validator key of the signer.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run hashes and signs the typed data.
+func (f *eip712SignFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document, validatorKey string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &document, &validatorKey))
+	if resp.Error != nil {
+		return
+	}
+
+	typedData, err := sign.ParseTypedData([]byte(document))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid typed data: %s.", err))
+		return
+	}
+	key, err := crypto.BytesToECDSAPrivateKey([]byte(validatorKey))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Unable to parse validator ECDSA key: %s.", err))
+		return
+	}
+
+	signature, err := sign.TypedDataSignature(key, typedData)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to sign typed data: %s.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hex.EncodeToHex(signature)))
+}
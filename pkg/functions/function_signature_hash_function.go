@@ -0,0 +1,61 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &functionSignatureHashFunction{}
+)
+
+// NewFunctionSignatureHashFunction is a helper function to simplify the provider implementation.
+func NewFunctionSignatureHashFunction() function.Function {
+	return &functionSignatureHashFunction{}
+}
+
+// functionSignatureHashFunction is the function implementation.
+type functionSignatureHashFunction struct{}
+
+// Metadata returns the function name.
+func (f *functionSignatureHashFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "function_signature_hash"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *functionSignatureHashFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Computes the keccak256 hash of a function or event signature",
+		Description: "Returns the 0x prefixed, 32 byte keccak256 hash of a function or event signature. " +
+			"For an event it is the first log topic, for a function `method_selector` returns its first 4 bytes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "signature",
+				Description: "Canonical signature, the name followed by its parameter types without names or spaces, " +
+					"like `Transfer(address,address,uint256)`. Tuples are written as parenthesized type lists.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run hashes the signature.
+func (f *functionSignatureHashFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var signature string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &signature))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateSignature(signature); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid signature %q: %s.", signature, err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hex.EncodeToHex(crypto.Keccak256([]byte(signature)))))
+}
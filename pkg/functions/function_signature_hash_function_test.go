@@ -0,0 +1,48 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFunctionSignatureHashFunction(t *testing.T) {
+	tests := []struct {
+		signature string
+		want      string
+		wantErr   bool
+	}{
+		// The ERC20 event topics.
+		{signature: "Transfer(address,address,uint256)", want: "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"},
+		{signature: "Approval(address,address,uint256)", want: "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"},
+		// The full hash of a method, whose first 4 bytes are its selector 0xa9059cbb.
+		{signature: "transfer(address,uint256)", want: "0xa9059cbb2ab09eb219583f4a59a5d0623ade346d962bcd4e46b11da047c9049b"},
+		{signature: "Transfer(address, address, uint256)", wantErr: true},
+		{signature: "Transfer(address,address,uint256", wantErr: true},
+		{signature: "Transfer", wantErr: true},
+		{signature: "Transfer(address indexed from,address indexed to,uint256 value)", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.signature, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.signature)})}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			NewFunctionSignatureHashFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %s", resp.Result.Value())
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
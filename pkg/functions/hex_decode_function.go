@@ -0,0 +1,66 @@
+package functions
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &hexDecodeFunction{}
+)
+
+// NewHexDecodeFunction is a helper function to simplify the provider implementation.
+func NewHexDecodeFunction() function.Function {
+	return &hexDecodeFunction{}
+}
+
+// hexDecodeFunction is the function implementation.
+type hexDecodeFunction struct{}
+
+// Metadata returns the function name.
+func (f *hexDecodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "hex_decode"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *hexDecodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Decodes a hex string",
+		Description: "Returns the string whose UTF-8 bytes are hex encoded in the value. Terraform strings are UTF-8, " +
+			"so bytes which are not valid UTF-8, like keys and calldata, are rejected; use `hex_encode` to re-encode them instead.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "Hex encoded bytes, with or without the `0x` prefix.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run decodes the hex string.
+func (f *hexDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	decoded, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid hex value: %s.", err))
+		return
+	}
+	if !utf8.Valid(decoded) {
+		resp.Error = function.NewArgumentFuncError(0, "The decoded bytes are not a valid UTF-8 string.")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, string(decoded)))
+}
@@ -0,0 +1,62 @@
+package functions
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &hexEncodeFunction{}
+)
+
+// NewHexEncodeFunction is a helper function to simplify the provider implementation.
+func NewHexEncodeFunction() function.Function {
+	return &hexEncodeFunction{}
+}
+
+// hexEncodeFunction is the function implementation.
+type hexEncodeFunction struct{}
+
+// Metadata returns the function name.
+func (f *hexEncodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "hex_encode"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *hexEncodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Hex encodes a string",
+		Description: "Returns the lowercase hex encoding of the UTF-8 bytes of the string, optionally 0x prefixed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "String to encode.",
+			},
+			function.BoolParameter{
+				Name:        "prefix",
+				Description: "Whether to prefix the encoding with `0x`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run encodes the string.
+func (f *hexEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	var prefix bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value, &prefix))
+	if resp.Error != nil {
+		return
+	}
+
+	encoded := hex.EncodeToString([]byte(value))
+	if prefix {
+		encoded = "0x" + encoded
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, encoded))
+}
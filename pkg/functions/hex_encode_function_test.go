@@ -0,0 +1,83 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func hexEncode(value string, prefix bool) (string, *function.FuncError) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewHexEncodeFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(value), types.BoolValue(prefix)}),
+	}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func hexDecode(value string) (string, *function.FuncError) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewHexDecodeFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(value)}),
+	}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func TestHexEncodeDecodeRoundTrip(t *testing.T) {
+	tests := []struct {
+		value  string
+		prefix bool
+		want   string
+	}{
+		{value: "hello", prefix: true, want: "0x68656c6c6f"},
+		{value: "hello", prefix: false, want: "68656c6c6f"},
+		{value: "", prefix: true, want: "0x"},
+		{value: "", prefix: false, want: ""},
+		{value: "héllo ✓", prefix: true, want: "0x68c3a96c6c6f20e29c93"},
+	}
+	for _, tt := range tests {
+		encoded, funcErr := hexEncode(tt.value, tt.prefix)
+		if funcErr != nil {
+			t.Fatalf("hex_encode(%q, %t) error = %s", tt.value, tt.prefix, funcErr)
+		}
+		if encoded != tt.want {
+			t.Errorf("hex_encode(%q, %t) = %s, want %s", tt.value, tt.prefix, encoded, tt.want)
+		}
+		decoded, funcErr := hexDecode(encoded)
+		if funcErr != nil {
+			t.Fatalf("hex_decode(%q) error = %s", encoded, funcErr)
+		}
+		if decoded != tt.value {
+			t.Errorf("hex_decode(%q) = %q, want %q", encoded, decoded, tt.value)
+		}
+	}
+
+	if decoded, funcErr := hexDecode("0x68656C6C6F"); funcErr != nil || decoded != "hello" {
+		t.Errorf("hex_decode of uppercase hex = %q, %v, want hello", decoded, funcErr)
+	}
+}
+
+func TestHexDecodeInvalid(t *testing.T) {
+	for name, value := range map[string]string{
+		"odd length":                "0x68656c6c6",
+		"odd length without prefix": "123",
+		"non-hex":                   "0x68zz",
+		"non-hex without prefix":    "hello",
+		"double prefix":             "0x0x68",
+		"invalid utf-8":             "0xff",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if decoded, funcErr := hexDecode(value); funcErr == nil {
+				t.Errorf("hex_decode(%q) = %q, want an error", value, decoded)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// maxHexPadLength is the largest length hex_pad pads to, in bytes.
+const maxHexPadLength = 1024
+
+// hexDigitsRegexp matches hex digits without the `0x` prefix, an odd number of them included.
+var hexDigitsRegexp = regexp.MustCompile(`^[0-9a-fA-F]*$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &hexPadFunction{}
+)
+
+// NewHexPadFunction is a helper function to simplify the provider implementation.
+func NewHexPadFunction() function.Function {
+	return &hexPadFunction{}
+}
+
+// hexPadFunction is the function implementation.
+type hexPadFunction struct{}
+
+// Metadata returns the function name.
+func (f *hexPadFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "hex_pad"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *hexPadFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Left-pads hex to a fixed number of bytes",
+		Description: "Returns the value left-padded with zeros to exactly `length` bytes, as 0x prefixed lowercase hex, " +
+			"like the 32 byte words of storage slots, event topics and calldata. Values longer than `length` bytes are " +
+			"rejected, unless `truncate` is set, which keeps their last `length` bytes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "Hex value, with or without the `0x` prefix. An odd number of digits is allowed, like `0x1`.",
+			},
+			function.Int64Parameter{
+				Name:        "length",
+				Description: fmt.Sprintf("Number of bytes of the result, like `32`, at most %d.", maxHexPadLength),
+			},
+			function.BoolParameter{
+				Name:        "truncate",
+				Description: "Whether to drop the leading bytes of values longer than `length` instead of rejecting them.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run pads the hex value.
+func (f *hexPadFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	var length int64
+	var truncate bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value, &length, &truncate))
+	if resp.Error != nil {
+		return
+	}
+
+	digits := strings.ToLower(strings.TrimPrefix(value, "0x"))
+	if !hexDigitsRegexp.MatchString(digits) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid hex value %q: must only hold hex digits after the optional 0x prefix.", value))
+		return
+	}
+	if length <= 0 || length > maxHexPadLength {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("The length must be between 1 and %d, got: %d.", maxHexPadLength, length))
+		return
+	}
+
+	width := int(length) * 2
+	if len(digits) > width {
+		if !truncate {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The value %s is longer than %d bytes; set truncate to keep its last %d bytes.", value, length, length))
+			return
+		}
+		digits = digits[len(digits)-width:]
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, "0x"+strings.Repeat("0", width-len(digits))+digits))
+}
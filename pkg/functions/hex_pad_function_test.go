@@ -0,0 +1,60 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHexPadFunction(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		length   int64
+		truncate bool
+		want     string
+		wantErr  bool
+	}{
+		{name: "pads", value: "0x1", length: 4, want: "0x00000001"},
+		{name: "without prefix", value: "ABcd", length: 3, want: "0x00abcd"},
+		{name: "exact length", value: "0x12345678", length: 4, want: "0x12345678"},
+		{name: "over length", value: "0x123456789a", length: 4, wantErr: true},
+		{name: "over length truncated", value: "0x123456789a", length: 4, truncate: true, want: "0x3456789a"},
+		{name: "maximum length", value: "0x12", length: maxHexPadLength, want: "0x" + strings.Repeat("0", 2*maxHexPadLength-2) + "12"},
+		{name: "invalid hex", value: "0x12zz", length: 4, wantErr: true},
+		{name: "zero length", value: "0x12", length: 0, wantErr: true},
+		{name: "above maximum length", value: "0x12", length: maxHexPadLength + 1, wantErr: true},
+		{name: "huge length", value: "0x12", length: 1 << 40, wantErr: true},
+		{name: "overflowing length", value: "0x12", length: 1 << 62, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.value),
+					types.Int64Value(tt.length),
+					types.BoolValue(tt.truncate),
+				}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			NewHexPadFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %s", resp.Result.Value())
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
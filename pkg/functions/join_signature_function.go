@@ -0,0 +1,100 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/sign"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &joinSignatureFunction{}
+)
+
+// NewJoinSignatureFunction is a helper function to simplify the provider implementation.
+func NewJoinSignatureFunction() function.Function {
+	return &joinSignatureFunction{}
+}
+
+// joinSignatureFunction is the function implementation.
+type joinSignatureFunction struct{}
+
+// Metadata returns the function name.
+func (f *joinSignatureFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "join_signature"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *joinSignatureFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Joins r, s and v into a signature",
+		Description: "Returns the 0x prefixed hex, 65 byte signature r || s || v assembled from its components, " +
+			"the inverse of `split_signature`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "r",
+				Description: "0x prefixed hex, 32 byte r component.",
+			},
+			function.StringParameter{
+				Name:        "s",
+				Description: "0x prefixed hex, 32 byte s component.",
+			},
+			function.Int64Parameter{
+				Name:        "v",
+				Description: "v component, 0, 1, 27 or 28.",
+			},
+			function.Int64Parameter{
+				Name: "v_offset",
+				Description: "Convention of the `v` byte of the signature, the offset added to the recovery id: 0 stores 0 or 1, " +
+					"27 stores 27 or 28, as the `polygonedge_sign` ephemeral resource does.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run joins the signature components.
+func (f *joinSignatureFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var r, s string
+	var v, vOffset int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &r, &s, &v, &vOffset))
+	if resp.Error != nil {
+		return
+	}
+
+	signature := make([]byte, 0, signatureLength)
+	for i, component := range []struct {
+		name  string
+		value string
+	}{
+		{"r", r},
+		{"s", s},
+	} {
+		raw, err := hex.DecodeHex(component.value)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(int64(i), fmt.Sprintf("Invalid %s: %s.", component.name, err))
+			return
+		}
+		if len(raw) != 32 {
+			resp.Error = function.NewArgumentFuncError(int64(i), fmt.Sprintf("Invalid %s: must be 32 bytes, got %d.", component.name, len(raw)))
+			return
+		}
+		signature = append(signature, raw...)
+	}
+	recoveryID, err := sign.RecoveryID(byte(v))
+	if v < 0 || v > 255 || err != nil {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Invalid v: must be 0, 1, 27 or 28, got %d.", v))
+		return
+	}
+	if err := validateVOffset(vOffset); err != nil {
+		resp.Error = function.NewArgumentFuncError(3, fmt.Sprintf("Invalid v offset: %s.", err))
+		return
+	}
+	signature = append(signature, recoveryID+byte(vOffset))
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hex.EncodeToHex(signature)))
+}
@@ -0,0 +1,89 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func joinSignature(t *testing.T, parts signatureParts, vOffset int64) (string, *function.FuncError) {
+	t.Helper()
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewJoinSignatureFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(parts.R),
+			types.StringValue(parts.S),
+			types.Int64Value(parts.V),
+			types.Int64Value(vOffset),
+		}),
+	}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func TestSplitJoinSignatureRoundTrip(t *testing.T) {
+	for _, tt := range []struct {
+		v          string
+		recoveryID int64
+	}{
+		{"00", 0},
+		{"01", 1},
+		{"1b", 0},
+		{"1c", 1},
+	} {
+		signature := testSignatureR + strings.TrimPrefix(testSignatureS, "0x") + tt.v
+		for _, splitOffset := range []int64{0, 27} {
+			parts, err := splitSignature(t, signature, splitOffset)
+			if err != nil {
+				t.Fatalf("unable to split %s: %s", signature, err)
+			}
+			want := signatureParts{R: testSignatureR, S: testSignatureS, V: tt.recoveryID + splitOffset}
+			if parts != want {
+				t.Errorf("split %s into %+v, want %+v", signature, parts, want)
+			}
+
+			for _, joinOffset := range []int64{0, 27} {
+				joined, err := joinSignature(t, parts, joinOffset)
+				if err != nil {
+					t.Fatalf("unable to join %+v: %s", parts, err)
+				}
+				if sameConvention := (tt.v == "1b" || tt.v == "1c") == (joinOffset == 27); sameConvention && joined != signature {
+					t.Errorf("joined %+v into %s, want %s", parts, joined, signature)
+				}
+				again, err := splitSignature(t, joined, splitOffset)
+				if err != nil {
+					t.Fatalf("unable to split joined %s: %s", joined, err)
+				}
+				if again != parts {
+					t.Errorf("round trip of %+v through %s gave %+v", parts, joined, again)
+				}
+			}
+		}
+	}
+}
+
+func TestJoinSignatureInvalid(t *testing.T) {
+	valid := signatureParts{R: testSignatureR, S: testSignatureS, V: 27}
+	for name, tt := range map[string]struct {
+		parts   signatureParts
+		vOffset int64
+	}{
+		"short r":        {signatureParts{R: "0x1234", S: testSignatureS, V: 27}, 27},
+		"invalid s":      {signatureParts{R: testSignatureR, S: "0xzz", V: 27}, 27},
+		"invalid v":      {signatureParts{R: testSignatureR, S: testSignatureS, V: 2}, 27},
+		"overflowed v":   {signatureParts{R: testSignatureR, S: testSignatureS, V: 256 + 27}, 27},
+		"invalid offset": {valid, 28},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := joinSignature(t, tt.parts, tt.vOffset); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
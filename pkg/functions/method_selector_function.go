@@ -0,0 +1,61 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &methodSelectorFunction{}
+)
+
+// NewMethodSelectorFunction is a helper function to simplify the provider implementation.
+func NewMethodSelectorFunction() function.Function {
+	return &methodSelectorFunction{}
+}
+
+// methodSelectorFunction is the function implementation.
+type methodSelectorFunction struct{}
+
+// Metadata returns the function name.
+func (f *methodSelectorFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "method_selector"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *methodSelectorFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Computes the selector of a contract method",
+		Description: "Returns the 0x prefixed, 4 byte selector of a contract method, the first 4 bytes of the keccak256 hash " +
+			"of its signature. Calldata starts with the selector, followed by the ABI encoded arguments.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "signature",
+				Description: "Canonical function signature, the function name followed by its parameter types without names or spaces, " +
+					"like `transfer(address,uint256)`. Tuples are written as parenthesized type lists.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run hashes the signature.
+func (f *methodSelectorFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var signature string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &signature))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateSignature(signature); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid function signature %q: %s.", signature, err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hex.EncodeToHex(crypto.Keccak256([]byte(signature))[:4])))
+}
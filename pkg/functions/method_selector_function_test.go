@@ -0,0 +1,50 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMethodSelectorFunction(t *testing.T) {
+	tests := []struct {
+		signature string
+		want      string
+		wantErr   bool
+	}{
+		{signature: "transfer(address,uint256)", want: "0xa9059cbb"},
+		{signature: "transferFrom(address,address,uint256)", want: "0x23b872dd"},
+		{signature: "approve(address,uint256)", want: "0x095ea7b3"},
+		{signature: "balanceOf(address)", want: "0x70a08231"},
+		{signature: "totalSupply()", want: "0x18160ddd"},
+		{signature: "transfer(address, uint256)", wantErr: true},
+		{signature: "transfer(address,uint256", wantErr: true},
+		{signature: "transfer", wantErr: true},
+		{signature: "(address,uint256)", wantErr: true},
+		{signature: "transfer(address,,uint256)", wantErr: true},
+		{signature: "transfer(address to,uint256 amount)", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.signature, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.signature)})}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			NewMethodSelectorFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %s", resp.Result.Value())
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/sign"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &recoverPubkeyFunction{}
+)
+
+// NewRecoverPubkeyFunction is a helper function to simplify the provider implementation.
+func NewRecoverPubkeyFunction() function.Function {
+	return &recoverPubkeyFunction{}
+}
+
+// recoverPubkeyFunction is the function implementation.
+type recoverPubkeyFunction struct{}
+
+// Metadata returns the function name.
+func (f *recoverPubkeyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "recover_pubkey"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *recoverPubkeyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Recovers the public key of a signature",
+		Description: "Returns the 0x prefixed hex secp256k1 public key which produced the signature of the message hash. " +
+			"Use `address_matches_pubkey` to check the key against an address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "message_hash",
+				Description: "0x prefixed hex, 32 byte hash which was signed, like the `message_hash` of the `polygonedge_sign` ephemeral resource.",
+			},
+			function.StringParameter{
+				Name:        "signature",
+				Description: "0x prefixed hex, 65 byte signature as r || s || v, with v being 0, 1, 27 or 28.",
+			},
+			function.BoolParameter{
+				Name:        "compressed",
+				Description: "Whether to return the 33 byte compressed form of the public key instead of the 65 byte uncompressed form.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run recovers the public key.
+func (f *recoverPubkeyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var messageHash, signature string
+	var compressed bool
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &messageHash, &signature, &compressed))
+	if resp.Error != nil {
+		return
+	}
+
+	hash, err := hex.DecodeHex(messageHash)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid message hash: %s.", err))
+		return
+	}
+	sig, err := hex.DecodeHex(signature)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid signature: %s.", err))
+		return
+	}
+
+	pub, err := sign.RecoverPubkey(hash, sig)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Unable to recover the public key: %s.", err))
+		return
+	}
+
+	serialized := (*btcec.PublicKey)(pub).SerializeUncompressed()
+	if compressed {
+		serialized = (*btcec.PublicKey)(pub).SerializeCompressed()
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hex.EncodeToHex(serialized)))
+}
@@ -0,0 +1,94 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/sign"
+)
+
+// testPrivateKey is the private key of testAddress, the first Hardhat development account.
+const testPrivateKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+func recoverPubkey(messageHash, signature string, compressed bool) (string, *function.FuncError) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewRecoverPubkeyFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(messageHash),
+			types.StringValue(signature),
+			types.BoolValue(compressed),
+		}),
+	}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func TestRecoverPubkeyFunction(t *testing.T) {
+	key, err := crypto.BytesToECDSAPrivateKey([]byte(testPrivateKey))
+	if err != nil {
+		t.Fatalf("unable to parse key: %v", err)
+	}
+	hash := sign.MessageHash([]byte("hello"))
+	signature, err := crypto.Sign(key, hash)
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+	withOffset := append([]byte{}, signature...)
+	withOffset[64] += 27
+
+	for name, sig := range map[string][]byte{"v 0 or 1": signature, "v 27 or 28": withOffset} {
+		t.Run(name, func(t *testing.T) {
+			for _, compressed := range []bool{false, true} {
+				pubkey, funcErr := recoverPubkey(hex.EncodeToHex(hash), hex.EncodeToHex(sig), compressed)
+				if funcErr != nil {
+					t.Fatalf("unexpected error: %s", funcErr)
+				}
+				want := testUncompressedPublicKey
+				if compressed {
+					want = testCompressedPublicKey
+				}
+				if pubkey != want {
+					t.Errorf("compressed %t: got %s, want %s", compressed, pubkey, want)
+				}
+
+				raw, _ := hex.DecodeHex(pubkey)
+				pub, err := btcec.ParsePubKey(raw, crypto.S256)
+				if err != nil {
+					t.Fatalf("unable to parse the recovered public key: %v", err)
+				}
+				if address := crypto.PubKeyToAddress(pub.ToECDSA()).String(); address != testAddress {
+					t.Errorf("compressed %t: recovered key derives %s, want %s", compressed, address, testAddress)
+				}
+			}
+		})
+	}
+}
+
+func TestRecoverPubkeyFunctionInvalidSignature(t *testing.T) {
+	hash := hex.EncodeToHex(sign.MessageHash([]byte("hello")))
+	signature := "0x" + strings.Repeat("11", 64)
+	for name, tt := range map[string]struct{ hash, signature string }{
+		"short hash":       {hash: hash[:34], signature: signature + "00"},
+		"short signature":  {hash: hash, signature: signature},
+		"invalid v":        {hash: hash, signature: signature + "02"},
+		"invalid hex":      {hash: hash, signature: "0xzz"},
+		"zero signature":   {hash: hash, signature: "0x" + strings.Repeat("00", 65)},
+		"invalid hash hex": {hash: "0xzz", signature: signature + "00"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if pubkey, funcErr := recoverPubkey(tt.hash, tt.signature, true); funcErr == nil {
+				t.Errorf("expected an error, got %s", pubkey)
+			}
+		})
+	}
+}
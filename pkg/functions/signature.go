@@ -0,0 +1,120 @@
+package functions
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// signatureRegexp splits a function signature into its name and parameter list.
+var signatureRegexp = regexp.MustCompile(`^([A-Za-z_$][A-Za-z0-9_$]*)\((.*)\)$`)
+
+// sizedTypeRegexp matches the elementary types with a size suffix.
+var sizedTypeRegexp = regexp.MustCompile(`^(uint|int|bytes)([0-9]+)$`)
+
+// validateSignature checks the function signature is in the canonical form the selector is computed from,
+// like `transfer(address,uint256)`.
+func validateSignature(signature string) error {
+	match := signatureRegexp.FindStringSubmatch(signature)
+	if match == nil {
+		return fmt.Errorf("must be a function name followed by its parameter types in parentheses")
+	}
+
+	p := &typeListParser{input: match[2]}
+	if err := p.parseList(); err != nil {
+		return err
+	}
+	if p.pos != len(p.input) {
+		return fmt.Errorf("unexpected %q", p.input[p.pos:])
+	}
+	return nil
+}
+
+// typeListParser parses a comma separated list of canonical ABI types.
+type typeListParser struct {
+	input string
+	pos   int
+}
+
+// parseList parses a possibly empty list of types, up to a closing parenthesis or the end of the input.
+func (p *typeListParser) parseList() error {
+	if p.pos == len(p.input) || p.input[p.pos] == ')' {
+		return nil
+	}
+	for {
+		if err := p.parseType(); err != nil {
+			return err
+		}
+		if p.pos == len(p.input) || p.input[p.pos] != ',' {
+			return nil
+		}
+		p.pos++
+	}
+}
+
+// parseType parses an elementary or tuple type, followed by any array suffixes.
+func (p *typeListParser) parseType() error {
+	if p.pos < len(p.input) && p.input[p.pos] == '(' {
+		p.pos++
+		if err := p.parseList(); err != nil {
+			return err
+		}
+		if p.pos == len(p.input) || p.input[p.pos] != ')' {
+			return fmt.Errorf("unterminated tuple type")
+		}
+		p.pos++
+	} else {
+		end := p.pos
+		for end < len(p.input) && !strings.ContainsRune(",()[", rune(p.input[end])) {
+			end++
+		}
+		if err := validateElementaryType(p.input[p.pos:end]); err != nil {
+			return err
+		}
+		p.pos = end
+	}
+
+	for p.pos < len(p.input) && p.input[p.pos] == '[' {
+		end := strings.IndexByte(p.input[p.pos:], ']')
+		if end < 0 {
+			return fmt.Errorf("unterminated array type")
+		}
+		if size := p.input[p.pos+1 : p.pos+end]; size != "" {
+			if n, err := strconv.ParseUint(size, 10, 64); err != nil || n == 0 || size[0] == '0' {
+				return fmt.Errorf("invalid array size %q", size)
+			}
+		}
+		p.pos += end + 1
+	}
+	return nil
+}
+
+// validateElementaryType checks the type is a canonical elementary ABI type, aliases like `uint` are rejected
+// because the selector is computed from the canonical name.
+func validateElementaryType(typ string) error {
+	switch typ {
+	case "address", "bool", "bytes", "string", "function":
+		return nil
+	case "uint", "int":
+		return fmt.Errorf("type %q is not canonical, use %q", typ, typ+"256")
+	case "":
+		return fmt.Errorf("missing type")
+	}
+
+	match := sizedTypeRegexp.FindStringSubmatch(typ)
+	if match == nil || match[2][0] == '0' {
+		return fmt.Errorf("unsupported type %q", typ)
+	}
+	size, _ := strconv.Atoi(match[2])
+	if match[1] == "bytes" {
+		if size < 1 || size > 32 {
+			return fmt.Errorf("invalid type %q: fixed bytes must have 1 to 32 bytes", typ)
+		}
+		return nil
+	}
+	if size%8 != 0 || size < 8 || size > 256 {
+		return fmt.Errorf("invalid type %q: integers must have a multiple of 8 bits, up to 256", typ)
+	}
+	return nil
+}
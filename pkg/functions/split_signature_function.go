@@ -0,0 +1,115 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/sign"
+)
+
+// signatureLength is the length of an r || s || v signature.
+const signatureLength = 65
+
+// signatureParts are the components of an r || s || v signature.
+type signatureParts struct {
+	R string `tfsdk:"r"`
+	S string `tfsdk:"s"`
+	V int64  `tfsdk:"v"`
+}
+
+// signaturePartsAttrTypes are the attribute types of a signatureParts object.
+var signaturePartsAttrTypes = map[string]attr.Type{
+	"r": types.StringType,
+	"s": types.StringType,
+	"v": types.Int64Type,
+}
+
+// validateVOffset checks the offset added to the recovery id is one of the two v conventions.
+func validateVOffset(offset int64) error {
+	if offset != 0 && offset != 27 {
+		return fmt.Errorf("must be 0, for v being 0 or 1, or 27, for v being 27 or 28, got %d", offset)
+	}
+	return nil
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &splitSignatureFunction{}
+)
+
+// NewSplitSignatureFunction is a helper function to simplify the provider implementation.
+func NewSplitSignatureFunction() function.Function {
+	return &splitSignatureFunction{}
+}
+
+// splitSignatureFunction is the function implementation.
+type splitSignatureFunction struct{}
+
+// Metadata returns the function name.
+func (f *splitSignatureFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "split_signature"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *splitSignatureFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Splits a signature into r, s and v",
+		Description: "Returns an object with the `r` and `s` components of the signature, as 0x prefixed, 32 byte hex, " +
+			"and its `v` component as a number, for contract calls and meta-transaction relayers which take them separately. " +
+			"Use `join_signature` to join them back.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "signature",
+				Description: "0x prefixed hex, 65 byte signature as r || s || v, with v being 0, 1, 27 or 28.",
+			},
+			function.Int64Parameter{
+				Name: "v_offset",
+				Description: "Convention of the returned `v`, the offset added to the recovery id: 0 returns 0 or 1, " +
+					"27 returns 27 or 28, as `ecrecover` expects.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: signaturePartsAttrTypes,
+		},
+	}
+}
+
+// Run splits the signature.
+func (f *splitSignatureFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var signature string
+	var vOffset int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &signature, &vOffset))
+	if resp.Error != nil {
+		return
+	}
+
+	sig, err := hex.DecodeHex(signature)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid signature: %s.", err))
+		return
+	}
+	if len(sig) != signatureLength {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid signature: must be %d bytes, got %d.", signatureLength, len(sig)))
+		return
+	}
+	recoveryID, err := sign.RecoveryID(sig[64])
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid signature: %s.", err))
+		return
+	}
+	if err := validateVOffset(vOffset); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid v offset: %s.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, signatureParts{
+		R: hex.EncodeToHex(sig[:32]),
+		S: hex.EncodeToHex(sig[32:64]),
+		V: int64(recoveryID) + vOffset,
+	}))
+}
@@ -0,0 +1,82 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	testSignatureR = "0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d"
+	testSignatureS = "0x07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562"
+)
+
+func splitSignature(t *testing.T, signature string, vOffset int64) (signatureParts, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+	resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(signaturePartsAttrTypes))}
+	NewSplitSignatureFunction().Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(signature), types.Int64Value(vOffset)}),
+	}, resp)
+	if resp.Error != nil {
+		return signatureParts{}, resp.Error
+	}
+
+	var parts signatureParts
+	if diags := resp.Result.Value().(types.Object).As(ctx, &parts, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("unable to read result: %v", diags)
+	}
+	return parts, nil
+}
+
+func TestSplitSignature(t *testing.T) {
+	for _, tt := range []struct {
+		v       string
+		vOffset int64
+		wantV   int64
+	}{
+		{"00", 0, 0},
+		{"01", 0, 1},
+		{"00", 27, 27},
+		{"01", 27, 28},
+		{"1b", 0, 0},
+		{"1c", 0, 1},
+		{"1b", 27, 27},
+		{"1c", 27, 28},
+	} {
+		signature := testSignatureR + strings.TrimPrefix(testSignatureS, "0x") + tt.v
+		parts, err := splitSignature(t, signature, tt.vOffset)
+		if err != nil {
+			t.Fatalf("split_signature(%s, %d) error = %s", signature, tt.vOffset, err)
+		}
+		want := signatureParts{R: testSignatureR, S: testSignatureS, V: tt.wantV}
+		if parts != want {
+			t.Errorf("split_signature(%s, %d) = %+v, want %+v", signature, tt.vOffset, parts, want)
+		}
+	}
+}
+
+func TestSplitSignatureInvalid(t *testing.T) {
+	valid := testSignatureR + strings.TrimPrefix(testSignatureS, "0x") + "1b"
+	for name, tt := range map[string]struct {
+		signature string
+		vOffset   int64
+	}{
+		"not hex":        {"0xzz", 0},
+		"short":          {valid[:len(valid)-2], 0},
+		"long":           {valid + "00", 0},
+		"invalid v":      {testSignatureR + strings.TrimPrefix(testSignatureS, "0x") + "02", 0},
+		"invalid offset": {valid, 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := splitSignature(t, tt.signature, tt.vOffset); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,463 @@
+package fund
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// transferGas is the gas used by a plain value transfer.
+const transferGas = 21000
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &fundResource{}
+	_ resource.ResourceWithConfigure      = &fundResource{}
+	_ resource.ResourceWithModifyPlan     = &fundResource{}
+	_ resource.ResourceWithValidateConfig = &fundResource{}
+)
+
+// fundResourceModel maps the resource schema data.
+type fundResourceModel struct {
+	RPCURL           types.String `tfsdk:"rpc_url"`
+	FunderKeyEncoded types.String `tfsdk:"funder_key_encoded"`
+	Amount           types.String `tfsdk:"amount"`
+	Recipients       types.List   `tfsdk:"recipients"`
+	BatchSize        types.Int64  `tfsdk:"batch_size"`
+	TxHashes         types.List   `tfsdk:"tx_hashes"`
+	Funded           types.Bool   `tfsdk:"funded"`
+
+	ChainID          types.Int64 `tfsdk:"chain_id"`
+	SkipChainIDCheck types.Bool  `tfsdk:"skip_chain_id_check"`
+
+	Confirmation *rpc.ConfirmationModel `tfsdk:"confirmation"`
+}
+
+// fundRecipientModel maps a single recipient entry.
+type fundRecipientModel struct {
+	Address types.String `tfsdk:"address"`
+	Amount  types.String `tfsdk:"amount"`
+}
+
+// NewFundResource is a helper function to simplify the provider implementation.
+func NewFundResource() resource.Resource {
+	return &fundResource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// fundResource is the resource implementation.
+type fundResource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the resource type name.
+func (r *fundResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fund"
+}
+
+// Schema defines the schema for the resource.
+func (r *fundResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends funds from a funder account to a list of recipients and waits for the transfers to be included. " +
+			"Destroying this resource does not return the funds.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node the transfers are sent to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"funder_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Encoded validator key of the account paying for the transfers.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+			},
+			"amount": schema.StringAttribute{
+				Optional:    true,
+				Description: "Amount in wei sent to every recipient that does not set its own amount.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.Wei(),
+				},
+			},
+			"recipients": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Accounts to fund.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Required:    true,
+							Description: "Recipient address.",
+							Validators: []validator.String{
+								validators.Address(),
+							},
+						},
+						"amount": schema.StringAttribute{
+							Optional:    true,
+							Description: "Amount in wei sent to this recipient. Overrides the top level `amount`.",
+							Validators: []validator.String{
+								validators.Wei(),
+							},
+						},
+					},
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(10),
+				Description: "Number of transfers submitted before waiting for them to be included, 0 to submit all of them " +
+					"before waiting. Defaults to 10.",
+			},
+			"chain_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "Expected chain id of the endpoint. Transfers are not sent if the endpoint serves another chain. " +
+					"Defaults to the provider `chain_id`.",
+			},
+			"skip_chain_id_check": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip comparing the endpoint chain id with `chain_id`.",
+			},
+			"confirmation": rpc.ConfirmationSchema(),
+			"tx_hashes": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Hashes of the transfer transactions, in the same order as `recipients`. " +
+					"Null for recipients which are not funded yet.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"funded": schema.BoolAttribute{
+				Computed: true,
+				Description: "Whether every recipient is funded. If a transfer fails once others were sent, the apply " +
+					"succeeds with a warning and `funded` false, and the next apply sends only the remaining transfers, " +
+					"after waiting for the recorded ones, so that no recipient is paid twice.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the resource.
+func (r *fundResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = data
+}
+
+// ValidateConfig ensures the batch size is not negative and every recipient has an amount to receive.
+func (r *fundResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config fundResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.BatchSize.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("batch_size"),
+			"Invalid batch size",
+			"Batch size must not be negative; 0 sends all transfers in one batch.",
+		)
+	}
+	if config.Recipients.IsUnknown() || config.Recipients.IsNull() || !config.Amount.IsNull() {
+		return
+	}
+
+	var recipients []fundRecipientModel
+	resp.Diagnostics.Append(config.Recipients.ElementsAs(ctx, &recipients, false)...)
+	for i, recipient := range recipients {
+		if recipient.Amount.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("recipients").AtListIndex(i).AtName("amount"),
+				"Missing amount",
+				"Either the recipient amount or the top level amount must be set.",
+			)
+		}
+	}
+}
+
+// ModifyPlan resumes the funding of a resource whose last apply did not fund every recipient.
+func (r *fundResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state fundResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.Funded.IsNull() || state.Funded.ValueBool() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("funded"), types.BoolUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tx_hashes"), types.ListUnknown(types.StringType))...)
+}
+
+func (r *fundResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan fundResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hashes := make([]types.String, len(plan.Recipients.Elements()))
+	for i := range hashes {
+		hashes[i] = types.StringNull()
+	}
+	funded, diags := r.fund(ctx, plan, hashes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.setState(ctx, plan, hashes, funded, &resp.State, &resp.Diagnostics)
+}
+
+// fund sends the transfers of the recipients which have no transfer hash yet, in batches, and waits for every
+// transfer to be included, updating the hashes in place. Hashes of transfers which are not included yet are kept,
+// so that they are waited for again instead of being sent twice; hashes of reverted transfers are cleared.
+// It returns whether every recipient is funded. Once a transfer is recorded or sent, failures are warnings, so that
+// the recorded transfers are saved in state and the next apply only sends the remaining ones.
+func (r *fundResource) fund(ctx context.Context, plan fundResourceModel, hashes []types.String) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var recipients []fundRecipientModel
+	diags.Append(plan.Recipients.ElementsAs(ctx, &recipients, false)...)
+	if diags.HasError() {
+		return false, diags
+	}
+	if len(hashes) != len(recipients) {
+		diags.AddError("Unable to fund recipients", fmt.Sprintf("Expected %d transfer hashes, got %d. Please report this issue to the provider developers.", len(recipients), len(hashes)))
+		return false, diags
+	}
+
+	confirmation, confirmationDiags := plan.Confirmation.Config(path.Root("confirmation"))
+	diags.Append(confirmationDiags...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	funderKey, err := crypto.BytesToECDSAPrivateKey([]byte(plan.FunderKeyEncoded.ValueString()))
+	if err != nil {
+		diags.Append(diagnostics.KeyParse(path.Root("funder_key_encoded"), diagnostics.ValidatorKey, err))
+		return false, diags
+	}
+	funder := crypto.PubKeyToAddress(&funderKey.PublicKey)
+
+	// incomplete reports a failure, as an error while nothing was sent or recorded, so that no state is saved.
+	sent := false
+	incomplete := func(summary, detail string) {
+		if sent {
+			diags.AddWarning(summary, detail+" The sent transfers are kept in state, the next apply only sends the remaining ones.")
+			return
+		}
+		for _, hash := range hashes {
+			if !hash.IsNull() {
+				diags.AddWarning(summary, detail+" The sent transfers are kept in state, the next apply only sends the remaining ones.")
+				return
+			}
+		}
+		diags.AddError(summary, detail)
+	}
+
+	client := rpc.NewClient(plan.RPCURL.ValueString(), r.providerData.RPC)
+	funded := true
+
+	// Transfers recorded by a previous apply are only waited for, they are never sent again.
+	for i, hash := range hashes {
+		if hash.IsNull() {
+			continue
+		}
+		receipt, err := client.WaitForConfirmation(ctx, edgetypes.StringToHash(hash.ValueString()), confirmation)
+		switch {
+		case err != nil:
+			incomplete("Unable to confirm transfer", err.Error()+".")
+			funded = false
+		case !receipt.Succeeded():
+			hashes[i] = types.StringNull()
+			diags.AddWarning("Transfer failed", fmt.Sprintf("Transaction %s was reverted, it is sent again.", hash.ValueString()))
+		}
+	}
+	if !funded {
+		return false, diags
+	}
+
+	var pending []int
+	for i, hash := range hashes {
+		if hash.IsNull() {
+			pending = append(pending, i)
+		}
+	}
+	if len(pending) == 0 {
+		return true, diags
+	}
+
+	var expectedChainID uint64
+	if !plan.SkipChainIDCheck.ValueBool() {
+		expectedChainID = r.providerData.ChainIDOrDefault(plan.ChainID)
+	}
+	chainID, err := client.GuardedChainID(ctx, expectedChainID)
+	if err != nil {
+		incomplete("Unable to verify chain id", err.Error())
+		return false, diags
+	}
+	gasPrice, err := client.GasPrice(ctx)
+	if err != nil {
+		incomplete("Unable to get gas price", err.Error())
+		return false, diags
+	}
+	nonce, err := client.PendingNonce(ctx, funder)
+	if err != nil {
+		incomplete("Unable to get funder nonce", err.Error())
+		return false, diags
+	}
+
+	batchSize := int(plan.BatchSize.ValueInt64())
+	if batchSize <= 0 {
+		batchSize = len(pending)
+	}
+
+	for start := 0; start < len(pending); start += batchSize {
+		end := start + batchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+
+		batch := pending[start:end]
+		for _, i := range batch {
+			amount := recipients[i].Amount
+			if amount.IsNull() {
+				amount = plan.Amount
+			}
+			value, _ := new(big.Int).SetString(amount.ValueString(), 10)
+			to := edgetypes.StringToAddress(recipients[i].Address.ValueString())
+
+			tflog.Debug(ctx, "Sending funds", map[string]interface{}{"to": to.String(), "value": value.String()})
+			hash, err := client.SignAndSend(ctx, funderKey, chainID, &edgetypes.Transaction{
+				Nonce:    nonce,
+				GasPrice: gasPrice,
+				Gas:      transferGas,
+				To:       &to,
+				Value:    value,
+			})
+			if err != nil {
+				incomplete(fmt.Sprintf("Unable to fund %s", to), err.Error())
+				return false, diags
+			}
+			nonce++
+			sent = true
+			hashes[i] = types.StringValue(hash.String())
+		}
+
+		for _, i := range batch {
+			receipt, err := client.WaitForConfirmation(ctx, edgetypes.StringToHash(hashes[i].ValueString()), confirmation)
+			switch {
+			case err != nil:
+				incomplete("Unable to confirm transfer", err.Error()+".")
+				funded = false
+			case !receipt.Succeeded():
+				reverted := hashes[i].ValueString()
+				hashes[i] = types.StringNull()
+				incomplete("Transfer failed", fmt.Sprintf("Transaction %s was reverted.", reverted))
+				funded = false
+			}
+		}
+		if !funded {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
+
+// setState records the transfer hashes and whether every recipient is funded.
+func (r *fundResource) setState(ctx context.Context, plan fundResourceModel, hashes []types.String, funded bool, state *tfsdk.State, diags *diag.Diagnostics) {
+	hashList, listDiags := types.ListValueFrom(ctx, types.StringType, hashes)
+	diags.Append(listDiags...)
+	plan.TxHashes = hashList
+	plan.Funded = types.BoolValue(funded)
+	diags.Append(state.Set(ctx, &plan)...)
+}
+
+func (r *fundResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	// NO-OP: sent transfers cannot change, the state already holds everything there is to read.
+	tflog.Debug(ctx, "Reading funds from state")
+}
+
+func (r *fundResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state fundResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings which do not affect sent transfers are updated in place, without sending anything.
+	if !plan.Funded.IsUnknown() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	// The recipients did not change, or the resource would be replaced, so the recorded hashes are theirs.
+	var hashes []types.String
+	resp.Diagnostics.Append(state.TxHashes.ElementsAs(ctx, &hashes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	funded, diags := r.fund(ctx, plan, hashes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		// Nothing was sent, the recorded hashes are kept as they were.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+	tflog.Debug(ctx, "Resumed funding", map[string]interface{}{"funded": funded})
+	r.setState(ctx, plan, hashes, funded, &resp.State, &resp.Diagnostics)
+}
+
+func (r *fundResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing funds from state")
+}
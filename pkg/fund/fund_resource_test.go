@@ -0,0 +1,471 @@
+package fund
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+)
+
+// testFunderKey is an encoded validator key, the first Hardhat development account.
+const testFunderKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+// mockNode is a JSON-RPC endpoint which accepts transfers and includes them at once.
+type mockNode struct {
+	mu       sync.Mutex
+	chainID  uint64
+	nonce    uint64
+	sent     []*edgetypes.Transaction
+	receipts map[edgetypes.Hash]string
+	// methods records the called methods, in order.
+	methods []string
+	// failSends is the number of sends accepted before every other send fails, negative to accept all.
+	failSends int
+	// revert is the recipient whose transfers are reverted.
+	revert edgetypes.Address
+	// authorization is the Authorization header of the last request.
+	authorization string
+}
+
+func newMockNode(t *testing.T) (*mockNode, string) {
+	node := &mockNode{chainID: 100, failSends: -1, receipts: map[edgetypes.Hash]string{}}
+	server := httptest.NewServer(http.HandlerFunc(node.serve))
+	t.Cleanup(server.Close)
+	return node, server.URL
+}
+
+func (n *mockNode) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     uint64            `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.methods = append(n.methods, req.Method)
+	n.authorization = r.Header.Get("Authorization")
+
+	var result interface{}
+	var rpcErr interface{}
+	switch req.Method {
+	case "eth_chainId":
+		result = fmt.Sprintf("0x%x", n.chainID)
+	case "eth_gasPrice":
+		result = "0x1"
+	case "eth_getTransactionCount":
+		result = fmt.Sprintf("0x%x", n.nonce)
+	case "eth_sendRawTransaction":
+		if n.failSends == 0 {
+			rpcErr = map[string]interface{}{"code": -32000, "message": "insufficient funds"}
+			break
+		}
+		n.failSends--
+
+		var encoded string
+		_ = json.Unmarshal(req.Params[0], &encoded)
+		raw, _ := hex.DecodeHex(encoded)
+		tx := &edgetypes.Transaction{}
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			rpcErr = map[string]interface{}{"code": -32000, "message": err.Error()}
+			break
+		}
+		tx.ComputeHash()
+		n.sent = append(n.sent, tx)
+		n.nonce++
+		n.receipts[tx.Hash] = "0x1"
+		if *tx.To == n.revert {
+			n.receipts[tx.Hash] = "0x0"
+		}
+		result = tx.Hash.String()
+	case "eth_getTransactionReceipt":
+		var hash string
+		_ = json.Unmarshal(req.Params[0], &hash)
+		if status, ok := n.receipts[edgetypes.StringToHash(hash)]; ok {
+			result = map[string]interface{}{"transactionHash": hash, "blockNumber": "0x1", "status": status}
+		}
+	default:
+		rpcErr = map[string]interface{}{"code": -32601, "message": "method not found"}
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result, "error": rpcErr})
+}
+
+// sentTo returns the recipients and values of the sent transfers, in order.
+func (n *mockNode) sentTo() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	transfers := make([]string, len(n.sent))
+	for i, tx := range n.sent {
+		transfers[i] = fmt.Sprintf("%s:%s@%d", tx.To, tx.Value, tx.Nonce)
+	}
+	return transfers
+}
+
+var (
+	recipientA = edgetypes.StringToAddress("0x000000000000000000000000000000000000000a")
+	recipientB = edgetypes.StringToAddress("0x000000000000000000000000000000000000000b")
+	recipientC = edgetypes.StringToAddress("0x000000000000000000000000000000000000000c")
+)
+
+// fundConfig returns the configuration of a fund resource paying the recipients, by address, the given amounts,
+// null for the top level amount.
+func fundConfig(t *testing.T, url string, batchSize int64, recipients []edgetypes.Address, amounts []string) map[string]tftypes.Value {
+	t.Helper()
+	schema := resourceSchema(t)
+	objectType := schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	recipientsType := objectType.AttributeTypes["recipients"].(tftypes.List)
+	recipientType := recipientsType.ElementType.(tftypes.Object)
+	confirmationType := objectType.AttributeTypes["confirmation"].(tftypes.Object)
+
+	list := make([]tftypes.Value, len(recipients))
+	for i, recipient := range recipients {
+		amount := tftypes.NewValue(tftypes.String, nil)
+		if amounts[i] != "" {
+			amount = tftypes.NewValue(tftypes.String, amounts[i])
+		}
+		list[i] = tftypes.NewValue(recipientType, map[string]tftypes.Value{
+			"address": tftypes.NewValue(tftypes.String, recipient.String()),
+			"amount":  amount,
+		})
+	}
+
+	return map[string]tftypes.Value{
+		"rpc_url":            tftypes.NewValue(tftypes.String, url),
+		"funder_key_encoded": tftypes.NewValue(tftypes.String, testFunderKey),
+		"amount":             tftypes.NewValue(tftypes.String, "1000"),
+		"recipients":         tftypes.NewValue(recipientsType, list),
+		"batch_size":         tftypes.NewValue(tftypes.Number, batchSize),
+		"confirmation": tftypes.NewValue(confirmationType, map[string]tftypes.Value{
+			"timeout":       tftypes.NewValue(tftypes.String, "2s"),
+			"poll_interval": tftypes.NewValue(tftypes.String, "10ms"),
+			"confirmations": tftypes.NewValue(tftypes.Number, nil),
+		}),
+	}
+}
+
+func resourceSchema(t *testing.T) rschema.Schema {
+	t.Helper()
+	resp := &resource.SchemaResponse{}
+	NewFundResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("invalid schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+// objectValue returns the resource object with the given attributes, the others set to the value of fill.
+func objectValue(t *testing.T, attrs map[string]tftypes.Value, fill func(tftypes.Type) tftypes.Value) tftypes.Value {
+	t.Helper()
+	objectType := resourceSchema(t).Type().TerraformType(context.Background()).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = fill(typ)
+	}
+	for name, value := range attrs {
+		values[name] = value
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+func nullValue(typ tftypes.Type) tftypes.Value    { return tftypes.NewValue(typ, nil) }
+func unknownValue(typ tftypes.Type) tftypes.Value { return tftypes.NewValue(typ, tftypes.UnknownValue) }
+
+// create applies a new fund resource, returning its state, null if none was saved, and its diagnostics.
+func create(t *testing.T, attrs map[string]tftypes.Value) (fundResourceModel, bool, *resource.CreateResponse) {
+	t.Helper()
+	return createWith(t, providerdata.Default(), attrs)
+}
+
+// createWith applies a new fund resource configured with the provider data.
+func createWith(t *testing.T, data providerdata.Data, attrs map[string]tftypes.Value) (fundResourceModel, bool, *resource.CreateResponse) {
+	t.Helper()
+	ctx := context.Background()
+	schema := resourceSchema(t)
+	r := NewFundResource()
+	configureResp := &resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: data}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(objectValue(t, nil, nullValue).Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: schema, Raw: objectValue(t, attrs, nullValue)},
+		Plan:   tfsdk.Plan{Schema: schema, Raw: objectValue(t, attrs, unknownValue)},
+	}, resp)
+
+	var state fundResourceModel
+	if resp.State.Raw.IsNull() {
+		return state, false, resp
+	}
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	return state, true, resp
+}
+
+// resume plans and applies an unchanged configuration on the prior state of a fund resource.
+func resume(t *testing.T, prior tfsdk.State) (fundResourceModel, *resource.UpdateResponse) {
+	t.Helper()
+	ctx := context.Background()
+	r := NewFundResource()
+
+	planResp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: prior.Schema, Raw: prior.Raw.Copy()}}
+	r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{
+		State: prior,
+		Plan:  tfsdk.Plan{Schema: prior.Schema, Raw: prior.Raw.Copy()},
+	}, planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %v", planResp.Diagnostics)
+	}
+
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: prior.Schema, Raw: planResp.Plan.Raw.Copy()}}
+	r.Update(ctx, resource.UpdateRequest{State: prior, Plan: planResp.Plan}, resp)
+	var state fundResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	return state, resp
+}
+
+func txHashes(t *testing.T, state fundResourceModel) []types.String {
+	t.Helper()
+	var hashes []types.String
+	if diags := state.TxHashes.ElementsAs(context.Background(), &hashes, false); diags.HasError() {
+		t.Fatalf("unable to get tx hashes: %v", diags)
+	}
+	return hashes
+}
+
+func TestFundResourceBatches(t *testing.T) {
+	node, url := newMockNode(t)
+	node.nonce = 7
+	recipients := []edgetypes.Address{recipientA, recipientB, recipientC}
+
+	state, saved, resp := create(t, fundConfig(t, url, 2, recipients, []string{"", "5", ""}))
+	if !saved || resp.Diagnostics.ErrorsCount()+resp.Diagnostics.WarningsCount() > 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	want := []string{
+		recipientA.String() + ":1000@7",
+		recipientB.String() + ":5@8",
+		recipientC.String() + ":1000@9",
+	}
+	if got := node.sentTo(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("sent %v, want %v", got, want)
+	}
+	if !state.Funded.ValueBool() {
+		t.Error("funded is false")
+	}
+	for i, hash := range txHashes(t, state) {
+		if hash.ValueString() != node.sent[i].Hash.String() {
+			t.Errorf("tx hash %d is %s, want %s", i, hash, node.sent[i].Hash)
+		}
+	}
+
+	// The first batch of two transfers is confirmed before the last transfer is sent.
+	var sends, receiptsBeforeLastSend int
+	for _, method := range node.methods {
+		switch method {
+		case "eth_sendRawTransaction":
+			sends++
+		case "eth_getTransactionReceipt":
+			if sends == 2 {
+				receiptsBeforeLastSend++
+			}
+		}
+	}
+	if receiptsBeforeLastSend != 2 {
+		t.Errorf("%d receipts were fetched between the two batches, want 2", receiptsBeforeLastSend)
+	}
+}
+
+func TestFundResourceSingleBatch(t *testing.T) {
+	node, url := newMockNode(t)
+	_, _, resp := create(t, fundConfig(t, url, 0, []edgetypes.Address{recipientA, recipientB, recipientC}, []string{"", "", ""}))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var sends int
+	for _, method := range node.methods {
+		if method == "eth_sendRawTransaction" {
+			sends++
+		} else if method == "eth_getTransactionReceipt" && sends != 3 {
+			t.Fatalf("a receipt was fetched after %d of 3 sends, a batch size of 0 sends all before waiting", sends)
+		}
+	}
+}
+
+func TestFundResourceUsesProviderData(t *testing.T) {
+	node, url := newMockNode(t)
+	data := providerdata.Default()
+	data.RPC.Auth.BearerToken = "secret"
+
+	if _, _, resp := createWith(t, data, fundConfig(t, url, 10, []edgetypes.Address{recipientA}, []string{""})); resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if node.authorization != "Bearer secret" {
+		t.Errorf("Authorization header = %q, want the provider credentials", node.authorization)
+	}
+}
+
+func TestFundResourceChainIDMismatch(t *testing.T) {
+	node, url := newMockNode(t)
+	attrs := fundConfig(t, url, 10, []edgetypes.Address{recipientA}, []string{""})
+	attrs["chain_id"] = tftypes.NewValue(tftypes.Number, 101)
+
+	_, saved, resp := create(t, attrs)
+	if !resp.Diagnostics.HasError() || saved {
+		t.Fatalf("expected an error and no state, got %v", resp.Diagnostics)
+	}
+	if got := node.sentTo(); len(got) != 0 {
+		t.Errorf("transfers were sent to the wrong chain: %v", got)
+	}
+
+	attrs["skip_chain_id_check"] = tftypes.NewValue(tftypes.Bool, true)
+	if _, _, resp := create(t, attrs); resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics with the check skipped: %v", resp.Diagnostics)
+	}
+}
+
+func TestFundResourceResumesPartialFunding(t *testing.T) {
+	node, url := newMockNode(t)
+	node.failSends = 2
+	recipients := []edgetypes.Address{recipientA, recipientB, recipientC}
+
+	state, saved, resp := create(t, fundConfig(t, url, 1, recipients, []string{"", "", ""}))
+	if !saved || resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() == 0 {
+		t.Fatalf("expected a warning and a saved state, got %v", resp.Diagnostics)
+	}
+	if state.Funded.ValueBool() {
+		t.Error("funded is true after a failed transfer")
+	}
+	hashes := txHashes(t, state)
+	if hashes[0].IsNull() || hashes[1].IsNull() || !hashes[2].IsNull() {
+		t.Fatalf("tx hashes are %v, want the first two set", hashes)
+	}
+
+	node.failSends = -1
+	state, updateResp := resume(t, resp.State)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", updateResp.Diagnostics)
+	}
+	if !state.Funded.ValueBool() {
+		t.Error("funded is false after the remaining transfer was sent")
+	}
+
+	// Every recipient is paid exactly once.
+	want := []string{
+		recipientA.String() + ":1000@0",
+		recipientB.String() + ":1000@1",
+		recipientC.String() + ":1000@2",
+	}
+	if got := node.sentTo(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("sent %v, want %v", got, want)
+	}
+	if resumed := txHashes(t, state); !resumed[0].Equal(hashes[0]) || !resumed[1].Equal(hashes[1]) || resumed[2].IsNull() {
+		t.Errorf("tx hashes are %v after resuming from %v", resumed, hashes)
+	}
+}
+
+func TestFundResourceResendsRevertedTransfer(t *testing.T) {
+	node, url := newMockNode(t)
+	node.revert = recipientB
+
+	state, saved, resp := create(t, fundConfig(t, url, 10, []edgetypes.Address{recipientA, recipientB}, []string{"", ""}))
+	if !saved || resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() == 0 {
+		t.Fatalf("expected a warning and a saved state, got %v", resp.Diagnostics)
+	}
+	if hashes := txHashes(t, state); hashes[0].IsNull() || !hashes[1].IsNull() || state.Funded.ValueBool() {
+		t.Fatalf("tx hashes are %v and funded %s, want only the first hash and not funded", hashes, state.Funded)
+	}
+
+	node.revert = edgetypes.ZeroAddress
+	state, updateResp := resume(t, resp.State)
+	if updateResp.Diagnostics.HasError() || !state.Funded.ValueBool() {
+		t.Fatalf("resume failed: %v", updateResp.Diagnostics)
+	}
+	if got := node.sentTo(); len(got) != 3 || got[2] != recipientB.String()+":1000@2" {
+		t.Errorf("sent %v, want the reverted transfer sent again", got)
+	}
+}
+
+func TestFundResourceResendsRecordedRevertedTransfer(t *testing.T) {
+	node, url := newMockNode(t)
+	state, _, resp := create(t, fundConfig(t, url, 10, []edgetypes.Address{recipientA}, []string{""}))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	// The only recorded transfer is found reverted on the next apply, as after a reorg.
+	reverted := txHashes(t, state)[0]
+	node.receipts[edgetypes.StringToHash(reverted.ValueString())] = "0x0"
+	prior := resp.State
+	if diags := prior.SetAttribute(context.Background(), path.Root("funded"), false); diags.HasError() {
+		t.Fatalf("unable to set funded: %v", diags)
+	}
+
+	state, updateResp := resume(t, prior)
+	if updateResp.Diagnostics.HasError() || updateResp.Diagnostics.WarningsCount() == 0 {
+		t.Fatalf("expected a warning about the reverted transfer, got %v", updateResp.Diagnostics)
+	}
+	if !state.Funded.ValueBool() {
+		t.Error("funded is false after the reverted transfer was sent again")
+	}
+	if got := node.sentTo(); len(got) != 2 {
+		t.Fatalf("sent %v, want the reverted transfer sent again once", got)
+	}
+	// The new transfer is recorded, so that it is not sent a third time.
+	if hash := txHashes(t, state)[0]; hash.ValueString() != node.sent[1].Hash.String() {
+		t.Errorf("tx hash is %s, want the hash of the new transfer %s", hash, node.sent[1].Hash)
+	}
+}
+
+func TestFundResourceNothingSent(t *testing.T) {
+	node, url := newMockNode(t)
+	node.failSends = 0
+
+	_, saved, resp := create(t, fundConfig(t, url, 10, []edgetypes.Address{recipientA}, []string{""}))
+	if !resp.Diagnostics.HasError() || saved {
+		t.Fatalf("expected an error and no state when nothing was sent, got %v", resp.Diagnostics)
+	}
+}
+
+func TestFundResourceValidateBatchSize(t *testing.T) {
+	ctx := context.Background()
+	schema := resourceSchema(t)
+	for batchSize, wantErr := range map[int64]bool{-1: true, 0: false, 5: false} {
+		attrs := fundConfig(t, "http://localhost", batchSize, []edgetypes.Address{recipientA}, []string{""})
+		// Batch size is checked even while the recipients are not known yet.
+		attrs["recipients"] = tftypes.NewValue(attrs["recipients"].Type(), tftypes.UnknownValue)
+
+		resp := &resource.ValidateConfigResponse{}
+		NewFundResource().(resource.ResourceWithValidateConfig).ValidateConfig(ctx, resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schema, Raw: objectValue(t, attrs, nullValue)},
+		}, resp)
+		if resp.Diagnostics.HasError() != wantErr {
+			t.Errorf("batch size %d: got diagnostics %v, want error %t", batchSize, resp.Diagnostics, wantErr)
+		}
+	}
+}
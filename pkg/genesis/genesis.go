@@ -0,0 +1,136 @@
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/umbracle/fastrlp"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+)
+
+// Names of the consensus engines in the genesis `params.engine`.
+const (
+	engineIBFT    = "ibft"
+	enginePolyBFT = "polybft"
+)
+
+// istanbulExtraVanity is the number of bytes reserved for the proposer vanity before the IBFT extra data.
+const istanbulExtraVanity = 32
+
+// readGenesis reads and decodes a genesis document, given either as content or as a path.
+func readGenesis(content, filePath types.String, contentAttr, pathAttr string) (*chain.Chain, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sourcePath := path.Root(contentAttr)
+	data := []byte(content.ValueString())
+	if !filePath.IsNull() {
+		sourcePath = path.Root(pathAttr)
+		var err error
+		if data, err = os.ReadFile(filePath.ValueString()); err != nil {
+			diags.AddAttributeError(sourcePath, "Unable to read genesis file", err.Error())
+			return nil, diags
+		}
+	}
+
+	var genesis chain.Chain
+	if err := json.Unmarshal(data, &genesis); err != nil {
+		diags.AddAttributeError(sourcePath, "Invalid genesis", fmt.Sprintf("Unable to decode the genesis JSON: %s.", err))
+		return nil, diags
+	}
+	if genesis.Genesis == nil || genesis.Params == nil {
+		diags.AddAttributeError(sourcePath, "Invalid genesis", "The genesis JSON must set the `genesis` and `params` fields.")
+		return nil, diags
+	}
+
+	return &genesis, diags
+}
+
+// genesisValidators returns the addresses of the genesis validators. PolyBFT validators are listed in the
+// engine params, IBFT validators are encoded in the genesis extra data. Other engines have no validators.
+// The addresses are in the configured format.
+func genesisValidators(data providerdata.Data, genesis *chain.Chain) ([]string, error) {
+	if config, ok := genesis.Params.Engine[enginePolyBFT].(map[string]interface{}); ok {
+		set, _ := config["initialValidatorSet"].([]interface{})
+		addresses := make([]string, 0, len(set))
+		for i, validator := range set {
+			fields, _ := validator.(map[string]interface{})
+			address, ok := fields["address"].(string)
+			if !ok {
+				return nil, fmt.Errorf("initial validator %d has no address", i)
+			}
+			addresses = append(addresses, data.FormatAddress(edgetypes.StringToAddress(address)))
+		}
+		return addresses, nil
+	}
+
+	if _, ok := genesis.Params.Engine[engineIBFT]; !ok {
+		return nil, nil
+	}
+	set, err := ibftExtraValidators(genesis.Genesis.ExtraData)
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]string, set.Len())
+	for i := range addresses {
+		addresses[i] = data.FormatAddress(set.At(uint64(i)).Addr())
+	}
+	return addresses, nil
+}
+
+// ibftExtraValidators decodes the validator set of IBFT extra data, including its vanity.
+func ibftExtraValidators(extraData []byte) (validators.Validators, error) {
+	if len(extraData) < istanbulExtraVanity {
+		return nil, fmt.Errorf("the IBFT extra data is shorter than its %d byte vanity", istanbulExtraVanity)
+	}
+	// The validators are the first element of the RLP encoded IBFT extra data, which does not tell
+	// their type. A set only decodes as the type it was encoded with.
+	var parser fastrlp.Parser
+	extra, err := parser.Parse(extraData[istanbulExtraVanity:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the IBFT extra data: %w", err)
+	}
+	elems, err := extra.GetElems()
+	if err != nil || len(elems) == 0 {
+		return nil, fmt.Errorf("the IBFT extra data has no validators")
+	}
+	for _, set := range []validators.Validators{validators.NewECDSAValidatorSet(), validators.NewBLSValidatorSet()} {
+		if err := set.UnmarshalRLPFrom(&parser, elems[0]); err != nil {
+			continue
+		}
+		return set, nil
+	}
+	return nil, fmt.Errorf("unable to decode the IBFT validators of the genesis extra data")
+}
+
+// ibftGenesisExtraData encodes the IBFT extra data of a genesis block with the validator set, the same way
+// `polygon-edge genesis` does: an empty vanity, followed by the RLP encoded validators and empty seals.
+func ibftGenesisExtraData(set validators.Validators) []byte {
+	var arena fastrlp.Arena
+	extra := arena.NewArray()
+	extra.Set(set.MarshalRLPWith(&arena))
+	// Proposer seal.
+	extra.Set(arena.NewNull())
+	// Committed seals, a list of seals for ECDSA validators, an aggregated seal of a bitmap and a signature for BLS ones.
+	if set.Type() == validators.BLSValidatorType {
+		seal := arena.NewArray()
+		seal.Set(arena.NewNull())
+		seal.Set(arena.NewNull())
+		extra.Set(seal)
+	} else {
+		extra.Set(arena.NewNullArray())
+	}
+	// Parent committed seals.
+	extra.Set(arena.NewNullArray())
+	// Round number.
+	extra.Set(arena.NewNull())
+
+	return extra.MarshalTo(make([]byte, istanbulExtraVanity))
+}
@@ -0,0 +1,508 @@
+package genesis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+)
+
+// validatorSetParam is the path of the PolyBFT validator set in the flattened params, diffed as validators instead.
+const validatorSetParam = "engine." + enginePolyBFT + ".initialValidatorSet"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &genesisDiffDataSource{}
+	_ datasource.DataSourceWithConfigure      = &genesisDiffDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &genesisDiffDataSource{}
+)
+
+// genesisDiffDataSourceModel maps the data source schema data.
+type genesisDiffDataSourceModel struct {
+	OldPath    types.String `tfsdk:"old_path"`
+	OldContent types.String `tfsdk:"old_content"`
+	NewPath    types.String `tfsdk:"new_path"`
+	NewContent types.String `tfsdk:"new_content"`
+
+	Changed           types.Bool         `tfsdk:"changed"`
+	ValidatorsAdded   []string           `tfsdk:"validators_added"`
+	ValidatorsRemoved []string           `tfsdk:"validators_removed"`
+	Premine           []premineDiffModel `tfsdk:"premine"`
+	Forks             []forkDiffModel    `tfsdk:"forks"`
+	Params            []paramDiffModel   `tfsdk:"params"`
+	JSON              types.String       `tfsdk:"json"`
+}
+
+// premineDiffModel maps a changed premine balance.
+type premineDiffModel struct {
+	Address    string       `tfsdk:"address"`
+	OldBalance types.String `tfsdk:"old_balance"`
+	NewBalance types.String `tfsdk:"new_balance"`
+}
+
+// forkDiffModel maps a changed fork block.
+type forkDiffModel struct {
+	Name     string      `tfsdk:"name"`
+	OldBlock types.Int64 `tfsdk:"old_block"`
+	NewBlock types.Int64 `tfsdk:"new_block"`
+}
+
+// paramDiffModel maps a changed chain param.
+type paramDiffModel struct {
+	Path     string       `tfsdk:"path"`
+	OldValue types.String `tfsdk:"old_value"`
+	NewValue types.String `tfsdk:"new_value"`
+}
+
+// NewGenesisDiffDataSource is a helper function to simplify the provider implementation.
+func NewGenesisDiffDataSource() datasource.DataSource {
+	return &genesisDiffDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// genesisDiffDataSource is the data source implementation.
+type genesisDiffDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *genesisDiffDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_genesis_diff"
+}
+
+// Schema defines the schema for the data source.
+func (d *genesisDiffDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Compares two polygon-edge genesis documents, such as a generated genesis and a deployed one, " +
+			"and returns the validators, premine balances, forks and chain params which differ. " +
+			"Every list is sorted, so the diff is stable, and `json` holds the whole diff in a machine-readable form.",
+		Attributes: map[string]schema.Attribute{
+			"old_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the old genesis file. Conflicts with `old_content`.",
+			},
+			"old_content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Content of the old genesis file. Conflicts with `old_path`.",
+			},
+			"new_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the new genesis file. Conflicts with `new_content`.",
+			},
+			"new_content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Content of the new genesis file. Conflicts with `new_path`.",
+			},
+			"changed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether any of the compared fields differ.",
+			},
+			"validators_added": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Addresses of the validators only in the new genesis, sorted. IBFT validators are decoded " +
+					"from the genesis extra data, PolyBFT validators from the initial validator set.",
+			},
+			"validators_removed": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Addresses of the validators only in the old genesis, sorted.",
+			},
+			"premine": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Accounts whose genesis balance differs, sorted by address.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Computed:    true,
+							Description: "Account address.",
+						},
+						"old_balance": schema.StringAttribute{
+							Computed:    true,
+							Description: "Balance in wei in the old genesis. Null if the account is not in it.",
+						},
+						"new_balance": schema.StringAttribute{
+							Computed:    true,
+							Description: "Balance in wei in the new genesis. Null if the account is not in it.",
+						},
+					},
+				},
+			},
+			"forks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Forks whose activation block differs, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Fork name, as in the genesis `params.forks`.",
+						},
+						"old_block": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Activation block in the old genesis. Null if the fork is not enabled in it.",
+						},
+						"new_block": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Activation block in the new genesis. Null if the fork is not enabled in it.",
+						},
+					},
+				},
+			},
+			"params": schema.ListNestedAttribute{
+				Computed: true,
+				Description: "Chain params which differ, including the engine params, sorted by path. " +
+					"The forks and the PolyBFT validator set are left out, they are diffed on their own.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Dot separated path of the param in the genesis `params`, such as `engine.ibft.epochSize`.",
+						},
+						"old_value": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON encoded value in the old genesis. Null if the param is not in it.",
+						},
+						"new_value": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON encoded value in the new genesis. Null if the param is not in it.",
+						},
+					},
+				},
+			},
+			"json": schema.StringAttribute{
+				Computed:    true,
+				Description: "The whole diff, JSON encoded.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *genesisDiffDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// ValidateConfig ensures exactly one source is set for each genesis.
+func (d *genesisDiffDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config genesisDiffDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, source := range []struct {
+		name          string
+		path, content types.String
+	}{
+		{"old", config.OldPath, config.OldContent},
+		{"new", config.NewPath, config.NewContent},
+	} {
+		if source.path.IsNull() == source.content.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(source.name+"_content"),
+				"Invalid genesis source",
+				fmt.Sprintf("Exactly one of `%s_path` and `%s_content` must be set.", source.name, source.name),
+			)
+		}
+	}
+}
+
+// Read compares the genesis documents.
+func (d *genesisDiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state genesisDiffDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oldGenesis, diags := readGenesis(state.OldContent, state.OldPath, "old_content", "old_path")
+	resp.Diagnostics.Append(diags...)
+	newGenesis, diags := readGenesis(state.NewContent, state.NewPath, "new_content", "new_path")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diff, err := diffGenesis(d.providerData, oldGenesis, newGenesis)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to compare genesis", err.Error())
+		return
+	}
+	encoded, err := json.MarshalIndent(diff, "", "  ")
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to encode genesis diff", err.Error())
+		return
+	}
+
+	state.Changed = types.BoolValue(len(diff.ValidatorsAdded)+len(diff.ValidatorsRemoved)+len(diff.Premine)+len(diff.Forks)+len(diff.Params) > 0)
+	state.ValidatorsAdded = diff.ValidatorsAdded
+	state.ValidatorsRemoved = diff.ValidatorsRemoved
+	state.Premine = make([]premineDiffModel, len(diff.Premine))
+	for i, change := range diff.Premine {
+		state.Premine[i] = premineDiffModel{
+			Address:    change.Address,
+			OldBalance: types.StringPointerValue(change.OldBalance),
+			NewBalance: types.StringPointerValue(change.NewBalance),
+		}
+	}
+	state.Forks = make([]forkDiffModel, len(diff.Forks))
+	for i, change := range diff.Forks {
+		state.Forks[i] = forkDiffModel{
+			Name:     change.Name,
+			OldBlock: types.Int64PointerValue(change.OldBlock),
+			NewBlock: types.Int64PointerValue(change.NewBlock),
+		}
+	}
+	state.Params = make([]paramDiffModel, len(diff.Params))
+	for i, change := range diff.Params {
+		state.Params[i] = paramDiffModel{
+			Path:     change.Path,
+			OldValue: types.StringPointerValue(change.OldValue),
+			NewValue: types.StringPointerValue(change.NewValue),
+		}
+	}
+	state.JSON = types.StringValue(string(encoded))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// genesisDiff is the difference between two genesis documents, as encoded in the `json` attribute.
+// Absent values are nil.
+type genesisDiff struct {
+	ValidatorsAdded   []string        `json:"validators_added"`
+	ValidatorsRemoved []string        `json:"validators_removed"`
+	Premine           []premineChange `json:"premine"`
+	Forks             []forkChange    `json:"forks"`
+	Params            []paramChange   `json:"params"`
+}
+
+// premineChange is a changed premine balance.
+type premineChange struct {
+	Address    string  `json:"address"`
+	OldBalance *string `json:"old_balance"`
+	NewBalance *string `json:"new_balance"`
+}
+
+// forkChange is a changed fork activation block.
+type forkChange struct {
+	Name     string `json:"name"`
+	OldBlock *int64 `json:"old_block"`
+	NewBlock *int64 `json:"new_block"`
+}
+
+// paramChange is a changed chain param.
+type paramChange struct {
+	Path     string  `json:"path"`
+	OldValue *string `json:"old_value"`
+	NewValue *string `json:"new_value"`
+}
+
+// diffGenesis compares the validators, premine balances, forks and params of the genesis documents.
+func diffGenesis(data providerdata.Data, oldGenesis, newGenesis *chain.Chain) (*genesisDiff, error) {
+	diff := &genesisDiff{Premine: []premineChange{}, Forks: []forkChange{}, Params: []paramChange{}}
+
+	oldValidators, err := genesisValidators(data, oldGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("old genesis validators: %w", err)
+	}
+	newValidators, err := genesisValidators(data, newGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("new genesis validators: %w", err)
+	}
+	diff.ValidatorsAdded = missingFrom(newValidators, oldValidators)
+	diff.ValidatorsRemoved = missingFrom(oldValidators, newValidators)
+
+	for _, key := range changedKeys(premineBalances(data, oldGenesis), premineBalances(data, newGenesis)) {
+		diff.Premine = append(diff.Premine, premineChange{key.name, key.oldValue, key.newValue})
+	}
+
+	oldForks, err := forkBlocks(oldGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("old genesis forks: %w", err)
+	}
+	newForks, err := forkBlocks(newGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("new genesis forks: %w", err)
+	}
+	for _, key := range changedKeys(oldForks, newForks) {
+		diff.Forks = append(diff.Forks, forkChange{key.name, forkBlock(key.oldValue), forkBlock(key.newValue)})
+	}
+
+	oldParams, err := flatParams(oldGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("old genesis params: %w", err)
+	}
+	newParams, err := flatParams(newGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("new genesis params: %w", err)
+	}
+	for _, key := range changedKeys(oldParams, newParams) {
+		diff.Params = append(diff.Params, paramChange{key.name, key.oldValue, key.newValue})
+	}
+
+	return diff, nil
+}
+
+// missingFrom returns the sorted values of a which are not in b.
+func missingFrom(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, value := range b {
+		in[value] = true
+	}
+	missing := []string{}
+	for _, value := range a {
+		if !in[value] {
+			missing = append(missing, value)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// changedKey is a key whose value differs between two maps, nil where the map does not have the key.
+type changedKey struct {
+	name               string
+	oldValue, newValue *string
+}
+
+// changedKeys returns the keys whose value differs between the maps, sorted.
+func changedKeys(oldValues, newValues map[string]string) []changedKey {
+	names := map[string]bool{}
+	for name := range oldValues {
+		names[name] = true
+	}
+	for name := range newValues {
+		names[name] = true
+	}
+
+	var changed []changedKey
+	for name := range names {
+		oldValue, inOld := oldValues[name]
+		newValue, inNew := newValues[name]
+		if inOld && inNew && oldValue == newValue {
+			continue
+		}
+		key := changedKey{name: name}
+		if inOld {
+			key.oldValue = &oldValue
+		}
+		if inNew {
+			key.newValue = &newValue
+		}
+		changed = append(changed, key)
+	}
+	sort.Slice(changed, func(i, j int) bool { return changed[i].name < changed[j].name })
+	return changed
+}
+
+// premineBalances returns the decimal genesis balances by address, in the configured format.
+func premineBalances(data providerdata.Data, genesis *chain.Chain) map[string]string {
+	balances := make(map[string]string, len(genesis.Genesis.Alloc))
+	for address, account := range genesis.Genesis.Alloc {
+		balance := new(big.Int)
+		if account != nil && account.Balance != nil {
+			balance = account.Balance
+		}
+		balances[data.FormatAddress(address)] = balance.String()
+	}
+	return balances
+}
+
+// forkBlocks returns the decimal activation blocks of the enabled forks by name.
+func forkBlocks(genesis *chain.Chain) (map[string]string, error) {
+	blocks := map[string]string{}
+	if genesis.Params.Forks == nil {
+		return blocks, nil
+	}
+
+	encoded, err := json.Marshal(genesis.Params.Forks)
+	if err != nil {
+		return nil, err
+	}
+	var forks map[string]json.Number
+	if err := json.Unmarshal(encoded, &forks); err != nil {
+		return nil, err
+	}
+	for name, block := range forks {
+		blocks[name] = block.String()
+	}
+	return blocks, nil
+}
+
+// forkBlock parses a block returned by forkBlocks, nil if the fork is not enabled.
+func forkBlock(block *string) *int64 {
+	if block == nil {
+		return nil
+	}
+	// The blocks are decoded from unsigned fork numbers.
+	number, _ := strconv.ParseInt(*block, 10, 64)
+	return &number
+}
+
+// flatParams returns the JSON encoded leaf values of the params by dot separated path, leaving out the forks
+// and the PolyBFT validator set. Arrays are leaf values.
+func flatParams(genesis *chain.Chain) (map[string]string, error) {
+	encoded, err := json.Marshal(genesis.Params)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var params map[string]interface{}
+	if err := decoder.Decode(&params); err != nil {
+		return nil, err
+	}
+	delete(params, "forks")
+
+	flat := map[string]string{}
+	var flatten func(prefix string, value interface{}) error
+	flatten = func(prefix string, value interface{}) error {
+		if prefix == validatorSetParam {
+			return nil
+		}
+		if object, ok := value.(map[string]interface{}); ok && len(object) > 0 {
+			for key, field := range object {
+				if err := flatten(strings.TrimPrefix(prefix+"."+key, "."), field); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		// Maps are encoded with sorted keys, so equal values encode the same.
+		leaf, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		flat[prefix] = string(leaf)
+		return nil
+	}
+	if err := flatten("", params); err != nil {
+		return nil, err
+	}
+	return flat, nil
+}
@@ -0,0 +1,152 @@
+package genesis
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// readDataSource reads the data source with the given attributes set in its configuration, the others null,
+// into the model, and returns its diagnostics.
+func readDataSource(t *testing.T, d datasource.DataSource, attrs map[string]tftypes.Value, model interface{}) diag.Diagnostics {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, value := range attrs {
+		values[name] = value
+	}
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, model)...)
+	}
+	return resp.Diagnostics
+}
+
+// The differences between testdata/genesis_old.json and testdata/genesis_new.json.
+var (
+	oldBalance1000, newBalance2000, oldBalance5, newBalance7 = "1000", "2000", "5", "7"
+	oldBlock0, newBlock5, newBlock100                        = int64(0), int64(5), int64(100)
+	oldChainID, newChainID                                   = "100", "200"
+	oldEpochSize, newEpochSize, newSprintSize                = "10", "20", "5"
+
+	fixtureDiff = genesisDiff{
+		ValidatorsAdded:   []string{"0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC"},
+		ValidatorsRemoved: []string{"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+		Premine: []premineChange{
+			{Address: "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC", NewBalance: &newBalance7},
+			{Address: "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", OldBalance: &oldBalance5},
+			{Address: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", OldBalance: &oldBalance1000, NewBalance: &newBalance2000},
+		},
+		Forks: []forkChange{
+			{Name: "istanbul", NewBlock: &newBlock5},
+			{Name: "london", OldBlock: &oldBlock0, NewBlock: &newBlock100},
+		},
+		Params: []paramChange{
+			{Path: "chainID", OldValue: &oldChainID, NewValue: &newChainID},
+			{Path: "engine.polybft.epochSize", OldValue: &oldEpochSize, NewValue: &newEpochSize},
+			{Path: "engine.polybft.sprintSize", NewValue: &newSprintSize},
+		},
+	}
+)
+
+func TestGenesisDiffDataSource(t *testing.T) {
+	oldContent, err := os.ReadFile("testdata/genesis_old.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The documents are given either as paths or as content.
+	tests := []struct {
+		name  string
+		attrs map[string]tftypes.Value
+	}{
+		{
+			name: "paths",
+			attrs: map[string]tftypes.Value{
+				"old_path": tftypes.NewValue(tftypes.String, "testdata/genesis_old.json"),
+				"new_path": tftypes.NewValue(tftypes.String, "testdata/genesis_new.json"),
+			},
+		},
+		{
+			name: "content",
+			attrs: map[string]tftypes.Value{
+				"old_content": tftypes.NewValue(tftypes.String, string(oldContent)),
+				"new_path":    tftypes.NewValue(tftypes.String, "testdata/genesis_new.json"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var model genesisDiffDataSourceModel
+			if diags := readDataSource(t, NewGenesisDiffDataSource(), tt.attrs, &model); diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+
+			if !model.Changed.ValueBool() {
+				t.Error("changed = false, want true")
+			}
+			var diff genesisDiff
+			if err := json.Unmarshal([]byte(model.JSON.ValueString()), &diff); err != nil {
+				t.Fatalf("unable to decode json: %v", err)
+			}
+			if !reflect.DeepEqual(diff, fixtureDiff) {
+				got, _ := json.MarshalIndent(diff, "", "  ")
+				want, _ := json.MarshalIndent(fixtureDiff, "", "  ")
+				t.Errorf("diff = %s, want %s", got, want)
+			}
+
+			// The attributes hold the same diff as the json.
+			if !reflect.DeepEqual(model.ValidatorsAdded, fixtureDiff.ValidatorsAdded) || !reflect.DeepEqual(model.ValidatorsRemoved, fixtureDiff.ValidatorsRemoved) {
+				t.Errorf("validators added, removed = %v, %v, want %v, %v",
+					model.ValidatorsAdded, model.ValidatorsRemoved, fixtureDiff.ValidatorsAdded, fixtureDiff.ValidatorsRemoved)
+			}
+			if len(model.Premine) != len(fixtureDiff.Premine) || len(model.Forks) != len(fixtureDiff.Forks) || len(model.Params) != len(fixtureDiff.Params) {
+				t.Fatalf("premine, forks, params = %d, %d, %d changes, want %d, %d, %d",
+					len(model.Premine), len(model.Forks), len(model.Params), len(fixtureDiff.Premine), len(fixtureDiff.Forks), len(fixtureDiff.Params))
+			}
+			if change := model.Premine[1]; change.Address != fixtureDiff.Premine[1].Address || change.OldBalance.ValueString() != "5" || !change.NewBalance.IsNull() {
+				t.Errorf("premine[1] = %+v, want the removed balance of 5", change)
+			}
+			if change := model.Forks[0]; change.Name != "istanbul" || !change.OldBlock.IsNull() || change.NewBlock.ValueInt64() != 5 {
+				t.Errorf("forks[0] = %+v, want istanbul enabled at 5", change)
+			}
+			if change := model.Params[0]; change.Path != "chainID" || change.OldValue.ValueString() != "100" || change.NewValue.ValueString() != "200" {
+				t.Errorf("params[0] = %+v, want chainID from 100 to 200", change)
+			}
+		})
+	}
+}
+
+func TestGenesisDiffDataSourceUnchanged(t *testing.T) {
+	var model genesisDiffDataSourceModel
+	diags := readDataSource(t, NewGenesisDiffDataSource(), map[string]tftypes.Value{
+		"old_path": tftypes.NewValue(tftypes.String, "testdata/genesis_new.json"),
+		"new_path": tftypes.NewValue(tftypes.String, "testdata/genesis_new.json"),
+	}, &model)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	if model.Changed.ValueBool() {
+		t.Errorf("changed = true comparing a genesis with itself: %s", model.JSON.ValueString())
+	}
+}
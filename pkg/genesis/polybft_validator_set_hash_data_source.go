@@ -0,0 +1,293 @@
+package genesis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/umbracle/ethgo/abi"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// polybftValidatorSetABIType is the ABI type PolyBFT encodes a validator set as before hashing it.
+var polybftValidatorSetABIType = abi.MustNewType(`tuple(tuple(address _address, uint256[4] blsKey, uint256 votingPower)[])`)
+
+// polybftGenesisValidator is a validator of the initial validator set in the PolyBFT engine params of a genesis.
+type polybftGenesisValidator struct {
+	Address edgetypes.Address
+	BLSKey  []byte
+	Stake   *big.Int
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &polybftValidatorSetHashDataSource{}
+	_ datasource.DataSourceWithConfigure      = &polybftValidatorSetHashDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &polybftValidatorSetHashDataSource{}
+)
+
+// polybftValidatorSetHashDataSourceModel maps the data source schema data.
+type polybftValidatorSetHashDataSourceModel struct {
+	Validators     types.List   `tfsdk:"validators"`
+	GenesisPath    types.String `tfsdk:"genesis_path"`
+	GenesisContent types.String `tfsdk:"genesis_content"`
+
+	Addresses  []string     `tfsdk:"addresses"`
+	TotalStake types.String `tfsdk:"total_stake"`
+	Hash       types.String `tfsdk:"hash"`
+}
+
+// polybftSetValidatorModel maps a validator of the set.
+type polybftSetValidatorModel struct {
+	Address   types.String `tfsdk:"address"`
+	BLSPubkey types.String `tfsdk:"bls_pubkey"`
+	Stake     types.String `tfsdk:"stake"`
+}
+
+// NewPolyBFTValidatorSetHashDataSource is a helper function to simplify the provider implementation.
+func NewPolyBFTValidatorSetHashDataSource() datasource.DataSource {
+	return &polybftValidatorSetHashDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// polybftValidatorSetHashDataSource is the data source implementation.
+type polybftValidatorSetHashDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *polybftValidatorSetHashDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_polybft_validator_set_hash"
+}
+
+// Schema defines the schema for the data source.
+func (d *polybftValidatorSetHashDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Computes the hash PolyBFT commits a validator set with in its checkpoints, the keccak256 hash of the " +
+			"ABI encoded addresses, BLS public keys and voting powers, the way polygon-edge hashes the genesis validators. " +
+			"The set is either given as `validators`, or read from the initial validator set of a PolyBFT genesis.",
+		Attributes: map[string]schema.Attribute{
+			"validators": schema.ListNestedAttribute{
+				Optional: true,
+				Description: "Validators of the set, in order. Exactly one of `validators`, `genesis_path` and " +
+					"`genesis_content` must be set.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Required:    true,
+							Description: "Validator address.",
+							Validators: []validator.String{
+								validators.Address(),
+							},
+						},
+						"bls_pubkey": schema.StringAttribute{
+							Required:    true,
+							Description: "Validator PolyBFT BLS public key, hex encoded.",
+							Validators: []validator.String{
+								validators.Hex(),
+							},
+						},
+						"stake": schema.StringAttribute{
+							Required:    true,
+							Description: "Stake of the validator in wei, its voting power at genesis.",
+							Validators: []validator.String{
+								validators.Wei(),
+							},
+						},
+					},
+				},
+			},
+			"genesis_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a PolyBFT genesis file to read the initial validator set from.",
+			},
+			"genesis_content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Content of a PolyBFT genesis file to read the initial validator set from.",
+			},
+			"addresses": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Addresses of the validators, in the order of the set.",
+			},
+			"total_stake": schema.StringAttribute{
+				Computed:    true,
+				Description: "Sum of the stakes of the validators in wei.",
+			},
+			"hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Keccak256 hash of the ABI encoded validator set, hex encoded.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *polybftValidatorSetHashDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// ValidateConfig ensures exactly one source of the validator set is set.
+func (d *polybftValidatorSetHashDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config polybftValidatorSetHashDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources := 0
+	for _, set := range []bool{!config.Validators.IsNull(), !config.GenesisPath.IsNull(), !config.GenesisContent.IsNull()} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validators"),
+			"Invalid validator set source",
+			"Exactly one of `validators`, `genesis_path` and `genesis_content` must be set.",
+		)
+	}
+}
+
+// Read builds or reads the validator set and computes its hash.
+func (d *polybftValidatorSetHashDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state polybftValidatorSetHashDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var set []polybftGenesisValidator
+	if !state.Validators.IsNull() {
+		var configured []polybftSetValidatorModel
+		resp.Diagnostics.Append(state.Validators.ElementsAs(ctx, &configured, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// The values are validated, so parsing cannot fail.
+		for _, v := range configured {
+			blsKey, _ := hex.DecodeHex(v.BLSPubkey.ValueString())
+			stake, _ := validators.ParseWei(v.Stake.ValueString())
+			set = append(set, polybftGenesisValidator{
+				Address: edgetypes.StringToAddress(v.Address.ValueString()),
+				BLSKey:  blsKey,
+				Stake:   stake,
+			})
+		}
+	} else {
+		genesis, diags := readGenesis(state.GenesisContent, state.GenesisPath, "genesis_content", "genesis_path")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var err error
+		if set, err = polybftInitialValidators(genesis); err != nil {
+			resp.Diagnostics.AddError("Unable to read the initial validator set", err.Error())
+			return
+		}
+	}
+
+	hash, totalStake, err := polybftValidatorSetHash(set)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid validator set", err.Error())
+		return
+	}
+
+	state.Addresses = make([]string, len(set))
+	for i, v := range set {
+		state.Addresses[i] = d.providerData.FormatAddress(v.Address)
+	}
+	state.TotalStake = types.StringValue(totalStake.String())
+	state.Hash = types.StringValue(hash.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// polybftInitialValidators decodes the initial validator set of the PolyBFT engine params of a genesis.
+func polybftInitialValidators(genesis *chain.Chain) ([]polybftGenesisValidator, error) {
+	config, ok := genesis.Params.Engine[enginePolyBFT]
+	if !ok {
+		return nil, fmt.Errorf("the genesis does not use the PolyBFT consensus engine, it has no initial validator set")
+	}
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	var params struct {
+		InitialValidatorSet []struct {
+			Address edgetypes.Address `json:"address"`
+			BLSKey  string            `json:"blsKey"`
+			Stake   *string           `json:"stake"`
+		} `json:"initialValidatorSet"`
+	}
+	if err := json.Unmarshal(encoded, &params); err != nil {
+		return nil, fmt.Errorf("unable to decode the PolyBFT engine params: %w", err)
+	}
+
+	set := make([]polybftGenesisValidator, len(params.InitialValidatorSet))
+	for i, v := range params.InitialValidatorSet {
+		// The genesis holds the BLS public key as hex without prefix, and the stake as a decimal or hex integer.
+		blsKey, err := hex.DecodeHex(v.BLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid BLS public key of validator %s: %w", v.Address, err)
+		}
+		stake, err := edgetypes.ParseUint256orHex(v.Stake)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stake of validator %s: %w", v.Address, err)
+		}
+		set[i] = polybftGenesisValidator{Address: v.Address, BLSKey: blsKey, Stake: stake}
+	}
+	return set, nil
+}
+
+// polybftValidatorSetHash hashes the validators the way polygon-edge hashes the genesis validator set, with their
+// stake as voting power, without pulling in the PolyBFT consensus engine. It returns the hash and the total stake.
+func polybftValidatorSetHash(set []polybftGenesisValidator) (edgetypes.Hash, *big.Int, error) {
+	bindings := make([]*contractsapi.Validator, len(set))
+	totalStake := new(big.Int)
+	for i, v := range set {
+		if v.Stake == nil {
+			return edgetypes.ZeroHash, nil, fmt.Errorf("validator %s has no stake", v.Address)
+		}
+		pubkey, err := bls.UnmarshalPublicKey(v.BLSKey)
+		if err != nil {
+			return edgetypes.ZeroHash, nil, fmt.Errorf("invalid BLS public key of validator %s: %w", v.Address, err)
+		}
+		bindings[i] = &contractsapi.Validator{Address: v.Address, BlsKey: pubkey.ToBigInt(), VotingPower: v.Stake}
+		totalStake.Add(totalStake, v.Stake)
+	}
+
+	encoded, err := polybftValidatorSetABIType.Encode([]interface{}{bindings})
+	if err != nil {
+		return edgetypes.ZeroHash, nil, fmt.Errorf("unable to encode the validator set: %w", err)
+	}
+	return edgetypes.BytesToHash(crypto.Keccak256(encoded)), totalStake, nil
+}
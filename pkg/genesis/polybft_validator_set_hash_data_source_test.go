@@ -0,0 +1,85 @@
+package genesis
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The BLS public keys of the PolyBFT validator set fixture, derived from fixed secret keys.
+const (
+	testPolyBFTPubkey1 = "0x198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c21800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa"
+	testPolyBFTPubkey2 = "0x203e205db4f19b37b60121b83a7333706db86431c6d835849957ed8c3928ad7927dc7234fd11d3e8c36c59277c3e6f149d5cd3cfa9a62aee49f8130962b4b3b9195e8aa5b7827463722b8c153931579d3505566b4edf48d498e185f0509de15204bb53b8977e5f92a0bc372742c4830944a59b4fe6b1c0466e2a6dad122b5d2e"
+
+	// testPolyBFTSetHash is the hash polygon-edge computes for the fixture set, with the Hash of its AccountSet.
+	testPolyBFTSetHash = "0xf7bec4cd3fb55e24d42b9037e40d0a4c41c2ca8e935442a2a041b7ecf377fc48"
+)
+
+func TestPolyBFTValidatorSetHashDataSource(t *testing.T) {
+	validatorType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"address":    tftypes.String,
+		"bls_pubkey": tftypes.String,
+		"stake":      tftypes.String,
+	}}
+	validator := func(address, pubkey, stake string) tftypes.Value {
+		return tftypes.NewValue(validatorType, map[string]tftypes.Value{
+			"address":    tftypes.NewValue(tftypes.String, address),
+			"bls_pubkey": tftypes.NewValue(tftypes.String, pubkey),
+			"stake":      tftypes.NewValue(tftypes.String, stake),
+		})
+	}
+	// The genesis holds the public keys without prefix, and the stakes as hex or decimal integers.
+	genesis := fmt.Sprintf(`{
+		"name": "test",
+		"genesis": {"gasLimit": "0x500000", "difficulty": "0x1"},
+		"params": {"engine": {"polybft": {"initialValidatorSet": [
+			{"address": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "blsKey": %q, "stake": "0xde0b6b3a7640000"},
+			{"address": "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", "blsKey": %q, "stake": "2000000000000000000"}
+		]}}}
+	}`, strings.TrimPrefix(testPolyBFTPubkey1, "0x"), strings.TrimPrefix(testPolyBFTPubkey2, "0x"))
+
+	for name, attrs := range map[string]map[string]tftypes.Value{
+		"validators": {
+			"validators": tftypes.NewValue(tftypes.List{ElementType: validatorType}, []tftypes.Value{
+				validator("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", testPolyBFTPubkey1, "1000000000000000000"),
+				validator("0x70997970C51812dc3A010C7d01b50e0d17dc79C8", testPolyBFTPubkey2, "2000000000000000000"),
+			}),
+		},
+		"genesis": {
+			"genesis_content": tftypes.NewValue(tftypes.String, genesis),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var model polybftValidatorSetHashDataSourceModel
+			if diags := readDataSource(t, NewPolyBFTValidatorSetHashDataSource(), attrs, &model); diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+			if model.Hash.ValueString() != testPolyBFTSetHash {
+				t.Errorf("hash = %s, want %s", model.Hash, testPolyBFTSetHash)
+			}
+			if model.TotalStake.ValueString() != "3000000000000000000" {
+				t.Errorf("total_stake = %s, want 3000000000000000000", model.TotalStake)
+			}
+			wantAddresses := []string{"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"}
+			if fmt.Sprint(model.Addresses) != fmt.Sprint(wantAddresses) {
+				t.Errorf("addresses = %v, want %v", model.Addresses, wantAddresses)
+			}
+		})
+	}
+}
+
+func TestPolyBFTValidatorSetHashDataSourceInvalidSet(t *testing.T) {
+	// The validators of the fixture have neither BLS public keys nor stakes.
+	var model polybftValidatorSetHashDataSourceModel
+	diags := readDataSource(t, NewPolyBFTValidatorSetHashDataSource(), map[string]tftypes.Value{
+		"genesis_path": tftypes.NewValue(tftypes.String, "testdata/genesis_old.json"),
+	}, &model)
+	if !diags.HasError() {
+		t.Fatalf("hashing a set without BLS public keys succeeded: %s", model.Hash)
+	}
+	if got := diags.Errors()[0].Summary(); got != "Invalid validator set" {
+		t.Errorf("got %q, want the invalid validator set error", got)
+	}
+}
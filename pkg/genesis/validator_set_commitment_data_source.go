@@ -0,0 +1,293 @@
+package genesis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	edgevalidators "github.com/0xPolygon/polygon-edge/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/umbracle/fastrlp"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &validatorSetCommitmentDataSource{}
+	_ datasource.DataSourceWithConfigure      = &validatorSetCommitmentDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &validatorSetCommitmentDataSource{}
+)
+
+// validatorSetCommitmentDataSourceModel maps the data source schema data.
+type validatorSetCommitmentDataSourceModel struct {
+	ValidatorType  types.String `tfsdk:"validator_type"`
+	Validators     types.List   `tfsdk:"validators"`
+	GenesisPath    types.String `tfsdk:"genesis_path"`
+	GenesisContent types.String `tfsdk:"genesis_content"`
+
+	Addresses  []string     `tfsdk:"addresses"`
+	ExtraData  types.String `tfsdk:"extra_data"`
+	Commitment types.String `tfsdk:"commitment"`
+}
+
+// commitmentValidatorModel maps a validator of the set.
+type commitmentValidatorModel struct {
+	Address   types.String `tfsdk:"address"`
+	BLSPubkey types.String `tfsdk:"bls_pubkey"`
+}
+
+// NewValidatorSetCommitmentDataSource is a helper function to simplify the provider implementation.
+func NewValidatorSetCommitmentDataSource() datasource.DataSource {
+	return &validatorSetCommitmentDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// validatorSetCommitmentDataSource is the data source implementation.
+type validatorSetCommitmentDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *validatorSetCommitmentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_validator_set_commitment"
+}
+
+// Schema defines the schema for the data source.
+func (d *validatorSetCommitmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Computes the IBFT extra data of a validator set and its commitment, the keccak256 hash of the " +
+			"RLP encoded validator set as it is embedded in the extra data. The set is either given as `validators`, " +
+			"or decoded from the extra data of an IBFT genesis, so a genesis can be checked against an expected commitment.",
+		Attributes: map[string]schema.Attribute{
+			"validator_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "IBFT validator type of `validators`, `ecdsa` or `bls`. Defaults to `bls`, like `polygon-edge genesis`. " +
+					"Read from the extra data when the set is decoded from a genesis.",
+			},
+			"validators": schema.ListNestedAttribute{
+				Optional: true,
+				Description: "Validators of the set, in order. Exactly one of `validators`, `genesis_path` and " +
+					"`genesis_content` must be set.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Required:    true,
+							Description: "Validator address.",
+							Validators: []validator.String{
+								validators.Address(),
+							},
+						},
+						"bls_pubkey": schema.StringAttribute{
+							Optional:    true,
+							Description: "Validator BLS public key, hex encoded. Required for `bls` validators, not allowed for `ecdsa` ones.",
+							Validators: []validator.String{
+								validators.Hex(),
+							},
+						},
+					},
+				},
+			},
+			"genesis_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of an IBFT genesis file to decode the validator set from.",
+			},
+			"genesis_content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Content of an IBFT genesis file to decode the validator set from.",
+			},
+			"addresses": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Addresses of the validators, in the order of the set.",
+			},
+			"extra_data": schema.StringAttribute{
+				Computed: true,
+				Description: "Hex encoded genesis IBFT extra data holding the validator set and empty seals, " +
+					"as `polygon-edge genesis` writes it.",
+			},
+			"commitment": schema.StringAttribute{
+				Computed:    true,
+				Description: "Keccak256 hash of the RLP encoded validator set, hex encoded.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *validatorSetCommitmentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// ValidateConfig ensures exactly one source of the validator set is set, and that the validators match their type.
+func (d *validatorSetCommitmentDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config validatorSetCommitmentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources := 0
+	for _, set := range []bool{!config.Validators.IsNull(), !config.GenesisPath.IsNull(), !config.GenesisContent.IsNull()} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validators"),
+			"Invalid validator set source",
+			"Exactly one of `validators`, `genesis_path` and `genesis_content` must be set.",
+		)
+		return
+	}
+
+	if config.ValidatorType.IsUnknown() {
+		return
+	}
+	validatorType, err := commitmentValidatorType(config.ValidatorType)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("validator_type"), "Invalid validator type", err.Error()+".")
+		return
+	}
+	if config.Validators.IsNull() {
+		if !config.ValidatorType.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("validator_type"),
+				"Conflicting validator type",
+				"The validator type is read from the genesis extra data, `validator_type` only applies to `validators`.",
+			)
+		}
+		return
+	}
+	if config.Validators.IsUnknown() {
+		return
+	}
+	var configured []commitmentValidatorModel
+	resp.Diagnostics.Append(config.Validators.ElementsAs(ctx, &configured, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, v := range configured {
+		if v.BLSPubkey.IsUnknown() {
+			continue
+		}
+		switch {
+		case validatorType == edgevalidators.BLSValidatorType && v.BLSPubkey.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("validators").AtListIndex(i).AtName("bls_pubkey"),
+				"Missing BLS public key",
+				"Validators of type `bls` must set `bls_pubkey`.",
+			)
+		case validatorType == edgevalidators.ECDSAValidatorType && !v.BLSPubkey.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("validators").AtListIndex(i).AtName("bls_pubkey"),
+				"Unexpected BLS public key",
+				"Validators of type `ecdsa` have no BLS public key.",
+			)
+		}
+	}
+}
+
+// Read builds or decodes the validator set and computes its commitment.
+func (d *validatorSetCommitmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state validatorSetCommitmentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var set edgevalidators.Validators
+	if !state.Validators.IsNull() {
+		var configured []commitmentValidatorModel
+		resp.Diagnostics.Append(state.Validators.ElementsAs(ctx, &configured, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// The type is validated, so parsing cannot fail.
+		validatorType, _ := commitmentValidatorType(state.ValidatorType)
+		var err error
+		if set, err = commitmentValidatorSet(validatorType, configured); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("validators"), "Invalid validator set", err.Error())
+			return
+		}
+	} else {
+		genesis, diags := readGenesis(state.GenesisContent, state.GenesisPath, "genesis_content", "genesis_path")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if _, ok := genesis.Params.Engine[engineIBFT]; !ok {
+			resp.Diagnostics.AddError("Unsupported genesis", "The genesis does not use the IBFT consensus engine, its extra data holds no IBFT validator set.")
+			return
+		}
+		var err error
+		if set, err = ibftExtraValidators(genesis.Genesis.ExtraData); err != nil {
+			resp.Diagnostics.AddError("Unable to decode genesis validators", err.Error())
+			return
+		}
+	}
+
+	var arena fastrlp.Arena
+	encodedSet := set.MarshalRLPWith(&arena).MarshalTo(nil)
+
+	state.ValidatorType = types.StringValue(string(set.Type()))
+	state.Addresses = make([]string, set.Len())
+	for i := range state.Addresses {
+		state.Addresses[i] = d.providerData.FormatAddress(set.At(uint64(i)).Addr())
+	}
+	state.ExtraData = types.StringValue(hex.EncodeToHex(ibftGenesisExtraData(set)))
+	state.Commitment = types.StringValue(hex.EncodeToHex(crypto.Keccak256(encodedSet)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// commitmentValidatorType returns the configured validator type, or `bls` if it is not set.
+func commitmentValidatorType(value types.String) (edgevalidators.ValidatorType, error) {
+	if value.IsNull() {
+		return edgevalidators.BLSValidatorType, nil
+	}
+	validatorType, err := edgevalidators.ParseValidatorType(value.ValueString())
+	if err != nil {
+		return "", fmt.Errorf("the validator type must be %q or %q, got: %q", edgevalidators.ECDSAValidatorType, edgevalidators.BLSValidatorType, value.ValueString())
+	}
+	return validatorType, nil
+}
+
+// commitmentValidatorSet builds a validator set of the given type. The addresses and public keys are validated.
+func commitmentValidatorSet(validatorType edgevalidators.ValidatorType, configured []commitmentValidatorModel) (edgevalidators.Validators, error) {
+	set := edgevalidators.NewValidatorSetFromType(validatorType)
+	for _, v := range configured {
+		address := edgetypes.StringToAddress(v.Address.ValueString())
+		var validator edgevalidators.Validator = edgevalidators.NewECDSAValidator(address)
+		if validatorType == edgevalidators.BLSValidatorType {
+			pubkey, _ := hex.DecodeHex(v.BLSPubkey.ValueString())
+			validator = edgevalidators.NewBLSValidator(address, pubkey)
+		}
+		if err := set.Add(validator); err != nil {
+			return nil, fmt.Errorf("unable to add validator %s: %w", address, err)
+		}
+	}
+	return set, nil
+}
@@ -0,0 +1,116 @@
+package genesis
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	commitmentValidatorObjectType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"address":    tftypes.String,
+		"bls_pubkey": tftypes.String,
+	}}
+
+	testBLSPubkey1 = "0x" + strings.Repeat("aa", 48)
+	testBLSPubkey2 = "0x" + strings.Repeat("bb", 48)
+)
+
+// commitmentValidators returns the list value of validators, given as address and BLS public key pairs.
+// An empty public key is left null.
+func commitmentValidators(pairs ...[2]string) tftypes.Value {
+	values := make([]tftypes.Value, len(pairs))
+	for i, pair := range pairs {
+		var pubkey interface{}
+		if pair[1] != "" {
+			pubkey = pair[1]
+		}
+		values[i] = tftypes.NewValue(commitmentValidatorObjectType, map[string]tftypes.Value{
+			"address":    tftypes.NewValue(tftypes.String, pair[0]),
+			"bls_pubkey": tftypes.NewValue(tftypes.String, pubkey),
+		})
+	}
+	return tftypes.NewValue(tftypes.List{ElementType: commitmentValidatorObjectType}, values)
+}
+
+func TestValidatorSetCommitmentDataSource(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorType interface{}
+		validators    tftypes.Value
+		// The commitments are the keccak256 hashes of the RLP encodings written out by hand:
+		// 0xea94<address 1>94<address 2> for ECDSA, and
+		// 0xf890f84694<address 1>b0<pubkey 1>f84694<address 2>b0<pubkey 2> for BLS.
+		want string
+	}{
+		{
+			name:          "ecdsa",
+			validatorType: "ecdsa",
+			validators: commitmentValidators(
+				[2]string{"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+				[2]string{"0x70997970C51812dc3A010C7d01b50e0d17dc79C8"},
+			),
+			want: "0x6b429ff1e24776390d458c8a17c916f2769cefb9dcc2709482f56ec69ebd54a6",
+		},
+		{
+			name: "bls by default",
+			validators: commitmentValidators(
+				[2]string{"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", testBLSPubkey1},
+				[2]string{"0x70997970C51812dc3A010C7d01b50e0d17dc79C8", testBLSPubkey2},
+			),
+			want: "0x1c7972ffa5b51ac549d900f0176ba7dde060499ba79736c2a5e404564bd43fdd",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var model validatorSetCommitmentDataSourceModel
+			diags := readDataSource(t, NewValidatorSetCommitmentDataSource(), map[string]tftypes.Value{
+				"validator_type": tftypes.NewValue(tftypes.String, tt.validatorType),
+				"validators":     tt.validators,
+			}, &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+			if model.Commitment.ValueString() != tt.want {
+				t.Errorf("commitment = %s, want %s", model.Commitment, tt.want)
+			}
+			wantAddresses := []string{"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"}
+			if fmt.Sprint(model.Addresses) != fmt.Sprint(wantAddresses) {
+				t.Errorf("addresses = %v, want %v", model.Addresses, wantAddresses)
+			}
+
+			// The extra data decodes back to the same set, as the genesis of a chain.
+			genesis := fmt.Sprintf(`{
+				"name": "test",
+				"genesis": {"extraData": %q, "gasLimit": "0x500000", "difficulty": "0x1"},
+				"params": {"engine": {"ibft": {}}}
+			}`, model.ExtraData.ValueString())
+			var decoded validatorSetCommitmentDataSourceModel
+			diags = readDataSource(t, NewValidatorSetCommitmentDataSource(), map[string]tftypes.Value{
+				"genesis_content": tftypes.NewValue(tftypes.String, genesis),
+			}, &decoded)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics of the genesis: %v", diags)
+			}
+			if decoded.Commitment != model.Commitment || decoded.ValidatorType != model.ValidatorType {
+				t.Errorf("genesis commitment = %s of %s validators, want %s of %s validators",
+					decoded.Commitment, decoded.ValidatorType, model.Commitment, model.ValidatorType)
+			}
+		})
+	}
+}
+
+func TestValidatorSetCommitmentDataSourceNotIBFT(t *testing.T) {
+	var model validatorSetCommitmentDataSourceModel
+	diags := readDataSource(t, NewValidatorSetCommitmentDataSource(), map[string]tftypes.Value{
+		"genesis_path": tftypes.NewValue(tftypes.String, "testdata/genesis_old.json"),
+	}, &model)
+	if !diags.HasError() {
+		t.Fatalf("decoding the validators of a PolyBFT genesis succeeded: %s", model.Commitment)
+	}
+	if got := diags.Errors()[0].Summary(); got != "Unsupported genesis" {
+		t.Errorf("got %q, want the unsupported genesis error", got)
+	}
+}
@@ -0,0 +1,279 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &delegateResource{}
+	_ resource.ResourceWithConfigure = &delegateResource{}
+)
+
+// delegateResourceModel maps the resource schema data.
+type delegateResourceModel struct {
+	RPCURL              types.String `tfsdk:"rpc_url"`
+	DelegatorKeyEncoded types.String `tfsdk:"delegator_key_encoded"`
+	Validator           types.String `tfsdk:"validator"`
+	Amount              types.String `tfsdk:"amount"`
+	Restake             types.Bool   `tfsdk:"restake"`
+	GasPrice            types.String `tfsdk:"gas_price"`
+
+	ChainID          types.Int64 `tfsdk:"chain_id"`
+	SkipChainIDCheck types.Bool  `tfsdk:"skip_chain_id_check"`
+
+	Confirmation *rpc.ConfirmationModel `tfsdk:"confirmation"`
+
+	Address    types.String `tfsdk:"address"`
+	TxHash     types.String `tfsdk:"tx_hash"`
+	Delegation types.String `tfsdk:"delegation"`
+}
+
+// NewDelegateResource is a helper function to simplify the provider implementation.
+func NewDelegateResource() resource.Resource {
+	return &delegateResource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// delegateResource is the resource implementation.
+type delegateResource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the resource type name.
+func (r *delegateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delegate"
+}
+
+// Schema defines the schema for the resource.
+func (r *delegateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Delegates stake to a registered PolyBFT validator on the child validator set contract. " +
+			"Every replacement of this resource delegates again, destroying it does not undelegate, use `polygonedge_undelegate` for that.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node the transaction is sent to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delegator_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Encoded validator key of the account delegating the stake.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+			},
+			"validator": schema.StringAttribute{
+				Required:    true,
+				Description: "Address of the validator the stake is delegated to. It must be registered.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"amount": schema.StringAttribute{
+				Required:    true,
+				Description: "Amount in wei to delegate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.Wei(),
+				},
+			},
+			"restake": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Restake the pending delegator reward of the validator along with the delegation. Defaults to false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"gas_price": schema.StringAttribute{
+				Optional:    true,
+				Description: "Gas price in wei. Defaults to the gas price suggested by the endpoint.",
+				Validators: []validator.String{
+					validators.Wei(),
+				},
+			},
+			"chain_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "Expected chain id of the endpoint. The delegation is not sent if the endpoint serves another chain. " +
+					"Defaults to the provider `chain_id`.",
+			},
+			"skip_chain_id_check": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip comparing the endpoint chain id with `chain_id`.",
+			},
+			"confirmation": rpc.ConfirmationSchema(),
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Delegator address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tx_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the delegation transaction.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delegation": schema.StringAttribute{
+				Computed:    true,
+				Description: "Total amount in wei the delegator has delegated to the validator once the delegation is confirmed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the resource.
+func (r *delegateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = data
+}
+
+func (r *delegateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan delegateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	confirmation, diags := plan.Confirmation.Config(path.Root("confirmation"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	delegatorKey, err := crypto.BytesToECDSAPrivateKey([]byte(plan.DelegatorKeyEncoded.ValueString()))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("delegator_key_encoded"), diagnostics.ValidatorKey, err))
+		return
+	}
+	delegator := crypto.PubKeyToAddress(&delegatorKey.PublicKey)
+	validatorAddress := edgetypes.StringToAddress(plan.Validator.ValueString())
+	// Both values are validated, so parsing cannot fail.
+	amount, _ := new(big.Int).SetString(plan.Amount.ValueString(), 10)
+	gasPrice, _ := new(big.Int).SetString(plan.GasPrice.ValueString(), 10)
+
+	client := rpc.NewClient(plan.RPCURL.ValueString(), r.providerData.RPC)
+	var expectedChainID uint64
+	if !plan.SkipChainIDCheck.ValueBool() {
+		expectedChainID = r.providerData.ChainIDOrDefault(plan.ChainID)
+	}
+	chainID, err := client.GuardedChainID(ctx, expectedChainID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to verify chain id", err.Error())
+		return
+	}
+
+	registered, err := isRegisteredValidator(ctx, client, validatorAddress)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to look up validator", err.Error())
+		return
+	}
+	if !registered {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validator"),
+			"Validator not registered",
+			fmt.Sprintf("%s is not a registered validator of the child validator set contract.", validatorAddress),
+		)
+		return
+	}
+
+	input, err := contractsapi.ChildValidatorSet.Abi.Methods["delegate"].Encode([]interface{}{validatorAddress, plan.Restake.ValueBool()})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to encode delegate call", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Delegating stake", map[string]interface{}{"delegator": delegator.String(), "validator": validatorAddress.String(), "amount": amount.String()})
+	sender := newTransactor(client, delegatorKey, chainID, confirmation)
+	hash, err := sender.Send(ctx, contractCall{To: contracts.ValidatorSetContract, Input: input, Value: amount, GasPrice: gasPrice})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delegate stake", err.Error())
+		return
+	}
+
+	// Record the delegation before waiting for it, so that it is kept in state even if it is not confirmed.
+	plan.Address = types.StringValue(r.providerData.FormatAddress(delegator))
+	plan.TxHash = types.StringValue(hash.String())
+	plan.Delegation = types.StringNull()
+
+	if _, err := sender.Wait(ctx, hash); err != nil {
+		resp.Diagnostics.AddError("Unable to confirm delegation", err.Error())
+	} else if delegation, err := delegationOf(ctx, client, validatorAddress, delegator, ""); err != nil {
+		resp.Diagnostics.AddError("Unable to read delegation", err.Error())
+	} else {
+		plan.Delegation = types.StringValue(delegation.String())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *delegateResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	// NO-OP: a sent delegation cannot change, the state already holds everything there is to read.
+	tflog.Debug(ctx, "Reading delegation from state")
+}
+
+func (r *delegateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only settings which do not affect the delegation can be updated in place.
+	var plan delegateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *delegateResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing delegation from state")
+}
@@ -0,0 +1,163 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+)
+
+// testValidator is the address of a validator the delegations go to, the second Hardhat development account.
+const testValidator = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+
+// getValidatorOutputs returns the getValidator outputs of a validator, registered with a BLS key or not.
+func getValidatorOutputs(registered bool, commission int64) map[string]interface{} {
+	blsKey := [4]*big.Int{new(big.Int), new(big.Int), new(big.Int), new(big.Int)}
+	if registered {
+		blsKey = [4]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)}
+	}
+	return map[string]interface{}{
+		"blsKey":              blsKey,
+		"stake":               new(big.Int),
+		"totalStake":          new(big.Int),
+		"commission":          big.NewInt(commission),
+		"withdrawableRewards": new(big.Int),
+		"active":              registered,
+	}
+}
+
+// delegationNode mocks the getters of a validator with the given delegation of the test delegator.
+func delegationNode(t *testing.T, registered bool, delegation int64) (*mockNode, string) {
+	node, url := newMockNode(t)
+	node.call = func(method string, args map[string]interface{}) map[string]interface{} {
+		switch method {
+		case "getValidator":
+			if args["validator"] != ethgo.HexToAddress(testValidator) {
+				t.Errorf("getValidator(%s), want %s", args["validator"], testValidator)
+			}
+			return getValidatorOutputs(registered, 0)
+		case "delegationOf":
+			if args["validator"] != ethgo.HexToAddress(testValidator) || args["delegator"] != ethgo.HexToAddress(testValidatorAddress) {
+				t.Errorf("delegationOf(%s, %s), want %s, %s", args["validator"], args["delegator"], testValidator, testValidatorAddress)
+			}
+			return map[string]interface{}{"0": big.NewInt(delegation)}
+		}
+		return nil
+	}
+	return node, url
+}
+
+func TestDelegateResource(t *testing.T) {
+	for _, restake := range []bool{false, true} {
+		t.Run(fmt.Sprintf("restake %t", restake), func(t *testing.T) {
+			node, url := delegationNode(t, true, 3000)
+
+			state, diags := createResource(t, NewDelegateResource(), map[string]tftypes.Value{
+				"rpc_url":               tftypes.NewValue(tftypes.String, url),
+				"delegator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+				"validator":             tftypes.NewValue(tftypes.String, testValidator),
+				"amount":                tftypes.NewValue(tftypes.String, "1000"),
+				"restake":               tftypes.NewValue(tftypes.Bool, restake),
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected create diagnostics: %v", diags)
+			}
+			var model delegateResourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("unable to get state: %v", diags)
+			}
+
+			if calls := node.sentCalls(t); fmt.Sprint(calls) != "[delegate]" {
+				t.Fatalf("sent calls %v, want [delegate]", calls)
+			}
+			tx := node.sent[0]
+			if tx.Value.Int64() != 1000 {
+				t.Errorf("sent value %s, want the delegated 1000", tx.Value)
+			}
+			args, err := abi.Decode(contractsapi.ChildValidatorSet.Abi.Methods["delegate"].Inputs, tx.Input[4:])
+			if err != nil {
+				t.Fatalf("unable to decode delegate call: %v", err)
+			}
+			decoded := args.(map[string]interface{})
+			if decoded["validator"] != ethgo.HexToAddress(testValidator) || decoded["restake"] != restake {
+				t.Errorf("delegate(%s, %v), want %s, %t", decoded["validator"], decoded["restake"], testValidator, restake)
+			}
+
+			if model.Address.ValueString() != testValidatorAddress {
+				t.Errorf("address = %s, want %s", model.Address, testValidatorAddress)
+			}
+			if model.TxHash.ValueString() != tx.Hash.String() {
+				t.Errorf("tx_hash = %s, want %s", model.TxHash, tx.Hash)
+			}
+			if model.Delegation.ValueString() != "3000" {
+				t.Errorf("delegation = %s, want the 3000 read back", model.Delegation)
+			}
+		})
+	}
+}
+
+func TestUndelegateResource(t *testing.T) {
+	node, url := delegationNode(t, true, 2000)
+
+	state, diags := createResource(t, NewUndelegateResource(), map[string]tftypes.Value{
+		"rpc_url":               tftypes.NewValue(tftypes.String, url),
+		"delegator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+		"validator":             tftypes.NewValue(tftypes.String, testValidator),
+		"amount":                tftypes.NewValue(tftypes.String, "1000"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+	var model undelegateResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	if calls := node.sentCalls(t); fmt.Sprint(calls) != "[undelegate]" {
+		t.Fatalf("sent calls %v, want [undelegate]", calls)
+	}
+	tx := node.sent[0]
+	if tx.Value != nil && tx.Value.Sign() != 0 {
+		t.Errorf("sent value %s, want none", tx.Value)
+	}
+	args, err := abi.Decode(contractsapi.ChildValidatorSet.Abi.Methods["undelegate"].Inputs, tx.Input[4:])
+	if err != nil {
+		t.Fatalf("unable to decode undelegate call: %v", err)
+	}
+	decoded := args.(map[string]interface{})
+	if decoded["validator"] != ethgo.HexToAddress(testValidator) || decoded["amount"].(*big.Int).Int64() != 1000 {
+		t.Errorf("undelegate(%s, %s), want %s, 1000", decoded["validator"], decoded["amount"], testValidator)
+	}
+	if model.TxHash.ValueString() != tx.Hash.String() {
+		t.Errorf("tx_hash = %s, want %s", model.TxHash, tx.Hash)
+	}
+	if model.Delegation.ValueString() != "2000" {
+		t.Errorf("delegation = %s, want the 2000 read back", model.Delegation)
+	}
+}
+
+func TestDelegateResourceUnregisteredValidator(t *testing.T) {
+	node, url := delegationNode(t, false, 0)
+
+	_, diags := createResource(t, NewDelegateResource(), map[string]tftypes.Value{
+		"rpc_url":               tftypes.NewValue(tftypes.String, url),
+		"delegator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+		"validator":             tftypes.NewValue(tftypes.String, testValidator),
+		"amount":                tftypes.NewValue(tftypes.String, "1000"),
+		"restake":               tftypes.NewValue(tftypes.Bool, false),
+	})
+	if !diags.HasError() {
+		t.Fatal("delegating to an unregistered validator succeeded")
+	}
+	if got := diags.Errors()[0].Summary(); got != "Validator not registered" {
+		t.Errorf("got %q, want the unregistered validator error", got)
+	}
+	if len(node.sent) != 0 {
+		t.Errorf("sent %d transactions to an unregistered validator", len(node.sent))
+	}
+}
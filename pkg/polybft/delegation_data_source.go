@@ -0,0 +1,136 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/units"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &delegationDataSource{}
+	_ datasource.DataSourceWithConfigure = &delegationDataSource{}
+)
+
+// delegationDataSourceModel maps the data source schema data.
+type delegationDataSourceModel struct {
+	RPCURL    types.String `tfsdk:"rpc_url"`
+	Validator types.String `tfsdk:"validator"`
+	Delegator types.String `tfsdk:"delegator"`
+	Block     types.String `tfsdk:"block"`
+
+	Amount      types.String `tfsdk:"amount"`
+	AmountEther types.String `tfsdk:"amount_ether"`
+}
+
+// NewDelegationDataSource is a helper function to simplify the provider implementation.
+func NewDelegationDataSource() datasource.DataSource {
+	return &delegationDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// delegationDataSource is the data source implementation.
+type delegationDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *delegationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delegation"
+}
+
+// Schema defines the schema for the data source.
+func (d *delegationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads how much a delegator has delegated to a PolyBFT validator. " +
+			"The `delegationOf` getter of the child validator set contract is called with `eth_call`.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"validator": schema.StringAttribute{
+				Required:    true,
+				Description: "Address of the validator the stake is delegated to.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"delegator": schema.StringAttribute{
+				Required:    true,
+				Description: "Address of the delegator.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"block": schema.StringAttribute{
+				Optional:    true,
+				Description: "Block the delegation is read at, `latest`, `pending`, `earliest` or a block number. Defaults to `latest`.",
+				Validators: []validator.String{
+					validators.BlockTag(),
+				},
+			},
+			"amount": schema.StringAttribute{
+				Computed:    true,
+				Description: "Delegated amount in wei.",
+			},
+			"amount_ether": schema.StringAttribute{
+				Computed:    true,
+				Description: "Delegated amount in ether, as an exact decimal string.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *delegationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read calls the child validator set contract and decodes the delegated amount.
+func (d *delegationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state delegationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The addresses are validated, so parsing cannot fail.
+	validatorAddress := edgetypes.StringToAddress(state.Validator.ValueString())
+	delegator := edgetypes.StringToAddress(state.Delegator.ValueString())
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	amount, err := delegationOf(ctx, client, validatorAddress, delegator, state.Block.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get delegation", err.Error())
+		return
+	}
+
+	state.Amount = types.StringValue(amount.String())
+	state.AmountEther = types.StringValue(units.FormatEther(amount))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
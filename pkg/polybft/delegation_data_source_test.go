@@ -0,0 +1,55 @@
+package polybft
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDelegationDataSource(t *testing.T) {
+	// 1.5 ether in wei.
+	delegation, _ := new(big.Int).SetString("1500000000000000000", 10)
+	node, url := newMockNode(t)
+	node.call = func(method string, args map[string]interface{}) map[string]interface{} {
+		if method != "delegationOf" {
+			return nil
+		}
+		return map[string]interface{}{"0": delegation}
+	}
+
+	state, diags := readDataSource(t, NewDelegationDataSource(), map[string]tftypes.Value{
+		"rpc_url":   tftypes.NewValue(tftypes.String, url),
+		"validator": tftypes.NewValue(tftypes.String, testValidator),
+		"delegator": tftypes.NewValue(tftypes.String, testValidatorAddress),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	var model delegationDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if model.Amount.ValueString() != "1500000000000000000" || model.AmountEther.ValueString() != "1.5" {
+		t.Errorf("amount = %s, %s ether, want 1500000000000000000, 1.5", model.Amount, model.AmountEther)
+	}
+}
+
+func TestDelegationDataSourceNotPolyBFT(t *testing.T) {
+	// Without the child validator set contract, the call returns no data.
+	_, url := newMockNode(t)
+
+	_, diags := readDataSource(t, NewDelegationDataSource(), map[string]tftypes.Value{
+		"rpc_url":   tftypes.NewValue(tftypes.String, url),
+		"validator": tftypes.NewValue(tftypes.String, testValidator),
+		"delegator": tftypes.NewValue(tftypes.String, testValidatorAddress),
+	})
+	if !diags.HasError() {
+		t.Fatal("reading a delegation without the child validator set contract succeeded")
+	}
+	if got := diags.Errors()[0].Detail(); !strings.Contains(got, "check that the chain runs PolyBFT") {
+		t.Errorf("got %q, want the missing contract hint", got)
+	}
+}
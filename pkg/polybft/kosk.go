@@ -0,0 +1,45 @@
+package polybft
+
+import (
+	"fmt"
+	"math/big"
+
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo/abi"
+)
+
+// koskMessage returns the message signed into the BLS proof of possession of a validator.
+func koskMessage(address edgetypes.Address, chainID int64) ([]byte, error) {
+	message, err := abi.Encode(
+		[]interface{}{address, big.NewInt(chainID)},
+		abi.MustNewType("tuple(address, uint256)"))
+	if err != nil {
+		return nil, err
+	}
+
+	// abi.Encode adds 12 zero bytes before actual address bytes
+	return message[12:], nil
+}
+
+// makeKOSKSignature signs the validator address and chain ID with the BLS key, the same way
+// polygon-edge secrets helper does, without pulling in the remote secrets managers.
+func makeKOSKSignature(key *bls.PrivateKey, address edgetypes.Address, chainID int64) (*bls.Signature, error) {
+	message, err := koskMessage(address, chainID)
+	if err != nil {
+		return nil, err
+	}
+	return key.Sign(message, bls.DomainValidatorSet)
+}
+
+// verifyKOSKSignature checks the proof of possession signs the validator address and chain ID with the BLS public key.
+func verifyKOSKSignature(signature *bls.Signature, pubkey *bls.PublicKey, address edgetypes.Address, chainID int64) error {
+	message, err := koskMessage(address, chainID)
+	if err != nil {
+		return err
+	}
+	if !signature.Verify(pubkey, message, bls.DomainValidatorSet) {
+		return fmt.Errorf("the proof of possession of %s does not verify against the BLS public key for chain id %d", address, chainID)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package polybft
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+)
+
+// Registration holds what a validator submits to register its BLS key on the child validator set contract.
+type Registration struct {
+	// BLSPubkey is the marshalled BLS public key.
+	BLSPubkey []byte
+	// Signature is the marshalled BLS proof of possession.
+	Signature []byte
+	// RegisterCalldata is the ABI encoded `register` call, including the method selector.
+	RegisterCalldata []byte
+}
+
+// NewRegistration signs the proof of possession of the BLS key for the validator address and chain ID, and encodes
+// the `register` call. The calldata is decoded again to make sure it carries the public key and proof.
+func NewRegistration(key *bls.PrivateKey, address edgetypes.Address, chainID int64) (*Registration, error) {
+	signature, err := makeKOSKSignature(key, address, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign proof of possession: %w", err)
+	}
+	signatureBytes, err := signature.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode proof of possession: %w", err)
+	}
+	signatureInts, err := signature.ToBigInt()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode proof of possession: %w", err)
+	}
+
+	registerFn := &contractsapi.RegisterChildValidatorSetFn{
+		Signature: signatureInts,
+		Pubkey:    key.PublicKey().ToBigInt(),
+	}
+	calldata, err := registerFn.EncodeAbi()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode register call: %w", err)
+	}
+	if err := verifyRegisterCalldata(calldata, registerFn); err != nil {
+		return nil, fmt.Errorf("the encoded register call does not decode to its arguments: %w", err)
+	}
+
+	return &Registration{
+		BLSPubkey:        key.PublicKey().Marshal(),
+		Signature:        signatureBytes,
+		RegisterCalldata: calldata,
+	}, nil
+}
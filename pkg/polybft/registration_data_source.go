@@ -0,0 +1,206 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &registrationDataSource{}
+	_ datasource.DataSourceWithConfigure = &registrationDataSource{}
+)
+
+// registrationDataSourceModel maps the data source schema data.
+type registrationDataSourceModel struct {
+	ValidatorKeyEncoded types.String `tfsdk:"validator_key_encoded"`
+	BLSKeyEncoded       types.String `tfsdk:"bls_key_encoded"`
+	ChainID             types.Int64  `tfsdk:"chain_id"`
+
+	Address             types.String `tfsdk:"address"`
+	BLSPubkey           types.String `tfsdk:"bls_pubkey"`
+	Signature           types.String `tfsdk:"signature"`
+	ValidatorSetAddress types.String `tfsdk:"validator_set_address"`
+	RegisterCalldata    types.String `tfsdk:"register_calldata"`
+	StakeCalldata       types.String `tfsdk:"stake_calldata"`
+}
+
+// NewRegistrationDataSource is a helper function to simplify the provider implementation.
+func NewRegistrationDataSource() datasource.DataSource {
+	return &registrationDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// registrationDataSource is the data source implementation.
+type registrationDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *registrationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_polybft_registration"
+}
+
+// Schema defines the schema for the data source.
+func (d *registrationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Computes the ABI encoded calldata to register a PolyBFT validator on the child validator set contract. " +
+			"The calldata can be submitted with any transaction tooling, signed by the validator account.",
+		Attributes: map[string]schema.Attribute{
+			"validator_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Validator ECDSA private key, as produced by `polygon-edge polybft-secrets`.",
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+			},
+			"bls_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Validator PolyBFT BLS private key, as produced by `polygon-edge polybft-secrets`.",
+				Validators: []validator.String{
+					validators.PolyBFTBLSKey(),
+				},
+			},
+			"chain_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Chain ID of the child chain, signed into the BLS proof of possession. Defaults to the provider `chain_id`.",
+			},
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator address, the registration transaction must be sent from it.",
+			},
+			"bls_pubkey": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator PolyBFT BLS public key, hex encoded.",
+			},
+			"signature": schema.StringAttribute{
+				Computed:    true,
+				Description: "BLS proof of possession of the validator key, hex encoded.",
+			},
+			"validator_set_address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Address of the child validator set contract the calldata must be sent to.",
+			},
+			"register_calldata": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hex encoded calldata of the `register` call.",
+			},
+			"stake_calldata": schema.StringAttribute{
+				Computed: true,
+				Description: "Hex encoded calldata of the `stake` call. " +
+					"The stake amount is the value of the transaction, not an argument of the call.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *registrationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read computes the registration calldata.
+func (d *registrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state registrationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	chainID := d.providerData.ChainIDOrDefault(state.ChainID)
+	if chainID == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("chain_id"),
+			"Missing chain id",
+			"The chain id must be set either on the data source or on the provider.",
+		)
+		return
+	}
+
+	validatorKey, err := crypto.BytesToECDSAPrivateKey([]byte(state.ValidatorKeyEncoded.ValueString()))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("validator_key_encoded"), diagnostics.ValidatorKey, err))
+		return
+	}
+	address := crypto.PubKeyToAddress(&validatorKey.PublicKey)
+
+	blsKey, err := bls.UnmarshalPrivateKey([]byte(state.BLSKeyEncoded.ValueString()))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("bls_key_encoded"), diagnostics.PolyBFTBLSKey, err))
+		return
+	}
+
+	registration, err := NewRegistration(blsKey, address, int64(chainID))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to compute registration", err.Error())
+		return
+	}
+
+	stakeCalldata, err := contractsapi.ChildValidatorSet.Abi.Methods["stake"].Encode([]interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to encode stake call", err.Error())
+		return
+	}
+
+	state.ChainID = types.Int64Value(int64(chainID))
+	state.Address = types.StringValue(d.providerData.FormatAddress(address))
+	state.BLSPubkey = types.StringValue(hex.EncodeToHex(registration.BLSPubkey))
+	state.Signature = types.StringValue(hex.EncodeToHex(registration.Signature))
+	state.ValidatorSetAddress = types.StringValue(d.providerData.FormatAddress(contracts.ValidatorSetContract))
+	state.RegisterCalldata = types.StringValue(hex.EncodeToHex(registration.RegisterCalldata))
+	state.StakeCalldata = types.StringValue(hex.EncodeToHex(stakeCalldata))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// verifyRegisterCalldata decodes the calldata and checks it matches the encoded arguments.
+func verifyRegisterCalldata(calldata []byte, expected *contractsapi.RegisterChildValidatorSetFn) error {
+	var decoded contractsapi.RegisterChildValidatorSetFn
+	if err := decoded.DecodeAbi(calldata); err != nil {
+		return err
+	}
+
+	for i := range expected.Signature {
+		if decoded.Signature[i].Cmp(expected.Signature[i]) != 0 {
+			return fmt.Errorf("signature mismatch at index %d", i)
+		}
+	}
+	for i := range expected.Pubkey {
+		if decoded.Pubkey[i].Cmp(expected.Pubkey[i]) != 0 {
+			return fmt.Errorf("public key mismatch at index %d", i)
+		}
+	}
+
+	return nil
+}
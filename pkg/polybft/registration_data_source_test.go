@@ -0,0 +1,136 @@
+package polybft
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testValidatorKey is an encoded validator key, the first Hardhat development account, with its address.
+const (
+	testValidatorKey     = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	testValidatorAddress = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+)
+
+// readDataSource reads the data source with the given attributes set in its configuration, the others null,
+// and returns its state and diagnostics.
+func readDataSource(t *testing.T, d datasource.DataSource, attrs map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, value := range attrs {
+		values[name] = value
+	}
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	return resp.State, resp.Diagnostics
+}
+
+// generatePolyBFTBLSKey returns a new PolyBFT BLS key and its encoded form.
+func generatePolyBFTBLSKey(t *testing.T) (*bls.PrivateKey, string) {
+	t.Helper()
+	key, err := bls.GenerateBlsKey()
+	if err != nil {
+		t.Fatalf("unable to generate BLS key: %v", err)
+	}
+	encoded, err := key.Marshal()
+	if err != nil {
+		t.Fatalf("unable to encode BLS key: %v", err)
+	}
+	return key, string(encoded)
+}
+
+func TestRegistrationDataSource(t *testing.T) {
+	blsKey, blsKeyEncoded := generatePolyBFTBLSKey(t)
+
+	state, diags := readDataSource(t, NewRegistrationDataSource(), map[string]tftypes.Value{
+		"validator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+		"bls_key_encoded":       tftypes.NewValue(tftypes.String, blsKeyEncoded),
+		"chain_id":              tftypes.NewValue(tftypes.Number, 100),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	var model registrationDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if model.Address.ValueString() != testValidatorAddress {
+		t.Errorf("address = %s, want %s", model.Address, testValidatorAddress)
+	}
+
+	// The register calldata decodes back to the public key and proof of possession.
+	calldata, err := hex.DecodeHex(model.RegisterCalldata.ValueString())
+	if err != nil {
+		t.Fatalf("register_calldata is not hex: %v", err)
+	}
+	var register contractsapi.RegisterChildValidatorSetFn
+	if err := register.DecodeAbi(calldata); err != nil {
+		t.Fatalf("unable to decode register_calldata: %v", err)
+	}
+	decodedPubkey, err := bls.UnmarshalPublicKeyFromBigInt(register.Pubkey)
+	if err != nil {
+		t.Fatalf("unable to decode the registered public key: %v", err)
+	}
+	if !bytes.Equal(decodedPubkey.Marshal(), blsKey.PublicKey().Marshal()) {
+		t.Error("register_calldata does not carry the BLS public key")
+	}
+	signature, err := hex.DecodeHex(model.Signature.ValueString())
+	if err != nil {
+		t.Fatalf("signature is not hex: %v", err)
+	}
+	proof, err := bls.UnmarshalSignature(signature)
+	if err != nil {
+		t.Fatalf("unable to decode signature: %v", err)
+	}
+	proofInts, err := proof.ToBigInt()
+	if err != nil {
+		t.Fatalf("unable to convert signature: %v", err)
+	}
+	for i := range proofInts {
+		if proofInts[i].Cmp(register.Signature[i]) != 0 {
+			t.Errorf("register_calldata signature word %d = %s, want %s", i, register.Signature[i], proofInts[i])
+		}
+	}
+
+	// The stake calldata is the bare selector, the stake is the transaction value.
+	stake, err := hex.DecodeHex(model.StakeCalldata.ValueString())
+	if err != nil {
+		t.Fatalf("stake_calldata is not hex: %v", err)
+	}
+	if !bytes.Equal(stake, contractsapi.ChildValidatorSet.Abi.Methods["stake"].ID()) {
+		t.Errorf("stake_calldata = %x, want the stake selector", stake)
+	}
+}
+
+func TestRegistrationDataSourceMissingChainID(t *testing.T) {
+	_, blsKeyEncoded := generatePolyBFTBLSKey(t)
+
+	_, diags := readDataSource(t, NewRegistrationDataSource(), map[string]tftypes.Value{
+		"validator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+		"bls_key_encoded":       tftypes.NewValue(tftypes.String, blsKeyEncoded),
+	})
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Missing chain id" {
+		t.Fatalf("expected a missing chain id error, got %v", diags)
+	}
+}
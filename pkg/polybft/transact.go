@@ -0,0 +1,110 @@
+package polybft
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// contractCall is a transaction calling a contract on behalf of a validator account.
+type contractCall struct {
+	To       edgetypes.Address
+	Input    []byte
+	Value    *big.Int
+	GasPrice *big.Int
+}
+
+// transactor sends contract calls from a single account, keeping track of its nonce.
+type transactor struct {
+	client       *rpc.Client
+	key          *ecdsa.PrivateKey
+	from         edgetypes.Address
+	chainID      uint64
+	confirmation rpc.ConfirmationConfig
+}
+
+// newTransactor is a helper function to create a transactor for the account of the given key.
+func newTransactor(client *rpc.Client, key *ecdsa.PrivateKey, chainID uint64, confirmation rpc.ConfirmationConfig) *transactor {
+	return &transactor{
+		client:       client,
+		key:          key,
+		from:         crypto.PubKeyToAddress(&key.PublicKey),
+		chainID:      chainID,
+		confirmation: confirmation,
+	}
+}
+
+// Send submits the call and returns its hash without waiting for it to be included.
+// The gas limit is estimated by the endpoint and the gas price defaults to the suggested one.
+func (t *transactor) Send(ctx context.Context, call contractCall) (edgetypes.Hash, error) {
+	value := call.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	gasPrice := call.GasPrice
+	if gasPrice == nil {
+		var err error
+		if gasPrice, err = t.client.GasPrice(ctx); err != nil {
+			return edgetypes.ZeroHash, fmt.Errorf("unable to get gas price: %w", err)
+		}
+	}
+	gas, err := t.client.EstimateGas(ctx, rpc.CallMsg{From: &t.from, To: &call.To, Value: value, Data: call.Input})
+	if reason, reverted := rpc.RevertReason(err); reverted {
+		return edgetypes.ZeroHash, fmt.Errorf("call reverts: %s", reason)
+	}
+	if err != nil {
+		return edgetypes.ZeroHash, fmt.Errorf("unable to estimate gas: %w", err)
+	}
+	nonce, err := t.client.PendingNonce(ctx, t.from)
+	if err != nil {
+		return edgetypes.ZeroHash, fmt.Errorf("unable to get nonce: %w", err)
+	}
+
+	return t.client.SignAndSend(ctx, t.key, t.chainID, &edgetypes.Transaction{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      gas,
+		To:       &call.To,
+		Value:    value,
+		Input:    call.Input,
+	})
+}
+
+// Wait waits for the transaction to be confirmed and fails if it was reverted.
+func (t *transactor) Wait(ctx context.Context, hash edgetypes.Hash) (*rpc.Receipt, error) {
+	receipt, err := t.client.WaitForConfirmation(ctx, hash, t.confirmation)
+	if err != nil {
+		return nil, err
+	}
+	if !receipt.Succeeded() {
+		return nil, fmt.Errorf("transaction %s was reverted", hash)
+	}
+	return receipt, nil
+}
+
+// ethgoLog converts a receipt log to the form expected by the contractsapi event parsers.
+func ethgoLog(log rpc.Log) (*ethgo.Log, error) {
+	data, err := hex.DecodeHex(log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log data %q: %w", log.Data, err)
+	}
+	topics := make([]ethgo.Hash, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = ethgo.HexToHash(topic)
+	}
+
+	return &ethgo.Log{
+		Address: ethgo.HexToAddress(log.Address),
+		Topics:  topics,
+		Data:    data,
+	}, nil
+}
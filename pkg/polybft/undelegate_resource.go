@@ -0,0 +1,268 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &undelegateResource{}
+	_ resource.ResourceWithConfigure = &undelegateResource{}
+)
+
+// undelegateResourceModel maps the resource schema data.
+type undelegateResourceModel struct {
+	RPCURL              types.String `tfsdk:"rpc_url"`
+	DelegatorKeyEncoded types.String `tfsdk:"delegator_key_encoded"`
+	Validator           types.String `tfsdk:"validator"`
+	Amount              types.String `tfsdk:"amount"`
+	GasPrice            types.String `tfsdk:"gas_price"`
+
+	ChainID          types.Int64 `tfsdk:"chain_id"`
+	SkipChainIDCheck types.Bool  `tfsdk:"skip_chain_id_check"`
+
+	Confirmation *rpc.ConfirmationModel `tfsdk:"confirmation"`
+
+	Address    types.String `tfsdk:"address"`
+	TxHash     types.String `tfsdk:"tx_hash"`
+	Delegation types.String `tfsdk:"delegation"`
+}
+
+// NewUndelegateResource is a helper function to simplify the provider implementation.
+func NewUndelegateResource() resource.Resource {
+	return &undelegateResource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// undelegateResource is the resource implementation.
+type undelegateResource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the resource type name.
+func (r *undelegateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_undelegate"
+}
+
+// Schema defines the schema for the resource.
+func (r *undelegateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Undelegates stake from a registered PolyBFT validator on the child validator set contract. " +
+			"The undelegated amount becomes withdrawable after the withdrawal wait period. " +
+			"Every replacement of this resource undelegates again, destroying it does not delegate the stake back.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node the transaction is sent to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delegator_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Encoded validator key of the account the stake was delegated from.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+			},
+			"validator": schema.StringAttribute{
+				Required:    true,
+				Description: "Address of the validator the stake is undelegated from. It must be registered.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"amount": schema.StringAttribute{
+				Required:    true,
+				Description: "Amount in wei to undelegate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.Wei(),
+				},
+			},
+			"gas_price": schema.StringAttribute{
+				Optional:    true,
+				Description: "Gas price in wei. Defaults to the gas price suggested by the endpoint.",
+				Validators: []validator.String{
+					validators.Wei(),
+				},
+			},
+			"chain_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "Expected chain id of the endpoint. The undelegation is not sent if the endpoint serves another chain. " +
+					"Defaults to the provider `chain_id`.",
+			},
+			"skip_chain_id_check": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip comparing the endpoint chain id with `chain_id`.",
+			},
+			"confirmation": rpc.ConfirmationSchema(),
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Delegator address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tx_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the undelegation transaction.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delegation": schema.StringAttribute{
+				Computed:    true,
+				Description: "Amount in wei the delegator still has delegated to the validator once the undelegation is confirmed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the resource.
+func (r *undelegateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = data
+}
+
+func (r *undelegateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan undelegateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	confirmation, diags := plan.Confirmation.Config(path.Root("confirmation"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	delegatorKey, err := crypto.BytesToECDSAPrivateKey([]byte(plan.DelegatorKeyEncoded.ValueString()))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("delegator_key_encoded"), diagnostics.ValidatorKey, err))
+		return
+	}
+	delegator := crypto.PubKeyToAddress(&delegatorKey.PublicKey)
+	validatorAddress := edgetypes.StringToAddress(plan.Validator.ValueString())
+	// Both values are validated, so parsing cannot fail.
+	amount, _ := new(big.Int).SetString(plan.Amount.ValueString(), 10)
+	gasPrice, _ := new(big.Int).SetString(plan.GasPrice.ValueString(), 10)
+
+	client := rpc.NewClient(plan.RPCURL.ValueString(), r.providerData.RPC)
+	var expectedChainID uint64
+	if !plan.SkipChainIDCheck.ValueBool() {
+		expectedChainID = r.providerData.ChainIDOrDefault(plan.ChainID)
+	}
+	chainID, err := client.GuardedChainID(ctx, expectedChainID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to verify chain id", err.Error())
+		return
+	}
+
+	registered, err := isRegisteredValidator(ctx, client, validatorAddress)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to look up validator", err.Error())
+		return
+	}
+	if !registered {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validator"),
+			"Validator not registered",
+			fmt.Sprintf("%s is not a registered validator of the child validator set contract.", validatorAddress),
+		)
+		return
+	}
+
+	input, err := contractsapi.ChildValidatorSet.Abi.Methods["undelegate"].Encode([]interface{}{validatorAddress, amount})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to encode undelegate call", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Undelegating stake", map[string]interface{}{"delegator": delegator.String(), "validator": validatorAddress.String(), "amount": amount.String()})
+	sender := newTransactor(client, delegatorKey, chainID, confirmation)
+	hash, err := sender.Send(ctx, contractCall{To: contracts.ValidatorSetContract, Input: input, GasPrice: gasPrice})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to undelegate stake", err.Error())
+		return
+	}
+
+	// Record the undelegation before waiting for it, so that it is kept in state even if it is not confirmed.
+	plan.Address = types.StringValue(r.providerData.FormatAddress(delegator))
+	plan.TxHash = types.StringValue(hash.String())
+	plan.Delegation = types.StringNull()
+
+	if _, err := sender.Wait(ctx, hash); err != nil {
+		resp.Diagnostics.AddError("Unable to confirm undelegation", err.Error())
+	} else if delegation, err := delegationOf(ctx, client, validatorAddress, delegator, ""); err != nil {
+		resp.Diagnostics.AddError("Unable to read delegation", err.Error())
+	} else {
+		plan.Delegation = types.StringValue(delegation.String())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *undelegateResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	// NO-OP: a sent undelegation cannot change, the state already holds everything there is to read.
+	tflog.Debug(ctx, "Reading undelegation from state")
+}
+
+func (r *undelegateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only settings which do not affect the undelegation can be updated in place.
+	var plan undelegateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *undelegateResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing undelegation from state")
+}
@@ -0,0 +1,339 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// maxCommissionPercent is the largest commission the child validator set contract of polygon-edge accepts.
+// The contract is checked again before the commission is sent, in case it was deployed with another limit.
+const maxCommissionPercent = 100
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &validatorCommissionResource{}
+	_ resource.ResourceWithConfigure      = &validatorCommissionResource{}
+	_ resource.ResourceWithValidateConfig = &validatorCommissionResource{}
+)
+
+// validatorCommissionResourceModel maps the resource schema data.
+type validatorCommissionResourceModel struct {
+	RPCURL              types.String `tfsdk:"rpc_url"`
+	ValidatorKeyEncoded types.String `tfsdk:"validator_key_encoded"`
+	Commission          types.Int64  `tfsdk:"commission"`
+	GasPrice            types.String `tfsdk:"gas_price"`
+
+	ChainID          types.Int64 `tfsdk:"chain_id"`
+	SkipChainIDCheck types.Bool  `tfsdk:"skip_chain_id_check"`
+
+	Confirmation *rpc.ConfirmationModel `tfsdk:"confirmation"`
+
+	Address            types.String `tfsdk:"address"`
+	PreviousCommission types.Int64  `tfsdk:"previous_commission"`
+	TxHash             types.String `tfsdk:"tx_hash"`
+}
+
+// NewValidatorCommissionResource is a helper function to simplify the provider implementation.
+func NewValidatorCommissionResource() resource.Resource {
+	return &validatorCommissionResource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// validatorCommissionResource is the resource implementation.
+type validatorCommissionResource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the resource type name.
+func (r *validatorCommissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_validator_commission"
+}
+
+// Schema defines the schema for the resource.
+func (r *validatorCommissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets the commission a registered PolyBFT validator takes from the rewards of its delegators, on the child " +
+			"validator set contract. The commission is read back on refresh, so a commission changed outside of Terraform is " +
+			"set again. Destroying this resource leaves the commission as it is.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node the transaction is sent to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"validator_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Validator ECDSA private key, as produced by `polygon-edge polybft-secrets`. The transaction is sent from its account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+			},
+			"commission": schema.Int64Attribute{
+				Required:    true,
+				Description: fmt.Sprintf("Commission in percent of the delegator rewards, between 0 and %d.", maxCommissionPercent),
+			},
+			"gas_price": schema.StringAttribute{
+				Optional:    true,
+				Description: "Gas price in wei. Defaults to the gas price suggested by the endpoint.",
+				Validators: []validator.String{
+					validators.Wei(),
+				},
+			},
+			"chain_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "Expected chain id of the endpoint. The commission is not sent if the endpoint serves another chain. " +
+					"Defaults to the provider `chain_id`.",
+			},
+			"skip_chain_id_check": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip comparing the endpoint chain id with `chain_id`.",
+			},
+			"confirmation": rpc.ConfirmationSchema(),
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_commission": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Commission of the validator before it was last set by this resource.",
+			},
+			"tx_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the transaction which last set the commission, null if the validator already had it.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the resource.
+func (r *validatorCommissionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = data
+}
+
+// ValidateConfig ensures the commission is a valid percentage.
+func (r *validatorCommissionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config validatorCommissionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Commission.IsNull() || config.Commission.IsUnknown() {
+		return
+	}
+
+	if commission := config.Commission.ValueInt64(); commission < 0 || commission > maxCommissionPercent {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("commission"),
+			"Invalid commission",
+			fmt.Sprintf("The commission must be between 0 and %d percent, got: %d.", maxCommissionPercent, commission),
+		)
+	}
+}
+
+func (r *validatorCommissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan validatorCommissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setCommission(ctx, &plan)...)
+	if plan.Address.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *validatorCommissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state validatorCommissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), r.providerData.RPC)
+	commission, err := validatorCommission(ctx, client, edgetypes.StringToAddress(state.Address.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read commission", err.Error())
+		return
+	}
+	if !commission.IsInt64() {
+		resp.Diagnostics.AddError("Invalid commission", fmt.Sprintf("The child validator set contract reports a commission of %s percent.", commission))
+		return
+	}
+	if commission.Int64() != state.Commission.ValueInt64() {
+		tflog.Debug(ctx, "Commission changed outside of Terraform", map[string]interface{}{"validator": state.Address.ValueString(), "commission": commission.Int64()})
+		state.Commission = types.Int64Value(commission.Int64())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *validatorCommissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state validatorCommissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings which do not affect the commission are updated in place without a transaction.
+	if plan.Commission.Equal(state.Commission) {
+		plan.PreviousCommission = state.PreviousCommission
+		plan.TxHash = state.TxHash
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setCommission(ctx, &plan)...)
+	if plan.Address.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *validatorCommissionResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing validator commission from state")
+}
+
+// setCommission sets the planned commission on the child validator set contract, unless the validator already has it,
+// and records the previous commission and the transaction on the model. The address stays unknown if nothing was
+// recorded, otherwise the model is to be saved even with errors, as the transaction may have been sent.
+func (r *validatorCommissionResource) setCommission(ctx context.Context, plan *validatorCommissionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	confirmation, confirmationDiags := plan.Confirmation.Config(path.Root("confirmation"))
+	diags.Append(confirmationDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	validatorKey, err := crypto.BytesToECDSAPrivateKey([]byte(plan.ValidatorKeyEncoded.ValueString()))
+	if err != nil {
+		diags.Append(diagnostics.KeyParse(path.Root("validator_key_encoded"), diagnostics.ValidatorKey, err))
+		return diags
+	}
+	address := crypto.PubKeyToAddress(&validatorKey.PublicKey)
+	commission := big.NewInt(plan.Commission.ValueInt64())
+	// The gas price is validated, so parsing cannot fail.
+	gasPrice, _ := new(big.Int).SetString(plan.GasPrice.ValueString(), 10)
+
+	client := rpc.NewClient(plan.RPCURL.ValueString(), r.providerData.RPC)
+	var expectedChainID uint64
+	if !plan.SkipChainIDCheck.ValueBool() {
+		expectedChainID = r.providerData.ChainIDOrDefault(plan.ChainID)
+	}
+	chainID, err := client.GuardedChainID(ctx, expectedChainID)
+	if err != nil {
+		diags.AddError("Unable to verify chain id", err.Error())
+		return diags
+	}
+
+	registered, err := isRegisteredValidator(ctx, client, address)
+	if err != nil {
+		diags.AddError("Unable to look up validator", err.Error())
+		return diags
+	}
+	if !registered {
+		diags.AddAttributeError(
+			path.Root("validator_key_encoded"),
+			"Validator not registered",
+			fmt.Sprintf("%s is not a registered validator of the child validator set contract.", address),
+		)
+		return diags
+	}
+	maxCommission, err := maxValidatorCommission(ctx, client)
+	if err != nil {
+		diags.AddError("Unable to read maximum commission", err.Error())
+		return diags
+	}
+	if commission.Cmp(maxCommission) > 0 {
+		diags.AddAttributeError(
+			path.Root("commission"),
+			"Invalid commission",
+			fmt.Sprintf("The child validator set contract accepts a commission of at most %s percent, got: %s.", maxCommission, commission),
+		)
+		return diags
+	}
+	previous, err := validatorCommission(ctx, client, address)
+	if err != nil {
+		diags.AddError("Unable to read commission", err.Error())
+		return diags
+	}
+
+	plan.Address = types.StringValue(r.providerData.FormatAddress(address))
+	plan.PreviousCommission = types.Int64Value(previous.Int64())
+	plan.TxHash = types.StringNull()
+	if previous.Cmp(commission) == 0 {
+		tflog.Debug(ctx, "Commission already set", map[string]interface{}{"validator": address.String(), "commission": commission.String()})
+		return diags
+	}
+
+	input, err := contractsapi.ChildValidatorSet.Abi.Methods["setCommission"].Encode([]interface{}{commission})
+	if err != nil {
+		diags.AddError("Unable to encode setCommission call", err.Error())
+		return diags
+	}
+
+	tflog.Debug(ctx, "Setting validator commission", map[string]interface{}{"validator": address.String(), "commission": commission.String()})
+	sender := newTransactor(client, validatorKey, chainID, confirmation)
+	hash, err := sender.Send(ctx, contractCall{To: contracts.ValidatorSetContract, Input: input, GasPrice: gasPrice})
+	if err != nil {
+		diags.AddError("Unable to set commission", err.Error())
+		return diags
+	}
+
+	// Record the transaction before waiting for it, so that it is kept in state even if it is not confirmed.
+	plan.TxHash = types.StringValue(hash.String())
+
+	if _, err := sender.Wait(ctx, hash); err != nil {
+		diags.AddError("Unable to confirm commission", err.Error())
+	} else if current, err := validatorCommission(ctx, client, address); err != nil {
+		diags.AddError("Unable to read commission", err.Error())
+	} else if current.Cmp(commission) != 0 {
+		diags.AddError(
+			"Commission not set",
+			fmt.Sprintf("Transaction %s was included but the child validator set contract reports a commission of %s percent.", hash, current),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,130 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/umbracle/ethgo"
+)
+
+// commissionNode mocks the getters of the test validator with the given commission, and the maximum commission of
+// the child validator set. If applied, a sent commission is reported by getValidator from then on.
+func commissionNode(t *testing.T, commission, maxCommission int64, applied bool) (*mockNode, string) {
+	node, url := newMockNode(t)
+	node.call = func(method string, args map[string]interface{}) map[string]interface{} {
+		switch method {
+		case "getValidator":
+			if args["validator"] != ethgo.HexToAddress(testValidatorAddress) {
+				t.Errorf("getValidator(%s), want %s", args["validator"], testValidatorAddress)
+			}
+			current := commission
+			if applied && len(node.sent) > 0 {
+				current = new(big.Int).SetBytes(node.sent[len(node.sent)-1].Input[4:]).Int64()
+			}
+			return getValidatorOutputs(true, current)
+		case "MAX_COMMISSION":
+			return map[string]interface{}{"0": big.NewInt(maxCommission)}
+		}
+		return nil
+	}
+	return node, url
+}
+
+// createCommission creates the commission resource of the test validator on the node.
+func createCommission(t *testing.T, url string, commission int64) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	return createResource(t, NewValidatorCommissionResource(), map[string]tftypes.Value{
+		"rpc_url":               tftypes.NewValue(tftypes.String, url),
+		"validator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+		"commission":            tftypes.NewValue(tftypes.Number, commission),
+	})
+}
+
+func TestValidatorCommissionResource(t *testing.T) {
+	node, url := commissionNode(t, 5, 100, true)
+
+	state, diags := createCommission(t, url, 10)
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+	var model validatorCommissionResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	if calls := node.sentCalls(t); fmt.Sprint(calls) != "[setCommission]" {
+		t.Fatalf("sent calls %v, want [setCommission]", calls)
+	}
+	if sent := new(big.Int).SetBytes(node.sent[0].Input[4:]); sent.Int64() != 10 {
+		t.Errorf("sent commission %s, want 10", sent)
+	}
+	if model.Address.ValueString() != testValidatorAddress {
+		t.Errorf("address = %s, want %s", model.Address, testValidatorAddress)
+	}
+	if model.Commission.ValueInt64() != 10 || model.PreviousCommission.ValueInt64() != 5 {
+		t.Errorf("commission, previous_commission = %s, %s, want 10, 5", model.Commission, model.PreviousCommission)
+	}
+	if model.TxHash.ValueString() != node.sent[0].Hash.String() {
+		t.Errorf("tx_hash = %s, want %s", model.TxHash, node.sent[0].Hash)
+	}
+}
+
+func TestValidatorCommissionResourceUnchanged(t *testing.T) {
+	node, url := commissionNode(t, 10, 100, true)
+
+	state, diags := createCommission(t, url, 10)
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+	var model validatorCommissionResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	if len(node.sent) != 0 {
+		t.Errorf("sent %d transactions for the commission the validator already has", len(node.sent))
+	}
+	if !model.TxHash.IsNull() || model.PreviousCommission.ValueInt64() != 10 {
+		t.Errorf("tx_hash, previous_commission = %s, %s, want null, 10", model.TxHash, model.PreviousCommission)
+	}
+}
+
+func TestValidatorCommissionResourceAboveMaxCommission(t *testing.T) {
+	node, url := commissionNode(t, 5, 20, true)
+
+	state, diags := createCommission(t, url, 30)
+	if !diags.HasError() {
+		t.Fatal("setting a commission above MAX_COMMISSION succeeded")
+	}
+	if got := diags.Errors()[0].Summary(); got != "Invalid commission" {
+		t.Errorf("got %q, want the invalid commission error", got)
+	}
+	if len(node.sent) != 0 || !state.Raw.IsNull() {
+		t.Errorf("sent %d transactions and saved %s, want nothing", len(node.sent), state.Raw)
+	}
+}
+
+func TestValidatorCommissionResourceNotReflected(t *testing.T) {
+	node, url := commissionNode(t, 5, 100, false)
+
+	state, diags := createCommission(t, url, 10)
+	if !diags.HasError() {
+		t.Fatal("a commission the contract does not report succeeded")
+	}
+	if got := diags.Errors()[0].Summary(); got != "Commission not set" {
+		t.Errorf("got %q, want the commission not set error", got)
+	}
+	// The commission is kept in state, it was sent.
+	var model validatorCommissionResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if model.TxHash.ValueString() != node.sent[0].Hash.String() {
+		t.Errorf("tx_hash = %s, want %s", model.TxHash, node.sent[0].Hash)
+	}
+}
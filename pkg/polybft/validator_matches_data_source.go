@@ -0,0 +1,198 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &validatorMatchesDataSource{}
+	_ datasource.DataSourceWithConfigure = &validatorMatchesDataSource{}
+)
+
+// validatorMatchesDataSourceModel maps the data source schema data.
+type validatorMatchesDataSourceModel struct {
+	RPCURL    types.String `tfsdk:"rpc_url"`
+	Address   types.String `tfsdk:"address"`
+	BLSPubkey types.String `tfsdk:"bls_pubkey"`
+	Block     types.String `tfsdk:"block"`
+
+	Registered       types.Bool   `tfsdk:"registered"`
+	OnChainBLSPubkey types.String `tfsdk:"onchain_bls_pubkey"`
+	BLSPubkeyMatches types.Bool   `tfsdk:"bls_pubkey_matches"`
+	Matches          types.Bool   `tfsdk:"matches"`
+}
+
+// NewValidatorMatchesDataSource is a helper function to simplify the provider implementation.
+func NewValidatorMatchesDataSource() datasource.DataSource {
+	return &validatorMatchesDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// validatorMatchesDataSource is the data source implementation.
+type validatorMatchesDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *validatorMatchesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_validator_matches"
+}
+
+// Schema defines the schema for the data source.
+func (d *validatorMatchesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks that the PolyBFT validator registered on chain matches local secrets, for example after a key " +
+			"rotation. The `getValidator` getter of the child validator set contract is called with `eth_call` for `address`, " +
+			"and the registered BLS public key is compared with `bls_pubkey`. A mismatch is not an error, `matches` is false " +
+			"and a warning shows both values.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node.",
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "Local validator address, such as the `address` of a `polygonedge_secrets` resource.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"bls_pubkey": schema.StringAttribute{
+				Required: true,
+				Description: "Local validator PolyBFT BLS public key, hex encoded, such as the `polybft_registration.bls_pubkey` " +
+					"of a `polygonedge_secrets` resource.",
+				Validators: []validator.String{
+					validators.Hex(),
+				},
+			},
+			"block": schema.StringAttribute{
+				Optional:    true,
+				Description: "Block the validator is read at, `latest`, `pending`, `earliest` or a block number. Defaults to `latest`.",
+				Validators: []validator.String{
+					validators.BlockTag(),
+				},
+			},
+			"registered": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether a validator is registered on chain with `address`.",
+			},
+			"onchain_bls_pubkey": schema.StringAttribute{
+				Computed:    true,
+				Description: "BLS public key registered on chain for `address`, hex encoded. Null if the validator is not registered.",
+			},
+			"bls_pubkey_matches": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the BLS public key registered on chain is `bls_pubkey`.",
+			},
+			"matches": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the validator is registered on chain with both `address` and `bls_pubkey`.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *validatorMatchesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read reads the validator from the child validator set contract and compares it with the local secrets.
+func (d *validatorMatchesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state validatorMatchesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The address and public key are validated, so parsing and decoding cannot fail.
+	address := edgetypes.StringToAddress(state.Address.ValueString())
+	rawPubkey, _ := hex.DecodeHex(state.BLSPubkey.ValueString())
+	pubkey, err := bls.UnmarshalPublicKey(rawPubkey)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bls_pubkey"),
+			"Invalid BLS public key",
+			fmt.Sprintf("The BLS public key is not a valid point: %s.", err),
+		)
+		return
+	}
+
+	client := rpc.NewClient(state.RPCURL.ValueString(), d.providerData.RPC)
+	onchain, err := validatorBLSKey(ctx, client, address, state.Block.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get validator", err.Error())
+		return
+	}
+
+	state.Registered = types.BoolValue(isRegisteredBLSKey(onchain))
+	state.OnChainBLSPubkey = types.StringNull()
+	state.BLSPubkeyMatches = types.BoolValue(false)
+	state.Matches = types.BoolValue(false)
+	if !state.Registered.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Validator not registered",
+			fmt.Sprintf("No validator is registered on chain with address %s, local BLS public key %s.", address, state.BLSPubkey.ValueString()),
+		)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	onchainPubkey, err := bls.UnmarshalPublicKeyFromBigInt(onchain)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get validator", fmt.Sprintf("The BLS public key registered on chain is not a valid point: %s.", err))
+		return
+	}
+	state.OnChainBLSPubkey = types.StringValue(hex.EncodeToHex(onchainPubkey.Marshal()))
+	state.BLSPubkeyMatches = types.BoolValue(blsKeysEqual(pubkey.ToBigInt(), onchain))
+	state.Matches = state.BLSPubkeyMatches
+	if !state.Matches.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Validator BLS public key mismatch",
+			fmt.Sprintf("The validator %s is registered on chain with BLS public key %s, but the local BLS public key is %s. "+
+				"If the BLS key was rotated, the validator must be re-registered with the new key.",
+				address, state.OnChainBLSPubkey.ValueString(), state.BLSPubkey.ValueString()),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// blsKeysEqual reports whether two BLS public keys, in the big integer form of the contracts, are the same.
+func blsKeysEqual(a, b [4]*big.Int) bool {
+	for i := range a {
+		if a[i].Cmp(b[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
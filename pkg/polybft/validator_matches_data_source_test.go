@@ -0,0 +1,122 @@
+package polybft
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/umbracle/ethgo"
+)
+
+// registeredNode mocks getValidator of the test validator, registered with the given BLS public key.
+func registeredNode(t *testing.T, blsKey [4]*big.Int) string {
+	node, url := newMockNode(t)
+	node.call = func(method string, args map[string]interface{}) map[string]interface{} {
+		if method != "getValidator" {
+			return nil
+		}
+		if args["validator"] != ethgo.HexToAddress(testValidatorAddress) {
+			t.Errorf("getValidator(%s), want %s", args["validator"], testValidatorAddress)
+		}
+		outputs := getValidatorOutputs(true, 0)
+		outputs["blsKey"] = blsKey
+		return outputs
+	}
+	return url
+}
+
+func TestValidatorMatchesDataSource(t *testing.T) {
+	localKey, _ := generatePolyBFTBLSKey(t)
+	otherKey, _ := generatePolyBFTBLSKey(t)
+	localPubkey := hex.EncodeToHex(localKey.PublicKey().Marshal())
+	unregistered := [4]*big.Int{new(big.Int), new(big.Int), new(big.Int), new(big.Int)}
+
+	tests := []struct {
+		name           string
+		onchain        [4]*big.Int
+		wantRegistered bool
+		wantOnchain    string
+		wantMatches    bool
+		wantWarning    string
+	}{
+		{
+			name:           "match",
+			onchain:        localKey.PublicKey().ToBigInt(),
+			wantRegistered: true,
+			wantOnchain:    localPubkey,
+			wantMatches:    true,
+		},
+		{
+			name:           "rotated key",
+			onchain:        otherKey.PublicKey().ToBigInt(),
+			wantRegistered: true,
+			wantOnchain:    hex.EncodeToHex(otherKey.PublicKey().Marshal()),
+			wantWarning:    "Validator BLS public key mismatch",
+		},
+		{
+			name:        "not registered",
+			onchain:     unregistered,
+			wantWarning: "Validator not registered",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, diags := readDataSource(t, NewValidatorMatchesDataSource(), map[string]tftypes.Value{
+				"rpc_url":    tftypes.NewValue(tftypes.String, registeredNode(t, tt.onchain)),
+				"address":    tftypes.NewValue(tftypes.String, testValidatorAddress),
+				"bls_pubkey": tftypes.NewValue(tftypes.String, localPubkey),
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+			var model validatorMatchesDataSourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("unable to get state: %v", diags)
+			}
+
+			if model.Registered.ValueBool() != tt.wantRegistered || model.Matches.ValueBool() != tt.wantMatches ||
+				model.BLSPubkeyMatches.ValueBool() != tt.wantMatches {
+				t.Errorf("registered, matches, bls_pubkey_matches = %s, %s, %s, want %t, %t, %t",
+					model.Registered, model.Matches, model.BLSPubkeyMatches, tt.wantRegistered, tt.wantMatches, tt.wantMatches)
+			}
+			// A null public key reads as empty.
+			if model.OnChainBLSPubkey.ValueString() != tt.wantOnchain || model.OnChainBLSPubkey.IsNull() != (tt.wantOnchain == "") {
+				t.Errorf("onchain_bls_pubkey = %s, want %q", model.OnChainBLSPubkey, tt.wantOnchain)
+			}
+
+			if tt.wantWarning == "" {
+				if diags.WarningsCount() != 0 {
+					t.Errorf("unexpected warnings: %v", diags)
+				}
+				return
+			}
+			if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != tt.wantWarning {
+				t.Fatalf("got %v, want the warning %q", diags, tt.wantWarning)
+			}
+			// The mismatch shows both public keys.
+			detail := diags.Warnings()[0].Detail()
+			for _, pubkey := range []string{localPubkey, tt.wantOnchain} {
+				if !strings.Contains(detail, pubkey) {
+					t.Errorf("warning %q does not show the public key %s", detail, pubkey)
+				}
+			}
+		})
+	}
+}
+
+func TestValidatorMatchesDataSourceNotPolyBFT(t *testing.T) {
+	_, url := newMockNode(t)
+	localKey, _ := generatePolyBFTBLSKey(t)
+
+	_, diags := readDataSource(t, NewValidatorMatchesDataSource(), map[string]tftypes.Value{
+		"rpc_url":    tftypes.NewValue(tftypes.String, url),
+		"address":    tftypes.NewValue(tftypes.String, testValidatorAddress),
+		"bls_pubkey": tftypes.NewValue(tftypes.String, hex.EncodeToHex(localKey.PublicKey().Marshal())),
+	})
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Unable to get validator" {
+		t.Fatalf("got %v, want the unable to get validator error", diags)
+	}
+}
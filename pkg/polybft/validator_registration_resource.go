@@ -0,0 +1,330 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &validatorRegistrationResource{}
+	_ resource.ResourceWithConfigure = &validatorRegistrationResource{}
+)
+
+// validatorRegistrationResourceModel maps the resource schema data.
+type validatorRegistrationResourceModel struct {
+	RPCURL              types.String `tfsdk:"rpc_url"`
+	ValidatorKeyEncoded types.String `tfsdk:"validator_key_encoded"`
+	BLSKeyEncoded       types.String `tfsdk:"bls_key_encoded"`
+	GasPrice            types.String `tfsdk:"gas_price"`
+
+	ChainID          types.Int64 `tfsdk:"chain_id"`
+	SkipChainIDCheck types.Bool  `tfsdk:"skip_chain_id_check"`
+
+	Confirmation *rpc.ConfirmationModel `tfsdk:"confirmation"`
+
+	Address    types.String `tfsdk:"address"`
+	BLSPubkey  types.String `tfsdk:"bls_pubkey"`
+	Signature  types.String `tfsdk:"signature"`
+	TxHash     types.String `tfsdk:"tx_hash"`
+	Registered types.Bool   `tfsdk:"registered"`
+}
+
+// NewValidatorRegistrationResource is a helper function to simplify the provider implementation.
+func NewValidatorRegistrationResource() resource.Resource {
+	return &validatorRegistrationResource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// validatorRegistrationResource is the resource implementation.
+type validatorRegistrationResource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the resource type name.
+func (r *validatorRegistrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_validator_registration"
+}
+
+// Schema defines the schema for the resource.
+func (r *validatorRegistrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Registers a whitelisted PolyBFT validator on the child validator set contract, without staking. " +
+			"The BLS public key is submitted together with its proof of possession, which is verified before sending. " +
+			"A validator which is already registered is not registered again. Destroying this resource does not unregister the validator.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node the registration is sent to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"validator_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Validator ECDSA private key, as produced by `polygon-edge polybft-secrets`. The registration is sent from its account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+			},
+			"bls_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Validator PolyBFT BLS private key, as produced by `polygon-edge polybft-secrets`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.PolyBFTBLSKey(),
+				},
+			},
+			"gas_price": schema.StringAttribute{
+				Optional:    true,
+				Description: "Gas price in wei. Defaults to the gas price suggested by the endpoint.",
+				Validators: []validator.String{
+					validators.Wei(),
+				},
+			},
+			"chain_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "Chain id of the child chain, signed into the proof of possession. The registration is not sent if the endpoint serves another chain. " +
+					"Defaults to the provider `chain_id`, or to the chain id of the endpoint if neither is set.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"skip_chain_id_check": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip comparing the endpoint chain id with `chain_id`.",
+			},
+			"confirmation": rpc.ConfirmationSchema(),
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bls_pubkey": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator PolyBFT BLS public key, hex encoded.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signature": schema.StringAttribute{
+				Computed:    true,
+				Description: "BLS proof of possession submitted with the registration, hex encoded.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tx_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the registration transaction, null if the validator was already registered.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registered": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the child validator set contract reports the validator as registered.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the resource.
+func (r *validatorRegistrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = data
+}
+
+func (r *validatorRegistrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan validatorRegistrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	confirmation, diags := plan.Confirmation.Config(path.Root("confirmation"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validatorKey, err := crypto.BytesToECDSAPrivateKey([]byte(plan.ValidatorKeyEncoded.ValueString()))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("validator_key_encoded"), diagnostics.ValidatorKey, err))
+		return
+	}
+	address := crypto.PubKeyToAddress(&validatorKey.PublicKey)
+
+	blsKey, err := bls.UnmarshalPrivateKey([]byte(plan.BLSKeyEncoded.ValueString()))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("bls_key_encoded"), diagnostics.PolyBFTBLSKey, err))
+		return
+	}
+	// The gas price is validated, so parsing cannot fail.
+	gasPrice, _ := new(big.Int).SetString(plan.GasPrice.ValueString(), 10)
+
+	client := rpc.NewClient(plan.RPCURL.ValueString(), r.providerData.RPC)
+	chainID := r.providerData.ChainIDOrDefault(plan.ChainID)
+	var expectedChainID uint64
+	if !plan.SkipChainIDCheck.ValueBool() {
+		expectedChainID = chainID
+	}
+	endpointChainID, err := client.GuardedChainID(ctx, expectedChainID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to verify chain id", err.Error())
+		return
+	}
+	if chainID == 0 {
+		chainID = endpointChainID
+	}
+
+	signature, err := makeKOSKSignature(blsKey, address, int64(chainID))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to sign proof of possession", err.Error())
+		return
+	}
+	if err := verifyKOSKSignature(signature, blsKey.PublicKey(), address, int64(chainID)); err != nil {
+		resp.Diagnostics.AddError("Invalid proof of possession", fmt.Sprintf("%s. Please report this issue to the provider developers.", err))
+		return
+	}
+	signatureBytes, err := signature.Marshal()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to encode proof of possession", err.Error())
+		return
+	}
+	signatureInts, err := signature.ToBigInt()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to encode proof of possession", err.Error())
+		return
+	}
+
+	plan.Address = types.StringValue(r.providerData.FormatAddress(address))
+	plan.BLSPubkey = types.StringValue(hex.EncodeToHex(blsKey.PublicKey().Marshal()))
+	plan.Signature = types.StringValue(hex.EncodeToHex(signatureBytes))
+	plan.TxHash = types.StringNull()
+	plan.Registered = types.BoolValue(false)
+
+	registered, err := isRegisteredValidator(ctx, client, address)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to look up validator", err.Error())
+		return
+	}
+	if registered {
+		resp.Diagnostics.AddWarning(
+			"Validator already registered",
+			fmt.Sprintf("%s is already registered on the child validator set contract, no registration was sent.", address),
+		)
+		plan.Registered = types.BoolValue(true)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	registerFn := &contractsapi.RegisterChildValidatorSetFn{
+		Signature: signatureInts,
+		Pubkey:    blsKey.PublicKey().ToBigInt(),
+	}
+	input, err := registerFn.EncodeAbi()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to encode register call", err.Error())
+		return
+	}
+	if err := verifyRegisterCalldata(input, registerFn); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid register calldata",
+			fmt.Sprintf("The encoded register call does not decode to its arguments: %s. Please report this issue to the provider developers.", err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Registering validator", map[string]interface{}{"validator": address.String(), "chain_id": chainID})
+	sender := newTransactor(client, validatorKey, endpointChainID, confirmation)
+	hash, err := sender.Send(ctx, contractCall{To: contracts.ValidatorSetContract, Input: input, GasPrice: gasPrice})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to register validator", err.Error())
+		return
+	}
+
+	// Record the registration before waiting for it, so that it is kept in state even if it is not confirmed.
+	plan.TxHash = types.StringValue(hash.String())
+
+	if _, err := sender.Wait(ctx, hash); err != nil {
+		resp.Diagnostics.AddError("Unable to confirm registration", err.Error())
+	} else if registered, err := isRegisteredValidator(ctx, client, address); err != nil {
+		resp.Diagnostics.AddError("Unable to look up validator", err.Error())
+	} else {
+		plan.Registered = types.BoolValue(registered)
+		if !registered {
+			resp.Diagnostics.AddError(
+				"Validator not registered",
+				fmt.Sprintf("Transaction %s was included but the child validator set contract does not report %s as registered.", hash, address),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *validatorRegistrationResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	// NO-OP: a registration cannot be undone, the state already holds everything there is to read.
+	tflog.Debug(ctx, "Reading validator registration from state")
+}
+
+func (r *validatorRegistrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only settings which do not affect the registration can be updated in place.
+	var plan validatorRegistrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *validatorRegistrationResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing validator registration from state")
+}
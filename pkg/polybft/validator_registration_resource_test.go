@@ -0,0 +1,147 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/umbracle/ethgo/abi"
+)
+
+// registrationNode mocks a child validator set which reports the validator as registered once a transaction was
+// sent, or from the start if already is set.
+func registrationNode(t *testing.T, already bool) (*mockNode, string) {
+	node, url := newMockNode(t)
+	node.call = func(method string, _ map[string]interface{}) map[string]interface{} {
+		if method != "getValidator" {
+			return nil
+		}
+		// The node holds its lock while calling, so reading the sent transactions is safe.
+		return getValidatorOutputs(already || len(node.sent) > 0, 0)
+	}
+	return node, url
+}
+
+func TestValidatorRegistrationResource(t *testing.T) {
+	blsKey, blsKeyEncoded := generatePolyBFTBLSKey(t)
+	node, url := registrationNode(t, false)
+
+	state, diags := createResource(t, NewValidatorRegistrationResource(), map[string]tftypes.Value{
+		"rpc_url":               tftypes.NewValue(tftypes.String, url),
+		"validator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+		"bls_key_encoded":       tftypes.NewValue(tftypes.String, blsKeyEncoded),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+	var model validatorRegistrationResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	if calls := node.sentCalls(t); fmt.Sprint(calls) != "[register]" {
+		t.Fatalf("sent calls %v, want [register]", calls)
+	}
+	tx := node.sent[0]
+	if tx.Value != nil && tx.Value.Sign() != 0 {
+		t.Errorf("sent value %s, want no stake moved", tx.Value)
+	}
+	args, err := abi.Decode(contractsapi.ChildValidatorSet.Abi.Methods["register"].Inputs, tx.Input[4:])
+	if err != nil {
+		t.Fatalf("unable to decode register call: %v", err)
+	}
+	decoded := args.(map[string]interface{})
+	if got, want := fmt.Sprint(decoded["pubkey"]), fmt.Sprint(blsKey.PublicKey().ToBigInt()); got != want {
+		t.Errorf("registered pubkey %s, want %s", got, want)
+	}
+
+	signatureBytes, err := hex.DecodeHex(model.Signature.ValueString())
+	if err != nil {
+		t.Fatalf("unable to decode signature %s: %v", model.Signature, err)
+	}
+	signature, err := bls.UnmarshalSignature(signatureBytes)
+	if err != nil {
+		t.Fatalf("unable to unmarshal signature: %v", err)
+	}
+	if err := verifyKOSKSignature(signature, blsKey.PublicKey(), edgetypes.StringToAddress(testValidatorAddress), 100); err != nil {
+		t.Errorf("proof of possession does not verify on the endpoint chain id: %v", err)
+	}
+	signatureInts, err := signature.ToBigInt()
+	if err != nil {
+		t.Fatalf("unable to convert signature: %v", err)
+	}
+	if got, want := fmt.Sprint(decoded["signature"].([2]*big.Int)), fmt.Sprint(signatureInts); got != want {
+		t.Errorf("registered signature %s, want the proof of possession %s", got, want)
+	}
+
+	if model.Address.ValueString() != testValidatorAddress {
+		t.Errorf("address = %s, want %s", model.Address, testValidatorAddress)
+	}
+	if model.BLSPubkey.ValueString() != hex.EncodeToHex(blsKey.PublicKey().Marshal()) {
+		t.Errorf("bls_pubkey = %s, want the public key of the BLS key", model.BLSPubkey)
+	}
+	if model.TxHash.ValueString() != tx.Hash.String() || !model.Registered.ValueBool() {
+		t.Errorf("tx_hash, registered = %s, %s, want %s, true", model.TxHash, model.Registered, tx.Hash)
+	}
+}
+
+func TestValidatorRegistrationResourceAlreadyRegistered(t *testing.T) {
+	_, blsKeyEncoded := generatePolyBFTBLSKey(t)
+	node, url := registrationNode(t, true)
+
+	state, diags := createResource(t, NewValidatorRegistrationResource(), map[string]tftypes.Value{
+		"rpc_url":               tftypes.NewValue(tftypes.String, url),
+		"validator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+		"bls_key_encoded":       tftypes.NewValue(tftypes.String, blsKeyEncoded),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+	if len(diags.Warnings()) != 1 || diags.Warnings()[0].Summary() != "Validator already registered" {
+		t.Errorf("got warnings %v, want the already registered warning", diags.Warnings())
+	}
+	if len(node.sent) != 0 {
+		t.Errorf("sent %d transactions for a registered validator", len(node.sent))
+	}
+	var model validatorRegistrationResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if !model.TxHash.IsNull() || !model.Registered.ValueBool() {
+		t.Errorf("tx_hash, registered = %s, %s, want null, true", model.TxHash, model.Registered)
+	}
+}
+
+func TestValidatorRegistrationResourceNotReflected(t *testing.T) {
+	_, blsKeyEncoded := generatePolyBFTBLSKey(t)
+	node, url := newMockNode(t)
+	node.call = func(string, map[string]interface{}) map[string]interface{} {
+		return getValidatorOutputs(false, 0)
+	}
+
+	state, diags := createResource(t, NewValidatorRegistrationResource(), map[string]tftypes.Value{
+		"rpc_url":               tftypes.NewValue(tftypes.String, url),
+		"validator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+		"bls_key_encoded":       tftypes.NewValue(tftypes.String, blsKeyEncoded),
+	})
+	if !diags.HasError() {
+		t.Fatal("a registration the contract does not report succeeded")
+	}
+	if got := diags.Errors()[0].Summary(); got != "Validator not registered" {
+		t.Errorf("got %q, want the not registered error", got)
+	}
+	// The registration is kept in state, it was sent.
+	var model validatorRegistrationResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if model.TxHash.ValueString() != node.sent[0].Hash.String() || model.Registered.ValueBool() {
+		t.Errorf("tx_hash, registered = %s, %s, want %s, false", model.TxHash, model.Registered, node.sent[0].Hash)
+	}
+}
@@ -0,0 +1,119 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// callValidatorSet calls a getter of the child validator set contract at the given block and returns its decoded outputs.
+func callValidatorSet(ctx context.Context, client *rpc.Client, block, method string, args ...interface{}) (map[string]interface{}, error) {
+	abiMethod := contractsapi.ChildValidatorSet.Abi.Methods[method]
+	if args == nil {
+		args = []interface{}{}
+	}
+	input, err := abiMethod.Encode(args)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode %s call: %w", method, err)
+	}
+
+	to := contracts.ValidatorSetContract
+	result, err := client.CallContract(ctx, rpc.CallMsg{To: &to, Data: input}, block)
+	if reason, reverted := rpc.RevertReason(err); reverted {
+		return nil, fmt.Errorf("%s call reverts: %s", method, reason)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("%s call returned no data, check that the chain runs PolyBFT", method)
+	}
+
+	decoded, err := abiMethod.Decode(result)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode %s result: %w", method, err)
+	}
+	return decoded, nil
+}
+
+// isRegisteredValidator reports whether the validator has registered its BLS key on the child validator set contract.
+func isRegisteredValidator(ctx context.Context, client *rpc.Client, validator edgetypes.Address) (bool, error) {
+	blsKey, err := validatorBLSKey(ctx, client, validator, "")
+	if err != nil {
+		return false, err
+	}
+	return isRegisteredBLSKey(blsKey), nil
+}
+
+// validatorBLSKey returns the BLS public key the validator registered on the child validator set contract at the
+// given block, which is all zeros if the validator is not registered.
+func validatorBLSKey(ctx context.Context, client *rpc.Client, validator edgetypes.Address, block string) ([4]*big.Int, error) {
+	outputs, err := callValidatorSet(ctx, client, block, "getValidator", validator)
+	if err != nil {
+		return [4]*big.Int{}, err
+	}
+
+	blsKey, ok := outputs["blsKey"].([4]*big.Int)
+	if !ok {
+		return [4]*big.Int{}, fmt.Errorf("unexpected getValidator blsKey type %T", outputs["blsKey"])
+	}
+	return blsKey, nil
+}
+
+// isRegisteredBLSKey reports whether a BLS public key read from the child validator set contract is set.
+func isRegisteredBLSKey(blsKey [4]*big.Int) bool {
+	for _, part := range blsKey {
+		if part.Sign() != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// delegationOf returns the amount in wei the delegator has delegated to the validator at the given block.
+func delegationOf(ctx context.Context, client *rpc.Client, validator, delegator edgetypes.Address, block string) (*big.Int, error) {
+	outputs, err := callValidatorSet(ctx, client, block, "delegationOf", validator, delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, ok := outputs["0"].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected delegationOf result type %T", outputs["0"])
+	}
+	return amount, nil
+}
+
+// validatorCommission returns the commission of the validator, in percent of its delegators' rewards.
+func validatorCommission(ctx context.Context, client *rpc.Client, validator edgetypes.Address) (*big.Int, error) {
+	outputs, err := callValidatorSet(ctx, client, "", "getValidator", validator)
+	if err != nil {
+		return nil, err
+	}
+
+	commission, ok := outputs["commission"].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected getValidator commission type %T", outputs["commission"])
+	}
+	return commission, nil
+}
+
+// maxValidatorCommission returns the largest commission the child validator set contract accepts, in percent.
+func maxValidatorCommission(ctx context.Context, client *rpc.Client) (*big.Int, error) {
+	outputs, err := callValidatorSet(ctx, client, "", "MAX_COMMISSION")
+	if err != nil {
+		return nil, err
+	}
+
+	maxCommission, ok := outputs["0"].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected MAX_COMMISSION result type %T", outputs["0"])
+	}
+	return maxCommission, nil
+}
@@ -0,0 +1,163 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &verifyPopDataSource{}
+	_ datasource.DataSourceWithConfigure = &verifyPopDataSource{}
+)
+
+// verifyPopDataSourceModel maps the data source schema data.
+type verifyPopDataSourceModel struct {
+	BLSPubkey types.String `tfsdk:"bls_pubkey"`
+	Signature types.String `tfsdk:"signature"`
+	Address   types.String `tfsdk:"address"`
+	ChainID   types.Int64  `tfsdk:"chain_id"`
+
+	Valid  types.Bool   `tfsdk:"valid"`
+	Reason types.String `tfsdk:"reason"`
+}
+
+// NewVerifyPopDataSource is a helper function to simplify the provider implementation.
+func NewVerifyPopDataSource() datasource.DataSource {
+	return &verifyPopDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// verifyPopDataSource is the data source implementation.
+type verifyPopDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *verifyPopDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_verify_pop"
+}
+
+// Schema defines the schema for the data source.
+func (d *verifyPopDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Verifies the BLS proof of possession of a PolyBFT validator, the signature of its address and chain id " +
+			"submitted with its registration, the same way the child validator set contract does. It checks registration data " +
+			"before it is submitted on chain. A proof which does not verify is not an error, `valid` is false and a warning " +
+			"details the reason.",
+		Attributes: map[string]schema.Attribute{
+			"bls_pubkey": schema.StringAttribute{
+				Required:    true,
+				Description: "Validator PolyBFT BLS public key, hex encoded.",
+				Validators: []validator.String{
+					validators.Hex(),
+				},
+			},
+			"signature": schema.StringAttribute{
+				Required:    true,
+				Description: "BLS proof of possession, hex encoded.",
+				Validators: []validator.String{
+					validators.Hex(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Required:    true,
+				Description: "Validator address the proof was made for.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"chain_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Chain ID of the child chain the proof was made for. Defaults to the provider `chain_id`.",
+			},
+			"valid": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the proof of possession verifies.",
+			},
+			"reason": schema.StringAttribute{
+				Computed:    true,
+				Description: "Why the proof of possession does not verify, null if it does.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *verifyPopDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read verifies the proof of possession.
+func (d *verifyPopDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state verifyPopDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	chainID := d.providerData.ChainIDOrDefault(state.ChainID)
+	if chainID == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("chain_id"),
+			"Missing chain id",
+			"The chain id must be set either on the data source or on the provider.",
+		)
+		return
+	}
+
+	// The public key and signature are validated hex, so decoding cannot fail.
+	pubkey, _ := hex.DecodeHex(state.BLSPubkey.ValueString())
+	signature, _ := hex.DecodeHex(state.Signature.ValueString())
+	address := edgetypes.StringToAddress(state.Address.ValueString())
+
+	state.ChainID = types.Int64Value(int64(chainID))
+	state.Valid = types.BoolValue(true)
+	state.Reason = types.StringNull()
+	if err := verifyPop(pubkey, signature, address, int64(chainID)); err != nil {
+		state.Valid = types.BoolValue(false)
+		state.Reason = types.StringValue(err.Error())
+		resp.Diagnostics.AddWarning("Invalid proof of possession", fmt.Sprintf("The proof of possession does not verify: %s.", err))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// verifyPop decodes the BLS public key and proof of possession and verifies the proof for the address and chain ID.
+func verifyPop(rawPubkey, rawSignature []byte, address edgetypes.Address, chainID int64) error {
+	pubkey, err := bls.UnmarshalPublicKey(rawPubkey)
+	if err != nil {
+		return fmt.Errorf("the BLS public key is not a valid point: %w", err)
+	}
+	signature, err := bls.UnmarshalSignature(rawSignature)
+	if err != nil {
+		return fmt.Errorf("the proof of possession is not a valid signature: %w", err)
+	}
+	return verifyKOSKSignature(signature, pubkey, address, chainID)
+}
@@ -0,0 +1,92 @@
+package polybft
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// registrationProof returns the BLS public key and proof of possession the registration data source makes
+// for the test validator on chain 100.
+func registrationProof(t *testing.T, blsKeyEncoded string) (pubkey, signature string) {
+	t.Helper()
+	state, diags := readDataSource(t, NewRegistrationDataSource(), map[string]tftypes.Value{
+		"validator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+		"bls_key_encoded":       tftypes.NewValue(tftypes.String, blsKeyEncoded),
+		"chain_id":              tftypes.NewValue(tftypes.Number, 100),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected registration diagnostics: %v", diags)
+	}
+	var model registrationDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	return model.BLSPubkey.ValueString(), model.Signature.ValueString()
+}
+
+func TestVerifyPopDataSource(t *testing.T) {
+	_, blsKeyEncoded := generatePolyBFTBLSKey(t)
+	pubkey, signature := registrationProof(t, blsKeyEncoded)
+	_, otherKeyEncoded := generatePolyBFTBLSKey(t)
+	otherPubkey, otherSignature := registrationProof(t, otherKeyEncoded)
+
+	tests := []struct {
+		name       string
+		pubkey     string
+		signature  string
+		address    string
+		chainID    int64
+		wantValid  bool
+		wantReason string
+	}{
+		{name: "valid", pubkey: pubkey, signature: signature, address: testValidatorAddress, chainID: 100, wantValid: true},
+		{name: "other address", pubkey: pubkey, signature: signature, address: testValidator, chainID: 100},
+		{name: "other chain", pubkey: pubkey, signature: signature, address: testValidatorAddress, chainID: 101},
+		{name: "signed by another key", pubkey: pubkey, signature: otherSignature, address: testValidatorAddress, chainID: 100},
+		{name: "another public key", pubkey: otherPubkey, signature: signature, address: testValidatorAddress, chainID: 100},
+		{
+			name: "not a public key", pubkey: "0x" + strings.Repeat("ff", 128), signature: signature,
+			address: testValidatorAddress, chainID: 100, wantReason: "the BLS public key is not a valid point",
+		},
+		{
+			name: "not a signature", pubkey: pubkey, signature: "0x" + strings.Repeat("ff", 64),
+			address: testValidatorAddress, chainID: 100, wantReason: "the proof of possession is not a valid signature",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, diags := readDataSource(t, NewVerifyPopDataSource(), map[string]tftypes.Value{
+				"bls_pubkey": tftypes.NewValue(tftypes.String, tt.pubkey),
+				"signature":  tftypes.NewValue(tftypes.String, tt.signature),
+				"address":    tftypes.NewValue(tftypes.String, tt.address),
+				"chain_id":   tftypes.NewValue(tftypes.Number, tt.chainID),
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+			var model verifyPopDataSourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("unable to get state: %v", diags)
+			}
+
+			if model.Valid.ValueBool() != tt.wantValid {
+				t.Errorf("valid = %s, want %t", model.Valid, tt.wantValid)
+			}
+			if tt.wantValid {
+				if !model.Reason.IsNull() || diags.WarningsCount() != 0 {
+					t.Errorf("valid proof with reason %s and warnings %v", model.Reason, diags)
+				}
+				return
+			}
+			if !strings.HasPrefix(model.Reason.ValueString(), tt.wantReason) || model.Reason.ValueString() == "" {
+				t.Errorf("reason = %s, want %q", model.Reason, tt.wantReason)
+			}
+			if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Invalid proof of possession" {
+				t.Errorf("got %v, want an invalid proof of possession warning", diags)
+			}
+		})
+	}
+}
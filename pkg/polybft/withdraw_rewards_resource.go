@@ -0,0 +1,317 @@
+package polybft
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/umbracle/ethgo"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &withdrawRewardsResource{}
+	_ resource.ResourceWithConfigure = &withdrawRewardsResource{}
+)
+
+// withdrawRewardsResourceModel maps the resource schema data.
+type withdrawRewardsResourceModel struct {
+	RPCURL              types.String `tfsdk:"rpc_url"`
+	ValidatorKeyEncoded types.String `tfsdk:"validator_key_encoded"`
+	To                  types.String `tfsdk:"to"`
+	Claim               types.Bool   `tfsdk:"claim"`
+	GasPrice            types.String `tfsdk:"gas_price"`
+
+	ChainID          types.Int64 `tfsdk:"chain_id"`
+	SkipChainIDCheck types.Bool  `tfsdk:"skip_chain_id_check"`
+
+	Confirmation *rpc.ConfirmationModel `tfsdk:"confirmation"`
+
+	Address     types.String `tfsdk:"address"`
+	ClaimTxHash types.String `tfsdk:"claim_tx_hash"`
+	TxHash      types.String `tfsdk:"tx_hash"`
+	Amount      types.String `tfsdk:"amount"`
+}
+
+// NewWithdrawRewardsResource is a helper function to simplify the provider implementation.
+func NewWithdrawRewardsResource() resource.Resource {
+	return &withdrawRewardsResource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// withdrawRewardsResource is the resource implementation.
+type withdrawRewardsResource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the resource type name.
+func (r *withdrawRewardsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_withdraw_rewards"
+}
+
+// Schema defines the schema for the resource.
+func (r *withdrawRewardsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Withdraws the rewards of a PolyBFT validator from the child validator set contract. " +
+			"The pending reward is claimed first, then the withdrawable amount is sent to the recipient. " +
+			"Every replacement of this resource submits a new withdrawal, destroying it does not revert the withdrawal.",
+		Attributes: map[string]schema.Attribute{
+			"rpc_url": schema.StringAttribute{
+				Required:    true,
+				Description: "JSON-RPC endpoint of the node the transactions are sent to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"validator_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Validator ECDSA private key, as produced by `polygon-edge polybft-secrets`. The transactions are sent from its account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+			},
+			"to": schema.StringAttribute{
+				Optional:    true,
+				Description: "Address the rewards are sent to. Defaults to the validator address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"claim": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				Description: "Claim the pending validator reward before withdrawing. " +
+					"If false only the amount which is already withdrawable is withdrawn. Defaults to true.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"gas_price": schema.StringAttribute{
+				Optional:    true,
+				Description: "Gas price in wei. Defaults to the gas price suggested by the endpoint.",
+				Validators: []validator.String{
+					validators.Wei(),
+				},
+			},
+			"chain_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "Expected chain id of the endpoint. The withdrawal is not sent if the endpoint serves another chain. " +
+					"Defaults to the provider `chain_id`.",
+			},
+			"skip_chain_id_check": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip comparing the endpoint chain id with `chain_id`.",
+			},
+			"confirmation": rpc.ConfirmationSchema(),
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"claim_tx_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the reward claim transaction, null if `claim` is false.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tx_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the withdrawal transaction.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"amount": schema.StringAttribute{
+				Computed:    true,
+				Description: "Amount in wei withdrawn, as reported by the `Withdrawal` event.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the resource.
+func (r *withdrawRewardsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = data
+}
+
+func (r *withdrawRewardsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan withdrawRewardsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	confirmation, diags := plan.Confirmation.Config(path.Root("confirmation"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validatorKey, err := crypto.BytesToECDSAPrivateKey([]byte(plan.ValidatorKeyEncoded.ValueString()))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("validator_key_encoded"), diagnostics.ValidatorKey, err))
+		return
+	}
+	address := crypto.PubKeyToAddress(&validatorKey.PublicKey)
+	to := address
+	if !plan.To.IsNull() {
+		to = edgetypes.StringToAddress(plan.To.ValueString())
+	}
+	// The gas price is validated, so parsing cannot fail.
+	gasPrice, _ := new(big.Int).SetString(plan.GasPrice.ValueString(), 10)
+
+	client := rpc.NewClient(plan.RPCURL.ValueString(), r.providerData.RPC)
+	var expectedChainID uint64
+	if !plan.SkipChainIDCheck.ValueBool() {
+		expectedChainID = r.providerData.ChainIDOrDefault(plan.ChainID)
+	}
+	chainID, err := client.GuardedChainID(ctx, expectedChainID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to verify chain id", err.Error())
+		return
+	}
+
+	plan.Address = types.StringValue(r.providerData.FormatAddress(address))
+	plan.ClaimTxHash = types.StringNull()
+	plan.TxHash = types.StringNull()
+	plan.Amount = types.StringNull()
+
+	sender := newTransactor(client, validatorKey, chainID, confirmation)
+	if plan.Claim.ValueBool() {
+		input, err := contractsapi.ChildValidatorSet.Abi.Methods["claimValidatorReward"].Encode([]interface{}{})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to encode claim call", err.Error())
+			return
+		}
+
+		tflog.Debug(ctx, "Claiming validator reward", map[string]interface{}{"validator": address.String()})
+		hash, err := sender.Send(ctx, contractCall{To: contracts.ValidatorSetContract, Input: input, GasPrice: gasPrice})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to claim validator reward", err.Error())
+			return
+		}
+		plan.ClaimTxHash = types.StringValue(hash.String())
+		if _, err := sender.Wait(ctx, hash); err != nil {
+			resp.Diagnostics.AddError("Unable to confirm reward claim", err.Error())
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			return
+		}
+	}
+
+	input, err := contractsapi.ChildValidatorSet.Abi.Methods["withdraw"].Encode([]interface{}{ethgo.Address(to)})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to encode withdraw call", err.Error())
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	tflog.Debug(ctx, "Withdrawing validator rewards", map[string]interface{}{"validator": address.String(), "to": to.String()})
+	hash, err := sender.Send(ctx, contractCall{To: contracts.ValidatorSetContract, Input: input, GasPrice: gasPrice})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to withdraw validator rewards", err.Error())
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+	// Record the withdrawal before waiting for it, so that it is kept in state even if it is not confirmed.
+	plan.TxHash = types.StringValue(hash.String())
+
+	receipt, err := sender.Wait(ctx, hash)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to confirm withdrawal", err.Error())
+	} else if amount, err := withdrawnAmount(receipt, address); err != nil {
+		resp.Diagnostics.AddError("Unable to read withdrawn amount", err.Error())
+	} else {
+		plan.Amount = types.StringValue(amount.String())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// withdrawnAmount returns the amount of the Withdrawal event emitted for the account in the receipt.
+func withdrawnAmount(receipt *rpc.Receipt, account edgetypes.Address) (*big.Int, error) {
+	for _, log := range receipt.Logs {
+		if edgetypes.StringToAddress(log.Address) != contracts.ValidatorSetContract {
+			continue
+		}
+		parsed, err := ethgoLog(log)
+		if err != nil {
+			return nil, err
+		}
+
+		var event contractsapi.WithdrawalEvent
+		matches, err := event.ParseLog(parsed)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode Withdrawal event: %w", err)
+		}
+		if matches && event.Account == account {
+			return event.Amount, nil
+		}
+	}
+
+	return nil, fmt.Errorf("transaction %s emitted no Withdrawal event for %s", receipt.TransactionHash, account)
+}
+
+func (r *withdrawRewardsResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	// NO-OP: a withdrawal cannot change, the state already holds everything there is to read.
+	tflog.Debug(ctx, "Reading rewards withdrawal from state")
+}
+
+func (r *withdrawRewardsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only settings which do not affect the withdrawal can be updated in place.
+	var plan withdrawRewardsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *withdrawRewardsResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing rewards withdrawal from state")
+}
@@ -0,0 +1,354 @@
+package polybft
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+)
+
+// mockNode is a JSON-RPC endpoint of a PolyBFT chain, which includes the transactions sent to it at once.
+type mockNode struct {
+	mu      sync.Mutex
+	chainID uint64
+	nonce   uint64
+	sent    []*edgetypes.Transaction
+	// logs returns the logs a sent transaction emits.
+	logs func(tx *edgetypes.Transaction) []map[string]interface{}
+	// call returns the outputs of a child validator set getter called with the given arguments, by name.
+	call func(method string, args map[string]interface{}) map[string]interface{}
+}
+
+func newMockNode(t *testing.T) (*mockNode, string) {
+	node := &mockNode{chainID: 100}
+	server := httptest.NewServer(http.HandlerFunc(node.serve))
+	t.Cleanup(server.Close)
+	return node, server.URL
+}
+
+func (n *mockNode) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     uint64            `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var result, rpcErr interface{}
+	switch req.Method {
+	case "eth_chainId":
+		result = fmt.Sprintf("0x%x", n.chainID)
+	case "eth_gasPrice":
+		result = "0x1"
+	case "eth_estimateGas":
+		result = "0x5208"
+	case "eth_getTransactionCount":
+		result = fmt.Sprintf("0x%x", n.nonce)
+	case "eth_sendRawTransaction":
+		var encoded string
+		_ = json.Unmarshal(req.Params[0], &encoded)
+		raw, _ := hex.DecodeHex(encoded)
+		tx := &edgetypes.Transaction{}
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			rpcErr = map[string]interface{}{"code": -32000, "message": err.Error()}
+			break
+		}
+		tx.ComputeHash()
+		n.sent = append(n.sent, tx)
+		n.nonce++
+		result = tx.Hash.String()
+	case "eth_call":
+		result = n.serveCall(req.Params[0])
+	case "eth_getTransactionReceipt":
+		var hash string
+		_ = json.Unmarshal(req.Params[0], &hash)
+		for _, tx := range n.sent {
+			if tx.Hash != edgetypes.StringToHash(hash) {
+				continue
+			}
+			logs := []map[string]interface{}{}
+			if n.logs != nil {
+				logs = n.logs(tx)
+			}
+			result = map[string]interface{}{"transactionHash": hash, "blockNumber": "0x1", "status": "0x1", "logs": logs}
+		}
+	default:
+		rpcErr = map[string]interface{}{"code": -32601, "message": "method not found"}
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result, "error": rpcErr})
+}
+
+// serveCall returns the ABI encoded outputs of the child validator set getter called by the call object, or no data
+// if the getter is not mocked.
+func (n *mockNode) serveCall(param json.RawMessage) string {
+	var msg struct {
+		Data string `json:"data"`
+	}
+	_ = json.Unmarshal(param, &msg)
+	input, _ := hex.DecodeHex(msg.Data)
+	if n.call == nil || len(input) < 4 {
+		return "0x"
+	}
+	for name, method := range contractsapi.ChildValidatorSet.Abi.Methods {
+		if !bytes.Equal(method.ID(), input[:4]) {
+			continue
+		}
+		args := map[string]interface{}{}
+		if len(method.Inputs.TupleElems()) > 0 {
+			decoded, err := abi.Decode(method.Inputs, input[4:])
+			if err != nil {
+				return "0x"
+			}
+			args = decoded.(map[string]interface{})
+		}
+		outputs := n.call(name, args)
+		if outputs == nil {
+			return "0x"
+		}
+		encoded, err := method.Outputs.Encode(outputs)
+		if err != nil {
+			return "0x"
+		}
+		return hex.EncodeToHex(encoded)
+	}
+	return "0x"
+}
+
+// sentCalls returns the child validator set methods called by the sent transactions, in order.
+func (n *mockNode) sentCalls(t *testing.T) []string {
+	t.Helper()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	calls := make([]string, len(n.sent))
+	for i, tx := range n.sent {
+		if *tx.To != contracts.ValidatorSetContract {
+			t.Fatalf("transaction %d sent to %s, want the child validator set", i, tx.To)
+		}
+		for name, method := range contractsapi.ChildValidatorSet.Abi.Methods {
+			if len(tx.Input) >= 4 && bytes.Equal(method.ID(), tx.Input[:4]) {
+				calls[i] = name
+			}
+		}
+	}
+	return calls
+}
+
+// eventLog returns the receipt log of a child validator set event with the given inputs, by name.
+func eventLog(t *testing.T, name string, inputs map[string]interface{}) map[string]interface{} {
+	t.Helper()
+	event := contractsapi.ChildValidatorSet.Abi.Events[name]
+	topics := []string{event.ID().String()}
+	var dataElems []*abi.TupleElem
+	dataValues := map[string]interface{}{}
+	for _, elem := range event.Inputs.TupleElems() {
+		if !elem.Indexed {
+			dataElems = append(dataElems, elem)
+			dataValues[elem.Name] = inputs[elem.Name]
+			continue
+		}
+		topic, err := abi.EncodeTopic(elem.Elem, inputs[elem.Name])
+		if err != nil {
+			t.Fatalf("unable to encode %s topic: %v", elem.Name, err)
+		}
+		topics = append(topics, topic.String())
+	}
+	data, err := abi.NewTupleType(dataElems).Encode(dataValues)
+	if err != nil {
+		t.Fatalf("unable to encode %s data: %v", name, err)
+	}
+	return map[string]interface{}{
+		"address": contracts.ValidatorSetContract.String(),
+		"topics":  topics,
+		"data":    hex.EncodeToHex(data),
+	}
+}
+
+// confirmation returns the confirmation block of a resource, polling the mock node quickly.
+func confirmation(objectType tftypes.Object) tftypes.Value {
+	return tftypes.NewValue(objectType.AttributeTypes["confirmation"], map[string]tftypes.Value{
+		"timeout":       tftypes.NewValue(tftypes.String, "2s"),
+		"poll_interval": tftypes.NewValue(tftypes.String, "10ms"),
+		"confirmations": tftypes.NewValue(tftypes.Number, nil),
+	})
+}
+
+// createResource applies a new resource with the given attributes set in its configuration and plan. The other
+// attributes are null in the configuration, and null or unknown if computed in the plan.
+func createResource(t *testing.T, r resource.Resource, attrs map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	plan := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		config[name] = tftypes.NewValue(typ, nil)
+		plan[name] = tftypes.NewValue(typ, nil)
+		if schemaResp.Schema.Attributes[name].IsComputed() {
+			plan[name] = tftypes.NewValue(typ, tftypes.UnknownValue)
+		}
+	}
+	config["confirmation"] = confirmation(objectType)
+	plan["confirmation"] = config["confirmation"]
+	for name, value := range attrs {
+		config[name] = value
+		plan[name] = value
+	}
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, config)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, plan)},
+	}, resp)
+	return resp.State, resp.Diagnostics
+}
+
+// withdrawalLogs emits the Withdrawal event of the amount for the validator withdrawing to recipient.
+func withdrawalLogs(t *testing.T, amount int64) func(tx *edgetypes.Transaction) []map[string]interface{} {
+	return func(tx *edgetypes.Transaction) []map[string]interface{} {
+		method := contractsapi.ChildValidatorSet.Abi.Methods["withdraw"]
+		if !bytes.Equal(tx.Input[:4], method.ID()) {
+			return nil
+		}
+		args, _ := abi.Decode(method.Inputs, tx.Input[4:])
+		return []map[string]interface{}{eventLog(t, "Withdrawal", map[string]interface{}{
+			"account": ethgo.HexToAddress(testValidatorAddress),
+			"to":      args.(map[string]interface{})["to"],
+			"amount":  big.NewInt(amount),
+		})}
+	}
+}
+
+func TestWithdrawRewardsResource(t *testing.T) {
+	const recipient = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+
+	tests := []struct {
+		name      string
+		claim     bool
+		to        string
+		wantCalls []string
+		wantTo    string
+	}{
+		{name: "claim", claim: true, wantCalls: []string{"claimValidatorReward", "withdraw"}, wantTo: testValidatorAddress},
+		{name: "without claim", claim: false, wantCalls: []string{"withdraw"}, wantTo: testValidatorAddress},
+		{name: "to recipient", claim: true, to: recipient, wantCalls: []string{"claimValidatorReward", "withdraw"}, wantTo: recipient},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, url := newMockNode(t)
+			node.logs = withdrawalLogs(t, 1000)
+
+			attrs := map[string]tftypes.Value{
+				"rpc_url":               tftypes.NewValue(tftypes.String, url),
+				"validator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+				"claim":                 tftypes.NewValue(tftypes.Bool, tt.claim),
+			}
+			if tt.to != "" {
+				attrs["to"] = tftypes.NewValue(tftypes.String, tt.to)
+			}
+			state, diags := createResource(t, NewWithdrawRewardsResource(), attrs)
+			if diags.HasError() {
+				t.Fatalf("unexpected create diagnostics: %v", diags)
+			}
+			var model withdrawRewardsResourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("unable to get state: %v", diags)
+			}
+
+			calls := node.sentCalls(t)
+			if fmt.Sprint(calls) != fmt.Sprint(tt.wantCalls) {
+				t.Fatalf("sent calls %v, want %v", calls, tt.wantCalls)
+			}
+			withdrawal := node.sent[len(node.sent)-1]
+			args, err := abi.Decode(contractsapi.ChildValidatorSet.Abi.Methods["withdraw"].Inputs, withdrawal.Input[4:])
+			if err != nil {
+				t.Fatalf("unable to decode withdraw call: %v", err)
+			}
+			if got := args.(map[string]interface{})["to"].(ethgo.Address); got != ethgo.HexToAddress(tt.wantTo) {
+				t.Errorf("withdrawn to %s, want %s", got, tt.wantTo)
+			}
+
+			if model.Address.ValueString() != testValidatorAddress {
+				t.Errorf("address = %s, want %s", model.Address, testValidatorAddress)
+			}
+			if model.TxHash.ValueString() != withdrawal.Hash.String() {
+				t.Errorf("tx_hash = %s, want %s", model.TxHash, withdrawal.Hash)
+			}
+			if tt.claim && model.ClaimTxHash.ValueString() != node.sent[0].Hash.String() {
+				t.Errorf("claim_tx_hash = %s, want %s", model.ClaimTxHash, node.sent[0].Hash)
+			}
+			if !tt.claim && !model.ClaimTxHash.IsNull() {
+				t.Errorf("claim_tx_hash = %s without claim, want null", model.ClaimTxHash)
+			}
+			if model.Amount.ValueString() != "1000" {
+				t.Errorf("amount = %s, want the withdrawn 1000", model.Amount)
+			}
+		})
+	}
+}
+
+func TestWithdrawRewardsResourceWithoutWithdrawalEvent(t *testing.T) {
+	node, url := newMockNode(t)
+
+	state, diags := createResource(t, NewWithdrawRewardsResource(), map[string]tftypes.Value{
+		"rpc_url":               tftypes.NewValue(tftypes.String, url),
+		"validator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+		"claim":                 tftypes.NewValue(tftypes.Bool, false),
+	})
+	if !diags.HasError() {
+		t.Fatal("a withdrawal without Withdrawal event succeeded")
+	}
+	// The withdrawal is kept in state, it was sent.
+	var model withdrawRewardsResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if model.TxHash.ValueString() != node.sent[0].Hash.String() || !model.Amount.IsNull() {
+		t.Errorf("tx_hash, amount = %s, %s, want %s, null", model.TxHash, model.Amount, node.sent[0].Hash)
+	}
+}
+
+func TestWithdrawRewardsResourceChainIDMismatch(t *testing.T) {
+	node, url := newMockNode(t)
+	node.chainID = 1
+
+	_, diags := createResource(t, NewWithdrawRewardsResource(), map[string]tftypes.Value{
+		"rpc_url":               tftypes.NewValue(tftypes.String, url),
+		"validator_key_encoded": tftypes.NewValue(tftypes.String, testValidatorKey),
+		"claim":                 tftypes.NewValue(tftypes.Bool, true),
+		"chain_id":              tftypes.NewValue(tftypes.Number, 100),
+	})
+	if !diags.HasError() {
+		t.Fatal("withdrawing on another chain succeeded")
+	}
+	if len(node.sent) != 0 {
+		t.Errorf("sent %d transactions to another chain", len(node.sent))
+	}
+}
@@ -2,17 +2,44 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/allowlist"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/chain"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/contract"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/functions"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/fund"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/genesis"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/polybft"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
 	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/secrets"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/server"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/sign"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
 )
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &polygonEdgeProvider{}
+	_ provider.Provider                       = &polygonEdgeProvider{}
+	_ provider.ProviderWithEphemeralResources = &polygonEdgeProvider{}
+	_ provider.ProviderWithFunctions          = &polygonEdgeProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -23,6 +50,25 @@ func New() provider.Provider {
 // polygonEdgeProvider is the provider implementation.
 type polygonEdgeProvider struct{}
 
+// polygonEdgeProviderModel maps the provider schema data.
+type polygonEdgeProviderModel struct {
+	RPCTimeout types.String `tfsdk:"rpc_timeout"`
+	RPCRetries types.Int64  `tfsdk:"rpc_retries"`
+
+	RPCBasicAuth   types.Object `tfsdk:"rpc_basic_auth"`
+	RPCBearerToken types.String `tfsdk:"rpc_bearer_token"`
+
+	ChainID types.Int64 `tfsdk:"chain_id"`
+
+	AddressFormat types.String `tfsdk:"address_format"`
+}
+
+// rpcBasicAuthModel maps the `rpc_basic_auth` attribute.
+type rpcBasicAuthModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
 // Metadata returns the provider type name.
 func (p *polygonEdgeProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "polygonedge"
@@ -30,20 +76,226 @@ func (p *polygonEdgeProvider) Metadata(_ context.Context, _ provider.MetadataReq
 
 // Schema defines the provider-level schema for configuration data.
 func (p *polygonEdgeProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rpc_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout of a single JSON-RPC request, as a Go duration. Defaults to `30s`.",
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"rpc_retries": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("Number of times a failed JSON-RPC read is retried, with exponential backoff, at most %d. ", rpc.MaxRetries) +
+					"Only network errors, `429` and `5xx` responses are retried, transactions never are. Defaults to 3.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, rpc.MaxRetries),
+				},
+			},
+			"rpc_basic_auth": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "HTTP basic auth credentials sent with every JSON-RPC request. Conflicts with `rpc_bearer_token`.",
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("rpc_bearer_token")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Required:    true,
+						Description: "Basic auth username.",
+					},
+					"password": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "Basic auth password.",
+					},
+				},
+			},
+			"rpc_bearer_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Bearer token sent in the `Authorization` header of every JSON-RPC request. Conflicts with `rpc_basic_auth`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("rpc_basic_auth")),
+				},
+			},
+			"chain_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "Chain id of the network. Used as the expected chain id of RPC endpoints, " +
+					"and as the default of the resources and data sources `chain_id` attribute.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"address_format": schema.StringAttribute{
+				Optional: true,
+				Description: "Format of the addresses computed by the resources and data sources, `" + providerdata.AddressFormatChecksum +
+					"` for EIP-55 checksummed addresses or `" + providerdata.AddressFormatLowercase + "` for lowercase ones. " +
+					"If not set, addresses are checksummed, except log addresses and the input of `polygonedge_secrets_output`, which are kept as given. " +
+					"`polygonedge_secrets` reformats its `address` on the next apply when the format changes, the other resources " +
+					"keep the format they were created with until they are replaced. Provider functions cannot read the " +
+					"provider configuration and always return checksummed addresses; use `lower` on their results.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(providerdata.AddressFormatChecksum, providerdata.AddressFormatLowercase),
+				},
+			},
+		},
+	}
 }
 
 // Configure prepares common configs for data sources and resources.
 func (p *polygonEdgeProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config polygonEdgeProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := providerdata.Default()
+	options := &data.RPC
+	if !config.RPCTimeout.IsNull() && !config.RPCTimeout.IsUnknown() {
+		timeout, err := time.ParseDuration(config.RPCTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rpc_timeout"), "Invalid RPC timeout", err.Error())
+			return
+		}
+		options.Timeout = timeout
+	}
+	if !config.RPCRetries.IsNull() && !config.RPCRetries.IsUnknown() {
+		options.Retries = int(config.RPCRetries.ValueInt64())
+	}
+	resp.Diagnostics.Append(configureAuth(ctx, config, &options.Auth)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.ChainID.IsNull() && !config.ChainID.IsUnknown() {
+		data.ChainID = uint64(config.ChainID.ValueInt64())
+	}
+	data.AddressFormat = config.AddressFormat.ValueString()
+	data.AddressFormatUnknown = config.AddressFormat.IsUnknown()
+
+	resp.DataSourceData = data
+	resp.ResourceData = data
+	resp.EphemeralResourceData = data
+}
+
+// configureAuth sets the JSON-RPC credentials. Credentials which are not known yet, such as during a plan which
+// creates them, are left out with a warning, the requests made until then are sent without them.
+func configureAuth(ctx context.Context, config polygonEdgeProviderModel, auth *rpc.Auth) diag.Diagnostics {
+	var diags diag.Diagnostics
+	unknown := config.RPCBasicAuth.IsUnknown() || config.RPCBearerToken.IsUnknown()
+	if !config.RPCBasicAuth.IsNull() && !config.RPCBasicAuth.IsUnknown() {
+		var basicAuth rpcBasicAuthModel
+		diags.Append(config.RPCBasicAuth.As(ctx, &basicAuth, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return diags
+		}
+		unknown = unknown || basicAuth.Username.IsUnknown() || basicAuth.Password.IsUnknown()
+		auth.Username = basicAuth.Username.ValueString()
+		auth.Password = basicAuth.Password.ValueString()
+	}
+	auth.BearerToken = config.RPCBearerToken.ValueString()
+
+	if unknown {
+		*auth = rpc.Auth{}
+		diags.AddWarning(
+			"Unknown RPC credentials",
+			"The rpc_basic_auth or rpc_bearer_token values are not known yet. "+
+				"JSON-RPC requests made before they are known are sent without credentials.",
+		)
+	}
+	return diags
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *polygonEdgeProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		chain.NewABIDecodeDataSource,
+		chain.NewBalancesDataSource,
+		chain.NewCallDataSource,
+		chain.NewChainIDFromNameDataSource,
+		chain.NewCodeAtDataSource,
+		chain.NewEstimateGasDataSource,
+		chain.NewGasPriceDataSource,
+		chain.NewLogsDataSource,
+		chain.NewNetVersionDataSource,
+		chain.NewNewHeadsDataSource,
+		chain.NewNodeHealthDataSource,
+		chain.NewNodeInfoDataSource,
+		chain.NewNodeIdentityDataSource,
+		chain.NewNonceDataSource,
+		chain.NewPeerCountDataSource,
+		chain.NewStakingValidatorsDataSource,
+		chain.NewStorageAtDataSource,
+		chain.NewSyncingDataSource,
+		genesis.NewGenesisDiffDataSource,
+		genesis.NewPolyBFTValidatorSetHashDataSource,
+		genesis.NewValidatorSetCommitmentDataSource,
+		polybft.NewDelegationDataSource,
+		polybft.NewRegistrationDataSource,
+		polybft.NewVerifyPopDataSource,
+		polybft.NewValidatorMatchesDataSource,
+		secrets.NewSecretsDataSource,
+		secrets.NewParseSecretsDataSource,
+		secrets.NewSecretsEnvDataSource,
+		secrets.NewSecretsOutputDataSource,
+		secrets.NewDecodeValidatorKeyDataSource,
+		secrets.NewDecodeNetworkKeyDataSource,
+		secrets.NewDecodeBLSKeyDataSource,
+		secrets.NewBLSFromHexDataSource,
+		secrets.NewECDSAFromHexDataSource,
+		secrets.NewValidateKeypairDataSource,
+		server.NewServerConfigDataSource,
+		server.NewBootnodeReachableDataSource,
+		server.NewBootnodesDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *polygonEdgeProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		secrets.NewSecretsResource,
+		secrets.NewSecretsRotationResource,
+		allowlist.NewDeployerAllowlistEntryResource,
+		contract.NewContractResource,
+		fund.NewFundResource,
+		polybft.NewDelegateResource,
+		polybft.NewUndelegateResource,
+		polybft.NewValidatorRegistrationResource,
+		polybft.NewValidatorCommissionResource,
+		polybft.NewWithdrawRewardsResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *polygonEdgeProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		sign.NewSignEphemeralResource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *polygonEdgeProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewAddressFromMnemonicFunction,
+		functions.NewAddressMatchesPubkeyFunction,
+		functions.NewAddressesEqualFunction,
+		functions.NewEIP712SignFunction,
+		functions.NewHexDecodeFunction,
+		functions.NewHexEncodeFunction,
+		functions.NewHexPadFunction,
+		functions.NewJoinSignatureFunction,
+		functions.NewABIEncodeHashFunction,
+		functions.NewBLSAggregateFunction,
+		functions.NewBLSSignFunction,
+		functions.NewBLSVerifyFunction,
+		functions.NewBuildAllocFunction,
+		functions.NewBuildMultiaddrFunction,
+		functions.NewConvertUnitsFunction,
+		functions.NewDeriveIdentityFunction,
+		functions.NewFunctionSignatureHashFunction,
+		functions.NewMethodSelectorFunction,
+		functions.NewRecoverPubkeyFunction,
+		functions.NewSplitSignatureFunction,
 	}
 }
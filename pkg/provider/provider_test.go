@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// configure configures the provider with the given attributes, the others null, and returns the shared data.
+func configure(t *testing.T, attrs map[string]tftypes.Value) (providerdata.Data, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	p := New()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, value := range attrs {
+		values[name] = value
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		return providerdata.Data{}, resp.Diagnostics
+	}
+	return resp.ResourceData.(providerdata.Data), resp.Diagnostics
+}
+
+func TestConfigureRPCOptions(t *testing.T) {
+	data, diags := configure(t, map[string]tftypes.Value{
+		"rpc_timeout": tftypes.NewValue(tftypes.String, "5s"),
+		"rpc_retries": tftypes.NewValue(tftypes.Number, 0),
+	})
+	if diags.HasError() {
+		t.Fatalf("Configure() diagnostics: %v", diags)
+	}
+	if data.RPC.Timeout.String() != "5s" || data.RPC.Retries != 0 {
+		t.Errorf("Configure() RPC options = %+v, want a 5s timeout and no retries", data.RPC)
+	}
+}
+
+func TestConfigureUnknownRPCOptions(t *testing.T) {
+	data, diags := configure(t, map[string]tftypes.Value{
+		"rpc_timeout": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"rpc_retries": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+	})
+	if diags.HasError() {
+		t.Fatalf("Configure() diagnostics: %v", diags)
+	}
+	if data.RPC != rpc.DefaultOptions() {
+		t.Errorf("Configure() RPC options = %+v, want the defaults %+v", data.RPC, rpc.DefaultOptions())
+	}
+}
+
+var basicAuthType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"username": tftypes.String,
+	"password": tftypes.String,
+}}
+
+// authorization returns the Authorization header sent by a JSON-RPC client built from the provider data.
+func authorization(t *testing.T, data providerdata.Data) string {
+	t.Helper()
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x64"}`))
+	}))
+	defer server.Close()
+
+	if err := rpc.NewClient(server.URL, data.RPC).Call(context.Background(), "eth_chainId", nil); err != nil {
+		t.Fatalf("Call() error = %v", err)
+	}
+	return header
+}
+
+func TestConfigureAuth(t *testing.T) {
+	tests := []struct {
+		name  string
+		attrs map[string]tftypes.Value
+		want  string
+	}{
+		{
+			name:  "bearer token",
+			attrs: map[string]tftypes.Value{"rpc_bearer_token": tftypes.NewValue(tftypes.String, "secret")},
+			want:  "Bearer secret",
+		},
+		{
+			name: "basic auth",
+			attrs: map[string]tftypes.Value{"rpc_basic_auth": tftypes.NewValue(basicAuthType, map[string]tftypes.Value{
+				"username": tftypes.NewValue(tftypes.String, "user"),
+				"password": tftypes.NewValue(tftypes.String, "pass"),
+			})},
+			want: "Basic dXNlcjpwYXNz",
+		},
+		{
+			name: "none",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, diags := configure(t, tt.attrs)
+			if diags.HasError() || diags.WarningsCount() > 0 {
+				t.Fatalf("Configure() diagnostics: %v", diags)
+			}
+			if got := authorization(t, data); got != tt.want {
+				t.Errorf("Authorization header = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigureUnknownAuth(t *testing.T) {
+	tests := map[string]map[string]tftypes.Value{
+		"bearer token": {"rpc_bearer_token": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+		"basic auth":   {"rpc_basic_auth": tftypes.NewValue(basicAuthType, tftypes.UnknownValue)},
+		"password": {"rpc_basic_auth": tftypes.NewValue(basicAuthType, map[string]tftypes.Value{
+			"username": tftypes.NewValue(tftypes.String, "user"),
+			"password": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		})},
+	}
+	for name, attrs := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, diags := configure(t, attrs)
+			if diags.HasError() {
+				t.Fatalf("Configure() diagnostics: %v", diags)
+			}
+			if diags.WarningsCount() != 1 {
+				t.Errorf("Configure() diagnostics = %v, want an unknown credentials warning", diags)
+			}
+			if data.RPC.Auth != (rpc.Auth{}) {
+				t.Errorf("Configure() credentials = %+v, want none", data.RPC.Auth)
+			}
+		})
+	}
+}
+
+func TestConfigureChainID(t *testing.T) {
+	tests := []struct {
+		name  string
+		value tftypes.Value
+		want  uint64
+	}{
+		{name: "set", value: tftypes.NewValue(tftypes.Number, 100), want: 100},
+		{name: "null", value: tftypes.NewValue(tftypes.Number, nil)},
+		{name: "unknown", value: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, diags := configure(t, map[string]tftypes.Value{"chain_id": tt.value})
+			if diags.HasError() {
+				t.Fatalf("Configure() diagnostics: %v", diags)
+			}
+			if data.ChainID != tt.want {
+				t.Errorf("Configure() chain id = %d, want %d", data.ChainID, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigureAddressFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       tftypes.Value
+		want        string
+		wantUnknown bool
+	}{
+		{name: "checksum", value: tftypes.NewValue(tftypes.String, providerdata.AddressFormatChecksum), want: providerdata.AddressFormatChecksum},
+		{name: "lowercase", value: tftypes.NewValue(tftypes.String, providerdata.AddressFormatLowercase), want: providerdata.AddressFormatLowercase},
+		{name: "null", value: tftypes.NewValue(tftypes.String, nil)},
+		{name: "unknown", value: tftypes.NewValue(tftypes.String, tftypes.UnknownValue), wantUnknown: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, diags := configure(t, map[string]tftypes.Value{"address_format": tt.value})
+			if diags.HasError() {
+				t.Fatalf("Configure() diagnostics: %v", diags)
+			}
+			if data.AddressFormat != tt.want || data.AddressFormatUnknown != tt.wantUnknown {
+				t.Errorf("Configure() address format = %q, unknown %t, want %q, unknown %t",
+					data.AddressFormat, data.AddressFormatUnknown, tt.want, tt.wantUnknown)
+			}
+		})
+	}
+}
+
+func TestConfigureSharesData(t *testing.T) {
+	ctx := context.Background()
+	p := New()
+	data, diags := configure(t, nil)
+	if diags.HasError() {
+		t.Fatalf("Configure() diagnostics: %v", diags)
+	}
+
+	// Every resource and data source accepts the shared data.
+	for _, newResource := range p.Resources(ctx) {
+		r, ok := newResource().(resource.ResourceWithConfigure)
+		if !ok {
+			continue
+		}
+		configureResp := &resource.ConfigureResponse{}
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: data}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			t.Errorf("%T.Configure() diagnostics: %v", r, configureResp.Diagnostics)
+		}
+	}
+	for _, newDataSource := range p.DataSources(ctx) {
+		d, ok := newDataSource().(datasource.DataSourceWithConfigure)
+		if !ok {
+			continue
+		}
+		configureResp := &datasource.ConfigureResponse{}
+		d.Configure(ctx, datasource.ConfigureRequest{ProviderData: data}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			t.Errorf("%T.Configure() diagnostics: %v", d, configureResp.Diagnostics)
+		}
+	}
+}
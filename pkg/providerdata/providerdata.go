@@ -0,0 +1,78 @@
+package providerdata
+
+import (
+	"strings"
+
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/rpc"
+)
+
+// Formats of the addresses emitted by the provider, selected with the provider `address_format`.
+const (
+	AddressFormatChecksum  = "checksum"
+	AddressFormatLowercase = "lowercase"
+)
+
+// Data is the provider configuration shared with resources and data sources through their Configure method.
+type Data struct {
+	// RPC holds the options of the JSON-RPC clients.
+	RPC rpc.Options
+
+	// ChainID is the chain id of the network, zero if not configured.
+	ChainID uint64
+
+	// AddressFormat is the format of the emitted addresses, empty if not configured.
+	AddressFormat string
+
+	// AddressFormatUnknown is set when the configured address format is not known yet, such as during a plan
+	// which computes it. AddressFormat is then empty.
+	AddressFormatUnknown bool
+}
+
+// Default returns the data used when the provider has not been configured.
+func Default() Data {
+	return Data{
+		RPC: rpc.DefaultOptions(),
+	}
+}
+
+// ChainIDOrDefault returns the chain id set on a resource, falling back to the provider chain id when unset.
+// Zero is returned when neither is set.
+func (d Data) ChainIDOrDefault(chainID types.Int64) uint64 {
+	if chainID.IsNull() || chainID.IsUnknown() {
+		return d.ChainID
+	}
+	return uint64(chainID.ValueInt64())
+}
+
+// FormatAddress returns the address in the configured format. Addresses are checksummed unless configured otherwise.
+func (d Data) FormatAddress(address edgetypes.Address) string {
+	if d.AddressFormat == AddressFormatLowercase {
+		return strings.ToLower(address.String())
+	}
+	return address.String()
+}
+
+// FormatAddressString returns an address read as text, such as from a JSON-RPC result or a genesis file, in the
+// configured format. It is returned as is if no format is configured, or if it is not a hex address.
+func (d Data) FormatAddressString(address string) string {
+	if d.AddressFormat == "" || !isHexAddress(address) {
+		return address
+	}
+	return d.FormatAddress(edgetypes.StringToAddress(address))
+}
+
+// isHexAddress reports whether the text is a 0x prefixed, 20 byte hex address.
+func isHexAddress(address string) bool {
+	if len(address) != 42 || !strings.HasPrefix(address, "0x") {
+		return false
+	}
+	for _, c := range address[2:] {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,34 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+)
+
+// methodNotFoundCode is the JSON-RPC error code of a method the endpoint does not serve.
+const methodNotFoundCode = -32601
+
+// NodeInfo is the identity a node reports about itself with admin_nodeInfo.
+type NodeInfo struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Enode       string   `json:"enode"`
+	ListenAddr  string   `json:"listenAddr"`
+	ListenAddrs []string `json:"listenAddrs"`
+}
+
+// NodeInfo returns the identity of the node, from the admin namespace.
+func (c *Client) NodeInfo(ctx context.Context) (*NodeInfo, error) {
+	var res NodeInfo
+	if err := c.Call(ctx, "admin_nodeInfo", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// IsMethodNotFound reports whether the error is the endpoint refusing a method it does not serve,
+// like the methods of a namespace which is not exposed.
+func IsMethodNotFound(err error) bool {
+	var rpcErr *Error
+	return errors.As(err, &rpcErr) && rpcErr.Code == methodNotFoundCode
+}
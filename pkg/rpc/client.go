@@ -0,0 +1,231 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultTimeout is the default time allowed for a single JSON-RPC request.
+	DefaultTimeout = 30 * time.Second
+	// DefaultRetries is the default number of times a failed read is retried.
+	DefaultRetries = 3
+	// MaxRetries is the largest supported number of retries, bounding the backoff delay.
+	MaxRetries = 10
+
+	retryBaseDelay = 250 * time.Millisecond
+)
+
+// writeMethods are the JSON-RPC methods which are not safe to retry, as a retry may submit twice.
+var writeMethods = map[string]bool{
+	"eth_sendRawTransaction": true,
+	"eth_sendTransaction":    true,
+}
+
+// Options configures the clients built by the provider.
+type Options struct {
+	// Timeout bounds a single request attempt.
+	Timeout time.Duration
+	// Retries is the number of additional attempts made for failed idempotent requests.
+	Retries int
+	// Auth holds the credentials sent with every request.
+	Auth Auth
+}
+
+// Auth holds the credentials used to authenticate against a JSON-RPC endpoint,
+// typically an authenticating proxy in front of the node.
+type Auth struct {
+	Username    string
+	Password    string
+	BearerToken string
+}
+
+// apply sets the authorization header on the request, if any credentials are configured.
+func (a Auth) apply(header http.Header) {
+	switch {
+	case a.BearerToken != "":
+		header.Set("Authorization", "Bearer "+a.BearerToken)
+	case a.Username != "" || a.Password != "":
+		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(a.Username+":"+a.Password)))
+	}
+}
+
+// DefaultOptions returns the options used when the provider does not configure any.
+func DefaultOptions() Options {
+	return Options{
+		Timeout: DefaultTimeout,
+		Retries: DefaultRetries,
+	}
+}
+
+// Client is a minimal JSON-RPC client for talking to a polygon-edge node.
+type Client struct {
+	url        string
+	httpClient *http.Client
+	options    Options
+	nextID     uint64
+}
+
+// NewClient is a helper function to create a client for the given JSON-RPC endpoint.
+func NewClient(url string, options Options) *Client {
+	return &Client{
+		url:        url,
+		httpClient: http.DefaultClient,
+		options:    options,
+	}
+}
+
+// request is a JSON-RPC 2.0 request.
+type request struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+// response is a JSON-RPC 2.0 response.
+type response struct {
+	ID     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *Error          `json:"error"`
+}
+
+// Error is an error returned by the JSON-RPC endpoint.
+type Error struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func (e *Error) Error() string {
+	if len(e.Data) > 0 {
+		return fmt.Sprintf("json-rpc error %d: %s (%s)", e.Code, e.Message, e.Data)
+	}
+	return fmt.Sprintf("json-rpc error %d: %s", e.Code, e.Message)
+}
+
+// transportError marks failures of the transport rather than of the JSON-RPC method itself, such as network
+// errors, rate limiting and server errors. Only these are worth retrying.
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string { return e.err.Error() }
+
+func (e *transportError) Unwrap() error { return e.err }
+
+// Call invokes the given JSON-RPC method and decodes the result into out.
+// If out is nil the result is discarded. Transport failures of read methods are retried
+// with exponential backoff, writes are attempted only once.
+func (c *Client) Call(ctx context.Context, method string, out interface{}, params ...interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	retries := c.options.Retries
+	if writeMethods[method] {
+		retries = 0
+	}
+	if retries > MaxRetries {
+		retries = MaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := c.call(ctx, method, out, params)
+
+		var tErr *transportError
+		if err == nil || !errors.As(err, &tErr) {
+			return err
+		}
+		if attempt >= retries {
+			if retries > 0 {
+				return fmt.Errorf("%w (gave up after %d attempts)", err, attempt+1)
+			}
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(retryBaseDelay << attempt):
+		}
+	}
+}
+
+// call performs a single attempt of a JSON-RPC request and logs it.
+func (c *Client) call(ctx context.Context, method string, out interface{}, params []interface{}) error {
+	id := atomic.AddUint64(&c.nextID, 1)
+	logRequest(ctx, id, method, params)
+	start := time.Now()
+	err := c.do(ctx, id, method, out, params)
+	logResponse(ctx, id, method, start, err)
+	return err
+}
+
+// do sends a JSON-RPC request and decodes its result into out.
+func (c *Client) do(ctx context.Context, id uint64, method string, out interface{}, params []interface{}) error {
+	if c.options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.options.Timeout)
+		defer cancel()
+	}
+
+	body, err := json.Marshal(&request{
+		JSONRPC: "2.0",
+		ID:      id,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to encode %s request: %w", method, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	c.options.Auth.apply(req.Header)
+
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return &transportError{fmt.Errorf("%s request failed: %w", method, err)}
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return &transportError{fmt.Errorf("unable to read %s response: %w", method, err)}
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("%s request failed with status %s: %s", method, httpResp.Status, respBody)
+		if httpResp.StatusCode == http.StatusTooManyRequests || httpResp.StatusCode >= http.StatusInternalServerError {
+			return &transportError{err}
+		}
+		return err
+	}
+
+	var resp response
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return fmt.Errorf("unable to decode %s response: %w", method, err)
+	}
+	if resp.Error != nil {
+		return resp.Error
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(resp.Result, out); err != nil {
+		return fmt.Errorf("unable to decode %s result: %w", method, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+// failingServer answers the first failures requests with the status, and the others with a chain id.
+func failingServer(t *testing.T, status int, failures int32) (*int32, string) {
+	t.Helper()
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= failures {
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x64"}`))
+	}))
+	t.Cleanup(server.Close)
+	return &calls, server.URL
+}
+
+func TestCallRetries(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		failures  int32
+		wantCalls int32
+		wantErr   bool
+	}{
+		{name: "server error", status: http.StatusServiceUnavailable, failures: 1, wantCalls: 2},
+		{name: "rate limited", status: http.StatusTooManyRequests, failures: 1, wantCalls: 2},
+		{name: "gives up", status: http.StatusBadGateway, failures: 5, wantCalls: 2, wantErr: true},
+		{name: "client error", status: http.StatusBadRequest, failures: 1, wantCalls: 1, wantErr: true},
+		{name: "unauthorized", status: http.StatusUnauthorized, failures: 1, wantCalls: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls, url := failingServer(t, tt.status, tt.failures)
+			client := NewClient(url, Options{Retries: 1})
+
+			var chainID string
+			err := client.Call(context.Background(), "eth_chainId", &chainID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Call() error = %v, want error %t", err, tt.wantErr)
+			}
+			if got := atomic.LoadInt32(calls); got != tt.wantCalls {
+				t.Errorf("Call() made %d requests, want %d", got, tt.wantCalls)
+			}
+			if !tt.wantErr && chainID != "0x64" {
+				t.Errorf("Call() result = %q, want %q", chainID, "0x64")
+			}
+		})
+	}
+}
+
+func TestCallDoesNotRetryWrites(t *testing.T) {
+	calls, url := failingServer(t, http.StatusServiceUnavailable, 1)
+	client := NewClient(url, Options{Retries: 3})
+
+	if err := client.Call(context.Background(), "eth_sendRawTransaction", nil, "0x00"); err == nil {
+		t.Fatal("Call() succeeded, want the server error")
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("Call() made %d requests, want 1", got)
+	}
+}
+
+func TestCallRedactsLogs(t *testing.T) {
+	const signedTx = "0xf86c808504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a0"
+	_, url := failingServer(t, http.StatusOK, 0)
+	client := NewClient(url, Options{})
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	if err := client.Call(ctx, "eth_sendRawTransaction", nil, signedTx); err != nil {
+		t.Fatalf("Call() error = %v", err)
+	}
+	if err := client.Call(ctx, "eth_getBalance", nil, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "latest"); err != nil {
+		t.Fatalf("Call() error = %v", err)
+	}
+
+	if strings.Contains(output.String(), strings.TrimPrefix(signedTx, "0x")) {
+		t.Errorf("the signed transaction was logged: %s", output.String())
+	}
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode logs: %v", err)
+	}
+	params := map[string]string{}
+	for _, entry := range entries {
+		if entry["@message"] == "Sending JSON-RPC request" {
+			params[fmt.Sprint(entry["rpc_method"])] = fmt.Sprint(entry["rpc_params"])
+		}
+		if entry["@level"] != "debug" {
+			t.Errorf("logged %q at %s, want debug", entry["@message"], entry["@level"])
+		}
+	}
+	if got, want := params["eth_sendRawTransaction"], "["+redacted+"]"; got != want {
+		t.Errorf("logged eth_sendRawTransaction params %s, want %s", got, want)
+	}
+	if got, want := params["eth_getBalance"], "[0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266 latest]"; got != want {
+		t.Errorf("logged eth_getBalance params %s, want the params as sent %s", got, want)
+	}
+}
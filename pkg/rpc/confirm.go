@@ -0,0 +1,165 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+const (
+	defaultConfirmationTimeout  = 2 * time.Minute
+	defaultConfirmationInterval = time.Second
+)
+
+// ConfirmationConfig controls how transactions are awaited after being submitted.
+type ConfirmationConfig struct {
+	// Timeout bounds the total time spent waiting for a single transaction.
+	Timeout time.Duration
+	// PollInterval is the delay between two receipt lookups.
+	PollInterval time.Duration
+	// Confirmations is the number of blocks, including the one holding the transaction, to wait for.
+	Confirmations uint64
+}
+
+// ConfirmationModel maps the `confirmation` attribute shared by the write resources.
+type ConfirmationModel struct {
+	Timeout       tftypes.String `tfsdk:"timeout"`
+	PollInterval  tftypes.String `tfsdk:"poll_interval"`
+	Confirmations tftypes.Int64  `tfsdk:"confirmations"`
+}
+
+// ConfirmationSchema returns the `confirmation` attribute shared by the write resources.
+func ConfirmationSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Controls how submitted transactions are awaited.",
+		Attributes: map[string]schema.Attribute{
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for each transaction, as a Go duration. Defaults to `2m`.",
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"poll_interval": schema.StringAttribute{
+				Optional:    true,
+				Description: "Time between two receipt lookups, as a Go duration. Defaults to `1s`.",
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"confirmations": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of blocks, including the one holding the transaction, to wait for. Defaults to 1.",
+			},
+		},
+	}
+}
+
+// Config converts the model into a ConfirmationConfig, filling in defaults for unset values.
+// A nil model yields the default configuration.
+func (m *ConfirmationModel) Config(attrPath path.Path) (ConfirmationConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	cfg := ConfirmationConfig{
+		Timeout:       defaultConfirmationTimeout,
+		PollInterval:  defaultConfirmationInterval,
+		Confirmations: 1,
+	}
+	if m == nil {
+		return cfg, diags
+	}
+
+	if !m.Timeout.IsNull() {
+		timeout, err := time.ParseDuration(m.Timeout.ValueString())
+		if err != nil {
+			diags.AddAttributeError(attrPath.AtName("timeout"), "Invalid timeout", err.Error())
+		}
+		cfg.Timeout = timeout
+	}
+	if !m.PollInterval.IsNull() {
+		interval, err := time.ParseDuration(m.PollInterval.ValueString())
+		if err != nil {
+			diags.AddAttributeError(attrPath.AtName("poll_interval"), "Invalid poll interval", err.Error())
+		}
+		cfg.PollInterval = interval
+	}
+	if !m.Confirmations.IsNull() {
+		if m.Confirmations.ValueInt64() < 1 {
+			diags.AddAttributeError(attrPath.AtName("confirmations"), "Invalid confirmations", "At least one confirmation is required.")
+		}
+		cfg.Confirmations = uint64(m.Confirmations.ValueInt64())
+	}
+
+	return cfg, diags
+}
+
+// PendingError is returned when a transaction is not confirmed in time.
+type PendingError struct {
+	Hash    types.Hash
+	Timeout time.Duration
+}
+
+func (e *PendingError) Error() string {
+	return fmt.Sprintf("transaction %s was not confirmed within %s, it may still be pending", e.Hash, e.Timeout)
+}
+
+// WaitForConfirmation polls the endpoint until the transaction is included and has the configured
+// number of confirmations, returning a PendingError on timeout.
+func (c *Client) WaitForConfirmation(ctx context.Context, hash types.Hash, cfg ConfirmationConfig) (*Receipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := c.confirmedReceipt(ctx, hash, cfg.Confirmations)
+		if err != nil && ctx.Err() == nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, &PendingError{Hash: hash, Timeout: cfg.Timeout}
+		case <-ticker.C:
+		}
+	}
+}
+
+// confirmedReceipt returns the receipt of the transaction once it has enough confirmations, nil otherwise.
+func (c *Client) confirmedReceipt(ctx context.Context, hash types.Hash, confirmations uint64) (*Receipt, error) {
+	receipt, err := c.TransactionReceipt(ctx, hash)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	if confirmations <= 1 {
+		return receipt, nil
+	}
+
+	included, err := hex.DecodeUint64(receipt.BlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("invalid receipt block number %q: %w", receipt.BlockNumber, err)
+	}
+	latest, err := c.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if latest+1 < included+confirmations {
+		return nil, nil
+	}
+
+	return receipt, nil
+}
@@ -0,0 +1,102 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// slowInclusionServer answers receipt lookups with null until the given number of lookups was made,
+// then with a receipt included in block 1. The latest block number grows by one on every lookup of it.
+func slowInclusionServer(t *testing.T, pendingLookups int32) (*int32, string) {
+	t.Helper()
+	var lookups, blocks int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     uint64 `json:"id"`
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var result interface{}
+		switch req.Method {
+		case "eth_getTransactionReceipt":
+			if atomic.AddInt32(&lookups, 1) > pendingLookups {
+				result = map[string]string{"transactionHash": "0x01", "blockNumber": "0x1", "status": "0x1"}
+			}
+		case "eth_blockNumber":
+			result = fmt.Sprintf("0x%x", atomic.AddInt32(&blocks, 1))
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	t.Cleanup(server.Close)
+	return &lookups, server.URL
+}
+
+func TestWaitForConfirmationSlowInclusion(t *testing.T) {
+	lookups, url := slowInclusionServer(t, 3)
+	client := NewClient(url, DefaultOptions())
+
+	receipt, err := client.WaitForConfirmation(context.Background(), types.StringToHash("0x01"), ConfirmationConfig{
+		Timeout:       5 * time.Second,
+		PollInterval:  10 * time.Millisecond,
+		Confirmations: 1,
+	})
+	if err != nil {
+		t.Fatalf("WaitForConfirmation() error = %v", err)
+	}
+	if !receipt.Succeeded() {
+		t.Errorf("receipt status = %s, want success", receipt.Status)
+	}
+	if got := atomic.LoadInt32(lookups); got != 4 {
+		t.Errorf("WaitForConfirmation() looked the receipt up %d times, want 4", got)
+	}
+}
+
+func TestWaitForConfirmationConfirmations(t *testing.T) {
+	lookups, url := slowInclusionServer(t, 0)
+	client := NewClient(url, DefaultOptions())
+
+	// The transaction is in block 1, the third confirmation is block 3.
+	if _, err := client.WaitForConfirmation(context.Background(), types.StringToHash("0x01"), ConfirmationConfig{
+		Timeout:       5 * time.Second,
+		PollInterval:  10 * time.Millisecond,
+		Confirmations: 3,
+	}); err != nil {
+		t.Fatalf("WaitForConfirmation() error = %v", err)
+	}
+	if got := atomic.LoadInt32(lookups); got != 3 {
+		t.Errorf("WaitForConfirmation() looked the receipt up %d times, want 3", got)
+	}
+}
+
+func TestWaitForConfirmationTimeout(t *testing.T) {
+	_, url := slowInclusionServer(t, 1<<30)
+	client := NewClient(url, DefaultOptions())
+	hash := types.StringToHash("0x5e1d")
+
+	_, err := client.WaitForConfirmation(context.Background(), hash, ConfirmationConfig{
+		Timeout:       50 * time.Millisecond,
+		PollInterval:  10 * time.Millisecond,
+		Confirmations: 1,
+	})
+	var pending *PendingError
+	if !errors.As(err, &pending) {
+		t.Fatalf("WaitForConfirmation() error = %v, want a PendingError", err)
+	}
+	if pending.Hash != hash || !strings.Contains(err.Error(), hash.String()) {
+		t.Errorf("WaitForConfirmation() error = %v, want it to name the pending transaction %s", err, hash)
+	}
+}
@@ -0,0 +1,336 @@
+package rpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Receipt is the subset of a transaction receipt used by the provider.
+type Receipt struct {
+	TransactionHash string  `json:"transactionHash"`
+	BlockNumber     string  `json:"blockNumber"`
+	Status          string  `json:"status"`
+	GasUsed         string  `json:"gasUsed"`
+	ContractAddress *string `json:"contractAddress"`
+	Logs            []Log   `json:"logs"`
+}
+
+// Log is a log entry emitted by a transaction, as found in its receipt or returned by eth_getLogs.
+type Log struct {
+	Address         string   `json:"address"`
+	Topics          []string `json:"topics"`
+	Data            string   `json:"data"`
+	BlockNumber     string   `json:"blockNumber"`
+	TransactionHash string   `json:"transactionHash"`
+	LogIndex        string   `json:"logIndex"`
+	Removed         bool     `json:"removed"`
+}
+
+// Succeeded reports whether the receipt status signals a successful execution.
+func (r *Receipt) Succeeded() bool {
+	status, err := hex.DecodeUint64(r.Status)
+	return err == nil && status == 1
+}
+
+// CallMsg is the transaction call object of eth_estimateGas and eth_call.
+type CallMsg struct {
+	From  *types.Address
+	To    *types.Address
+	Value *big.Int
+	Data  []byte
+}
+
+// arg returns the JSON-RPC form of the call object, leaving out unset fields.
+func (m CallMsg) arg() map[string]interface{} {
+	arg := map[string]interface{}{}
+	if m.From != nil {
+		arg["from"] = m.From.String()
+	}
+	if m.To != nil {
+		arg["to"] = m.To.String()
+	}
+	if m.Value != nil {
+		arg["value"] = hex.EncodeBig(m.Value)
+	}
+	if len(m.Data) > 0 {
+		arg["data"] = hex.EncodeToHex(m.Data)
+	}
+	return arg
+}
+
+// FilterQuery is the filter object of eth_getLogs.
+type FilterQuery struct {
+	// FromBlock and ToBlock are block tags or decimal block numbers, empty selects the latest block.
+	FromBlock string
+	ToBlock   string
+	Address   *types.Address
+	// Topics match the topics of a log by position, a nil topic matches any.
+	Topics []*types.Hash
+}
+
+// arg returns the JSON-RPC form of the filter object, leaving out unset fields.
+func (q FilterQuery) arg() map[string]interface{} {
+	arg := map[string]interface{}{
+		"fromBlock": BlockParam(q.FromBlock),
+		"toBlock":   BlockParam(q.ToBlock),
+	}
+	if q.Address != nil {
+		arg["address"] = q.Address.String()
+	}
+	if len(q.Topics) > 0 {
+		topics := make([]interface{}, len(q.Topics))
+		for i, topic := range q.Topics {
+			if topic != nil {
+				topics[i] = topic.String()
+			}
+		}
+		arg["topics"] = topics
+	}
+	return arg
+}
+
+// SyncProgress is the sync status reported by eth_syncing while a node is syncing.
+type SyncProgress struct {
+	StartingBlock uint64
+	CurrentBlock  uint64
+	HighestBlock  uint64
+}
+
+// BlockParam converts a block tag or a decimal block number to the block parameter of the JSON-RPC methods.
+// An empty tag selects the latest block.
+func BlockParam(tag string) string {
+	if tag == "" {
+		return "latest"
+	}
+	if number, err := strconv.ParseUint(tag, 10, 64); err == nil {
+		return hex.EncodeUint64(number)
+	}
+	return tag
+}
+
+// BlockNumber returns the number of the most recent block.
+func (c *Client) BlockNumber(ctx context.Context) (uint64, error) {
+	var res string
+	if err := c.Call(ctx, "eth_blockNumber", &res); err != nil {
+		return 0, err
+	}
+	return hex.DecodeUint64(res)
+}
+
+// ChainID returns the chain id reported by the endpoint.
+func (c *Client) ChainID(ctx context.Context) (uint64, error) {
+	var res string
+	if err := c.Call(ctx, "eth_chainId", &res); err != nil {
+		return 0, err
+	}
+	return hex.DecodeUint64(res)
+}
+
+// Coinbase returns the address the node signs blocks with, which is its validator address.
+func (c *Client) Coinbase(ctx context.Context) (types.Address, error) {
+	var res string
+	if err := c.Call(ctx, "eth_coinbase", &res); err != nil {
+		return types.ZeroAddress, err
+	}
+	raw, err := hex.DecodeHex(res)
+	if err != nil || len(raw) != types.AddressLength {
+		return types.ZeroAddress, fmt.Errorf("invalid eth_coinbase result %q", res)
+	}
+	return types.BytesToAddress(raw), nil
+}
+
+// Syncing returns the sync progress of the node, or nil if it is not syncing.
+func (c *Client) Syncing(ctx context.Context) (*SyncProgress, error) {
+	// The result is false if the node is not syncing, and the progress object otherwise.
+	var res json.RawMessage
+	if err := c.Call(ctx, "eth_syncing", &res); err != nil {
+		return nil, err
+	}
+	var syncing bool
+	if err := json.Unmarshal(res, &syncing); err == nil {
+		if syncing {
+			return nil, fmt.Errorf("unexpected eth_syncing result: true")
+		}
+		return nil, nil
+	}
+
+	var progress struct {
+		StartingBlock string `json:"startingBlock"`
+		CurrentBlock  string `json:"currentBlock"`
+		HighestBlock  string `json:"highestBlock"`
+	}
+	if err := json.Unmarshal(res, &progress); err != nil {
+		return nil, fmt.Errorf("unable to decode eth_syncing result: %w", err)
+	}
+	var sync SyncProgress
+	for _, field := range []struct {
+		name  string
+		value string
+		out   *uint64
+	}{
+		{"startingBlock", progress.StartingBlock, &sync.StartingBlock},
+		{"currentBlock", progress.CurrentBlock, &sync.CurrentBlock},
+		{"highestBlock", progress.HighestBlock, &sync.HighestBlock},
+	} {
+		number, err := hex.DecodeUint64(field.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid eth_syncing %s %q: %w", field.name, field.value, err)
+		}
+		*field.out = number
+	}
+	return &sync, nil
+}
+
+// CallContract executes the call at the given block, without creating a transaction, and returns its result.
+// Use RevertReason to get the reason of a failed call.
+func (c *Client) CallContract(ctx context.Context, msg CallMsg, block string) ([]byte, error) {
+	var res string
+	if err := c.Call(ctx, "eth_call", &res, msg.arg(), BlockParam(block)); err != nil {
+		return nil, err
+	}
+	return hex.DecodeHex(res)
+}
+
+// CodeAt returns the code of the account at the given block.
+func (c *Client) CodeAt(ctx context.Context, address types.Address, block string) ([]byte, error) {
+	var res string
+	if err := c.Call(ctx, "eth_getCode", &res, address.String(), BlockParam(block)); err != nil {
+		return nil, err
+	}
+	return hex.DecodeHex(res)
+}
+
+// StorageAt returns the 32 byte value of the storage slot of the account at the given block.
+func (c *Client) StorageAt(ctx context.Context, address types.Address, slot types.Hash, block string) (types.Hash, error) {
+	var res types.Hash
+	if err := c.Call(ctx, "eth_getStorageAt", &res, address.String(), slot.String(), BlockParam(block)); err != nil {
+		return types.ZeroHash, err
+	}
+	return res, nil
+}
+
+// EstimateGas returns the gas the endpoint estimates the call needs. Use RevertReason to get the reason
+// of a failed estimate.
+func (c *Client) EstimateGas(ctx context.Context, msg CallMsg) (uint64, error) {
+	var res string
+	if err := c.Call(ctx, "eth_estimateGas", &res, msg.arg()); err != nil {
+		return 0, err
+	}
+	return hex.DecodeUint64(res)
+}
+
+// GasPrice returns the gas price suggested by the endpoint.
+func (c *Client) GasPrice(ctx context.Context) (*big.Int, error) {
+	var res string
+	if err := c.Call(ctx, "eth_gasPrice", &res); err != nil {
+		return nil, err
+	}
+	return hex.DecodeHexToBig(res)
+}
+
+// MaxPriorityFeePerGas returns the priority fee per gas suggested by the endpoint for dynamic fee transactions.
+func (c *Client) MaxPriorityFeePerGas(ctx context.Context) (*big.Int, error) {
+	var res string
+	if err := c.Call(ctx, "eth_maxPriorityFeePerGas", &res); err != nil {
+		return nil, err
+	}
+	return hex.DecodeHexToBig(res)
+}
+
+// LatestBaseFee returns the base fee per gas of the most recent block, or nil if the chain has no base fee.
+func (c *Client) LatestBaseFee(ctx context.Context) (*big.Int, error) {
+	var res *struct {
+		BaseFeePerGas *string `json:"baseFeePerGas"`
+	}
+	if err := c.Call(ctx, "eth_getBlockByNumber", &res, "latest", false); err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("latest block not found")
+	}
+	if res.BaseFeePerGas == nil {
+		return nil, nil
+	}
+	return hex.DecodeHexToBig(*res.BaseFeePerGas)
+}
+
+// LatestHeader returns the header of the most recent block.
+func (c *Client) LatestHeader(ctx context.Context) (*Header, error) {
+	var res *Header
+	if err := c.Call(ctx, "eth_getBlockByNumber", &res, "latest", false); err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("latest block not found")
+	}
+	return res, nil
+}
+
+// PendingNonce returns the nonce of the next transaction sent from the given address.
+func (c *Client) PendingNonce(ctx context.Context, address types.Address) (uint64, error) {
+	return c.NonceAt(ctx, address, "pending")
+}
+
+// NonceAt returns the number of transactions sent from the given address at the given block.
+func (c *Client) NonceAt(ctx context.Context, address types.Address, block string) (uint64, error) {
+	var res string
+	if err := c.Call(ctx, "eth_getTransactionCount", &res, address.String(), BlockParam(block)); err != nil {
+		return 0, err
+	}
+	return hex.DecodeUint64(res)
+}
+
+// BalanceAt returns the balance in wei of the given address at the given block.
+func (c *Client) BalanceAt(ctx context.Context, address types.Address, block string) (*big.Int, error) {
+	var res string
+	if err := c.Call(ctx, "eth_getBalance", &res, address.String(), BlockParam(block)); err != nil {
+		return nil, err
+	}
+	return hex.DecodeHexToBig(res)
+}
+
+// Logs returns the logs matching the filter.
+func (c *Client) Logs(ctx context.Context, query FilterQuery) ([]Log, error) {
+	var res []Log
+	if err := c.Call(ctx, "eth_getLogs", &res, query.arg()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SendRawTransaction submits a signed, RLP encoded transaction and returns its hash.
+func (c *Client) SendRawTransaction(ctx context.Context, raw []byte) (types.Hash, error) {
+	var res types.Hash
+	if err := c.Call(ctx, "eth_sendRawTransaction", &res, hex.EncodeToHex(raw)); err != nil {
+		return types.ZeroHash, err
+	}
+	return res, nil
+}
+
+// SignAndSend signs the transaction for the given chain and submits it to the endpoint.
+func (c *Client) SignAndSend(ctx context.Context, key *ecdsa.PrivateKey, chainID uint64, tx *types.Transaction) (types.Hash, error) {
+	signer := crypto.NewEIP155Signer(chain.AllForksEnabled.At(0), chainID)
+	signed, err := signer.SignTx(tx, key)
+	if err != nil {
+		return types.ZeroHash, fmt.Errorf("unable to sign transaction: %w", err)
+	}
+	return c.SendRawTransaction(ctx, signed.MarshalRLP())
+}
+
+// TransactionReceipt returns the receipt of the given transaction, or nil if it is not included yet.
+func (c *Client) TransactionReceipt(ctx context.Context, hash types.Hash) (*Receipt, error) {
+	var res *Receipt
+	if err := c.Call(ctx, "eth_getTransactionReceipt", &res, hash.String()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
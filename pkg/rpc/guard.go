@@ -0,0 +1,30 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+)
+
+// ChainIDMismatchError is returned when the endpoint serves a different chain than expected.
+type ChainIDMismatchError struct {
+	Expected uint64
+	Actual   uint64
+}
+
+func (e *ChainIDMismatchError) Error() string {
+	return fmt.Sprintf("endpoint serves chain id %d, expected chain id %d", e.Actual, e.Expected)
+}
+
+// GuardedChainID returns the chain id of the endpoint, failing with a ChainIDMismatchError if it
+// differs from the expected one. An expected chain id of 0 disables the check.
+func (c *Client) GuardedChainID(ctx context.Context, expected uint64) (uint64, error) {
+	chainID, err := c.ChainID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if expected != 0 && chainID != expected {
+		return 0, &ChainIDMismatchError{Expected: expected, Actual: chainID}
+	}
+
+	return chainID, nil
+}
@@ -0,0 +1,27 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestGuardedChainID(t *testing.T) {
+	// The server serves chain id 100.
+	_, url := failingServer(t, http.StatusOK, 0)
+	client := NewClient(url, DefaultOptions())
+
+	for _, expected := range []uint64{0, 100} {
+		chainID, err := client.GuardedChainID(context.Background(), expected)
+		if err != nil || chainID != 100 {
+			t.Errorf("GuardedChainID(%d) = %d, %v, want 100", expected, chainID, err)
+		}
+	}
+
+	_, err := client.GuardedChainID(context.Background(), 101)
+	var mismatch *ChainIDMismatchError
+	if !errors.As(err, &mismatch) || mismatch.Expected != 101 || mismatch.Actual != 100 {
+		t.Fatalf("GuardedChainID(101) error = %v, want a mismatch of 100 with 101", err)
+	}
+}
@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redacted replaces sensitive params in the logs.
+const redacted = "<redacted>"
+
+// sensitiveParams are the positions of the params which must never be logged, by JSON-RPC method:
+// signed transactions, which may not have been submitted yet, and private keys and passphrases.
+var sensitiveParams = map[string][]int{
+	"eth_sendRawTransaction":   {0},
+	"personal_importRawKey":    {0, 1},
+	"personal_unlockAccount":   {1},
+	"personal_sendTransaction": {1},
+	"personal_signTransaction": {1},
+	"personal_sign":            {2},
+}
+
+// redactParams returns a copy of the params of the method with the sensitive ones replaced.
+func redactParams(method string, params []interface{}) []interface{} {
+	logged := make([]interface{}, len(params))
+	copy(logged, params)
+	for _, i := range sensitiveParams[method] {
+		if i < len(logged) {
+			logged[i] = redacted
+		}
+	}
+	return logged
+}
+
+// logRequest logs a JSON-RPC request attempt at debug level, shown with TF_LOG=DEBUG.
+func logRequest(ctx context.Context, id uint64, method string, params []interface{}) {
+	tflog.Debug(ctx, "Sending JSON-RPC request", map[string]interface{}{
+		"rpc_id":     id,
+		"rpc_method": method,
+		"rpc_params": redactParams(method, params),
+	})
+}
+
+// logResponse logs the outcome of a JSON-RPC request attempt at debug level. Results are not logged,
+// they can be large and are visible in the state.
+func logResponse(ctx context.Context, id uint64, method string, start time.Time, err error) {
+	fields := map[string]interface{}{
+		"rpc_id":       id,
+		"rpc_method":   method,
+		"rpc_duration": time.Since(start).String(),
+	}
+	if err != nil {
+		fields["rpc_error"] = err.Error()
+	}
+	tflog.Debug(ctx, "Received JSON-RPC response", fields)
+}
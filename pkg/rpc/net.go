@@ -0,0 +1,32 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+)
+
+// PeerCount returns the number of peers the node is connected to.
+func (c *Client) PeerCount(ctx context.Context) (uint64, error) {
+	var res string
+	if err := c.Call(ctx, "net_peerCount", &res); err != nil {
+		return 0, err
+	}
+	return hex.DecodeUint64(res)
+}
+
+// NetVersion returns the network id of the node.
+func (c *Client) NetVersion(ctx context.Context) (uint64, error) {
+	var res string
+	if err := c.Call(ctx, "net_version", &res); err != nil {
+		return 0, err
+	}
+	// Unlike the other quantities, the network id is a decimal string.
+	version, err := strconv.ParseUint(res, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid net_version result %q: %w", res, err)
+	}
+	return version, nil
+}
@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/umbracle/ethgo/abi"
+)
+
+var (
+	// errorSelector is the selector of the Error(string) revert data of require and revert.
+	errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	// panicSelector is the selector of the Panic(uint256) revert data of failed assertions.
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+	errorType = abi.MustNewType("tuple(string reason)")
+	panicType = abi.MustNewType("tuple(uint256 code)")
+)
+
+// RevertReason returns the revert reason carried by a JSON-RPC error, decoding the revert data if the node
+// returns it. It returns false if the error does not carry revert data.
+func RevertReason(err error) (string, bool) {
+	var rpcErr *Error
+	if !errors.As(err, &rpcErr) || len(rpcErr.Data) == 0 {
+		return "", false
+	}
+
+	var encoded string
+	if json.Unmarshal(rpcErr.Data, &encoded) != nil {
+		return "", false
+	}
+	data, decodeErr := hex.DecodeHex(encoded)
+	if decodeErr != nil || len(data) < 4 {
+		return "", false
+	}
+
+	switch {
+	case bytes.Equal(data[:4], errorSelector):
+		var decoded struct{ Reason string }
+		if errorType.DecodeStruct(data[4:], &decoded) == nil {
+			return decoded.Reason, true
+		}
+	case bytes.Equal(data[:4], panicSelector):
+		var decoded struct{ Code *big.Int }
+		if panicType.DecodeStruct(data[4:], &decoded) == nil && decoded.Code != nil {
+			return fmt.Sprintf("panic code 0x%x", decoded.Code), true
+		}
+	}
+
+	return "custom error " + hex.EncodeToHex(data[:4]), true
+}
@@ -0,0 +1,89 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Header is the subset of a block header reported by a newHeads subscription.
+type Header struct {
+	Number     string `json:"number"`
+	Hash       string `json:"hash"`
+	ParentHash string `json:"parentHash"`
+	Timestamp  string `json:"timestamp"`
+}
+
+// subscriptionMessage is either the response to eth_subscribe or a subscription notification.
+type subscriptionMessage struct {
+	ID     uint64          `json:"id"`
+	Method string          `json:"method"`
+	Result json.RawMessage `json:"result"`
+	Error  *Error          `json:"error"`
+	Params struct {
+		Subscription string          `json:"subscription"`
+		Result       json.RawMessage `json:"result"`
+	} `json:"params"`
+}
+
+// FirstNewHead subscribes to newHeads over the given WebSocket endpoint and returns the first header
+// received. The subscription is torn down before returning.
+func FirstNewHead(ctx context.Context, url string, options Options) (*Header, error) {
+	header := http.Header{}
+	options.Auth.apply(header)
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, header)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to %s: %w", url, err)
+	}
+	defer conn.Close()
+
+	// Unblock pending reads once the context is done.
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.SetReadDeadline(time.Now())
+		case <-stop:
+		}
+	}()
+
+	if err := conn.WriteJSON(&request{JSONRPC: "2.0", ID: 1, Method: "eth_subscribe", Params: []interface{}{"newHeads"}}); err != nil {
+		return nil, fmt.Errorf("unable to subscribe: %w", err)
+	}
+
+	var subscription string
+	for {
+		var msg subscriptionMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("no new head received: %w", ctx.Err())
+			}
+			return nil, fmt.Errorf("unable to read subscription message: %w", err)
+		}
+
+		switch {
+		case msg.Error != nil:
+			return nil, msg.Error
+		case msg.ID == 1 && subscription == "":
+			if err := json.Unmarshal(msg.Result, &subscription); err != nil {
+				return nil, fmt.Errorf("unable to decode subscription id: %w", err)
+			}
+		case msg.Method == "eth_subscription" && msg.Params.Subscription == subscription:
+			var head Header
+			if err := json.Unmarshal(msg.Params.Result, &head); err != nil {
+				return nil, fmt.Errorf("unable to decode new head: %w", err)
+			}
+
+			_ = conn.WriteJSON(&request{JSONRPC: "2.0", ID: 2, Method: "eth_unsubscribe", Params: []interface{}{subscription}})
+			_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+
+			return &head, nil
+		}
+	}
+}
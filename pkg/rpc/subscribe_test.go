@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// newHeadsServer accepts a newHeads subscription and, if emit is set, notifies one head. It returns the methods
+// called over the connection on the channel once the client disconnects.
+func newHeadsServer(t *testing.T, emit bool) (<-chan []string, string) {
+	t.Helper()
+	methods := make(chan []string, 1)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var called []string
+		defer func() { methods <- called }()
+		for {
+			var req struct {
+				ID     uint64 `json:"id"`
+				Method string `json:"method"`
+			}
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			called = append(called, req.Method)
+			if req.Method != "eth_subscribe" {
+				continue
+			}
+
+			_ = conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": "0x9cef478923ff08bf67fde6c64013158d"})
+			if emit {
+				_ = conn.WriteJSON(map[string]interface{}{
+					"jsonrpc": "2.0",
+					"method":  "eth_subscription",
+					"params": map[string]interface{}{
+						"subscription": "0x9cef478923ff08bf67fde6c64013158d",
+						"result":       json.RawMessage(`{"number":"0x1b4","hash":"0xdc0818cf78f21a8e70579cb46a43643f78291264dda342ae31049421c82d21ae","parentHash":"0x6f3e8a7d1f7b4b0e0e0b7e3a0f3f4e0c2f8f3a6f4c3f1f5d5c1a9b8c7d6e5f4a","timestamp":"0x64"}`),
+					},
+				})
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return methods, "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func TestFirstNewHead(t *testing.T) {
+	methods, url := newHeadsServer(t, true)
+
+	head, err := FirstNewHead(context.Background(), url, DefaultOptions())
+	if err != nil {
+		t.Fatalf("FirstNewHead() error = %v", err)
+	}
+	if head.Number != "0x1b4" || head.Hash != "0xdc0818cf78f21a8e70579cb46a43643f78291264dda342ae31049421c82d21ae" {
+		t.Errorf("FirstNewHead() = %+v, want block 0x1b4", head)
+	}
+
+	// The subscription is torn down before returning.
+	select {
+	case called := <-methods:
+		if strings.Join(called, ",") != "eth_subscribe,eth_unsubscribe" {
+			t.Errorf("called %v, want a subscription followed by an unsubscription", called)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the connection was not closed")
+	}
+}
+
+func TestFirstNewHeadTimeout(t *testing.T) {
+	_, url := newHeadsServer(t, false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := FirstNewHead(ctx, url, DefaultOptions()); err == nil || !strings.Contains(err.Error(), "no new head received") {
+		t.Fatalf("FirstNewHead() error = %v, want no new head received", err)
+	}
+}
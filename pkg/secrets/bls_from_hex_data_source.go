@@ -0,0 +1,116 @@
+package secrets
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	edgehex "github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// blsSecretKeySize is the size of a big endian BLS12-381 secret key.
+const blsSecretKeySize = 32
+
+// blsGroupOrder is the order of the BLS12-381 groups, secret keys are scalars below it.
+var blsGroupOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &blsFromHexDataSource{}
+)
+
+// blsFromHexDataSourceModel maps the data source schema data.
+type blsFromHexDataSourceModel struct {
+	BLSKeyHex types.String `tfsdk:"bls_key_hex"`
+
+	ValidatorBLSKeyEncoded types.String `tfsdk:"validator_bls_key_encoded"`
+	BLSPubkey              types.String `tfsdk:"bls_pubkey"`
+}
+
+// NewBLSFromHexDataSource is a helper function to simplify the provider implementation.
+func NewBLSFromHexDataSource() datasource.DataSource {
+	return &blsFromHexDataSource{}
+}
+
+// blsFromHexDataSource is the data source implementation.
+type blsFromHexDataSource struct{}
+
+// Metadata returns the data source type name.
+func (d *blsFromHexDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bls_from_hex"
+}
+
+// Schema defines the schema for the data source.
+func (d *blsFromHexDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Builds an encoded validator BLS key from a raw hex BLS12-381 secret key, " +
+			"and returns it with its public key, in the same format as the `polygonedge_secrets` resource.",
+		Attributes: map[string]schema.Attribute{
+			"bls_key_hex": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "0x prefixed hex, 32 byte big endian BLS12-381 secret key.",
+				Validators: []validator.String{
+					validators.SensitiveHex(),
+				},
+			},
+			"validator_bls_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded validator BLS key.",
+			},
+			"bls_pubkey": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator BLS public key, hex encoded.",
+			},
+		},
+	}
+}
+
+// Read builds the encoded BLS key.
+func (d *blsFromHexDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state blsFromHexDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	raw, err := edgehex.DecodeHex(state.BLSKeyHex.ValueString())
+	if err != nil || len(raw) != blsSecretKeySize {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bls_key_hex"),
+			"Invalid BLS secret key",
+			fmt.Sprintf("The BLS secret key must be %d bytes, hex encoded.", blsSecretKeySize),
+		)
+		return
+	}
+	if scalar := new(big.Int).SetBytes(raw); scalar.Sign() == 0 || scalar.Cmp(blsGroupOrder) >= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bls_key_hex"),
+			"Invalid BLS secret key",
+			"The BLS secret key must be a scalar greater than zero and lower than the BLS12-381 group order.",
+		)
+		return
+	}
+
+	// polygon-edge encodes BLS keys as the hex of the big endian scalar, without the 0x prefix.
+	encoded := []byte(hex.EncodeToString(raw))
+	blsPubkey, diags := deriveBLSPubkey(encodedKey{value: encoded, attr: path.Root("bls_key_hex")})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ValidatorBLSKeyEncoded = types.StringValue(string(encoded))
+	state.BLSPubkey = types.StringValue(blsPubkey)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,57 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestBLSFromHexDataSource(t *testing.T) {
+	key, err := crypto.GenerateBLSKey()
+	if err != nil {
+		t.Fatalf("unable to generate BLS key: %v", err)
+	}
+	raw, err := key.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unable to marshal BLS key: %v", err)
+	}
+	pubkey, err := crypto.BLSSecretKeyToPubkeyBytes(key)
+	if err != nil {
+		t.Fatalf("unable to derive BLS public key: %v", err)
+	}
+
+	state := readDataSource(t, NewBLSFromHexDataSource(), map[string]tftypes.Value{
+		"bls_key_hex": tftypes.NewValue(tftypes.String, hex.EncodeToHex(raw)),
+	})
+	var model blsFromHexDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if got, want := model.ValidatorBLSKeyEncoded.ValueString(), hex.EncodeToString(raw); got != want {
+		t.Errorf("validator_bls_key_encoded = %s, want %s", got, want)
+	}
+	if got, want := model.BLSPubkey.ValueString(), hex.EncodeToHex(pubkey); got != want {
+		t.Errorf("bls_pubkey = %s, want %s", got, want)
+	}
+}
+
+func TestBLSFromHexDataSourceOutOfRange(t *testing.T) {
+	for name, value := range map[string]string{
+		"zero":        "0x0000000000000000000000000000000000000000000000000000000000000000",
+		"group order": "0x73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001",
+		"above order": "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"short":       "0x73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff000000",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, diags := readDataSourceDiagnostics(t, NewBLSFromHexDataSource(), map[string]tftypes.Value{
+				"bls_key_hex": tftypes.NewValue(tftypes.String, value),
+			})
+			if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Invalid BLS secret key" {
+				t.Fatalf("expected an invalid BLS secret key error, got %v", diags)
+			}
+		})
+	}
+}
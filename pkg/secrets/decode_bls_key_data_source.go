@@ -0,0 +1,86 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &decodeBLSKeyDataSource{}
+)
+
+// decodeBLSKeyDataSourceModel maps the data source schema data.
+type decodeBLSKeyDataSourceModel struct {
+	ValidatorBLSKeyEncoded types.String `tfsdk:"validator_bls_key_encoded"`
+
+	BLSPubkey types.String `tfsdk:"bls_pubkey"`
+}
+
+// NewDecodeBLSKeyDataSource is a helper function to simplify the provider implementation.
+func NewDecodeBLSKeyDataSource() datasource.DataSource {
+	return &decodeBLSKeyDataSource{}
+}
+
+// decodeBLSKeyDataSource is the data source implementation.
+type decodeBLSKeyDataSource struct{}
+
+// Metadata returns the data source type name.
+func (d *decodeBLSKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_decode_bls_key"
+}
+
+// Schema defines the schema for the data source.
+func (d *decodeBLSKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Decodes an encoded validator BLS key and returns its public key, in the same format as the `polygonedge_secrets` resource.",
+		Attributes: map[string]schema.Attribute{
+			"validator_bls_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Encoded validator BLS key.",
+				Validators: []validator.String{
+					validators.BLSKey(),
+				},
+			},
+			"bls_pubkey": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator BLS public key, hex encoded.",
+			},
+		},
+	}
+}
+
+// Read decodes the BLS key.
+func (d *decodeBLSKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state decodeBLSKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := crypto.BytesToBLSSecretKey([]byte(state.ValidatorBLSKeyEncoded.ValueString()))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("validator_bls_key_encoded"), diagnostics.BLSKey, err))
+		return
+	}
+	pubkeyBytes, err := crypto.BLSSecretKeyToPubkeyBytes(key)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyDerivation(diagnostics.BLSKey, "BLS public key", err))
+		return
+	}
+
+	state.BLSPubkey = types.StringValue(hex.EncodeToHex(pubkeyBytes))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
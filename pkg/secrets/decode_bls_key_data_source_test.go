@@ -0,0 +1,29 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+)
+
+func TestDecodeBLSKeyDataSource(t *testing.T) {
+	var secrets secretsDataSourceModel
+	state := createSecrets(t, newConfiguredSecretsResource(t, providerdata.Default()), nil)
+	if diags := state.Get(context.Background(), &secrets); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	decoded := readDataSource(t, NewDecodeBLSKeyDataSource(), map[string]tftypes.Value{
+		"validator_bls_key_encoded": tftypes.NewValue(tftypes.String, secrets.ValidatorBLSKeyEncoded.ValueString()),
+	})
+	var model decodeBLSKeyDataSourceModel
+	if diags := decoded.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if !model.BLSPubkey.Equal(secrets.BLSPubkey) {
+		t.Errorf("bls_pubkey = %s, want the resource's %s", model.BLSPubkey, secrets.BLSPubkey)
+	}
+}
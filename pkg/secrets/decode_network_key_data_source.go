@@ -0,0 +1,92 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &decodeNetworkKeyDataSource{}
+)
+
+// decodeNetworkKeyDataSourceModel maps the data source schema data.
+type decodeNetworkKeyDataSourceModel struct {
+	NetworkKeyEncoded types.String `tfsdk:"network_key_encoded"`
+
+	NodeID  types.String `tfsdk:"node_id"`
+	KeyType types.String `tfsdk:"key_type"`
+}
+
+// NewDecodeNetworkKeyDataSource is a helper function to simplify the provider implementation.
+func NewDecodeNetworkKeyDataSource() datasource.DataSource {
+	return &decodeNetworkKeyDataSource{}
+}
+
+// decodeNetworkKeyDataSource is the data source implementation.
+type decodeNetworkKeyDataSource struct{}
+
+// Metadata returns the data source type name.
+func (d *decodeNetworkKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_decode_network_key"
+}
+
+// Schema defines the schema for the data source.
+func (d *decodeNetworkKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Decodes an encoded libp2p network key and returns the node ID it derives.",
+		Attributes: map[string]schema.Attribute{
+			"network_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Encoded network key.",
+				Validators: []validator.String{
+					validators.NetworkKey(),
+				},
+			},
+			"node_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Node ID.",
+			},
+			"key_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the libp2p key, such as `Secp256k1` or `Ed25519`.",
+			},
+		},
+	}
+}
+
+// Read decodes the network key.
+func (d *decodeNetworkKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state decodeNetworkKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := network.ParseLibp2pKey([]byte(state.NetworkKeyEncoded.ValueString()))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("network_key_encoded"), diagnostics.NetworkKey, err))
+		return
+	}
+	nodeID, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyDerivation(diagnostics.NetworkKey, "node ID", err))
+		return
+	}
+
+	state.NodeID = types.StringValue(nodeID.String())
+	state.KeyType = types.StringValue(key.Type().String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
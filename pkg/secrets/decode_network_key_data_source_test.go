@@ -0,0 +1,50 @@
+package secrets
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+func TestDecodeNetworkKeyDataSource(t *testing.T) {
+	for name, keyType := range map[string]int{
+		"Secp256k1": libp2pcrypto.Secp256k1,
+		"Ed25519":   libp2pcrypto.Ed25519,
+		"ECDSA":     libp2pcrypto.ECDSA,
+	} {
+		t.Run(name, func(t *testing.T) {
+			key, _, err := libp2pcrypto.GenerateKeyPairWithReader(keyType, 0, rand.Reader)
+			if err != nil {
+				t.Fatalf("unable to generate %s key: %v", name, err)
+			}
+			raw, err := libp2pcrypto.MarshalPrivateKey(key)
+			if err != nil {
+				t.Fatalf("unable to marshal %s key: %v", name, err)
+			}
+			nodeID, err := peer.IDFromPrivateKey(key)
+			if err != nil {
+				t.Fatalf("unable to derive node ID: %v", err)
+			}
+
+			// polygon-edge encodes network keys as the hex of their libp2p protobuf encoding.
+			state := readDataSource(t, NewDecodeNetworkKeyDataSource(), map[string]tftypes.Value{
+				"network_key_encoded": tftypes.NewValue(tftypes.String, hex.EncodeToString(raw)),
+			})
+			var model decodeNetworkKeyDataSourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("unable to get state: %v", diags)
+			}
+			if model.NodeID.ValueString() != nodeID.String() {
+				t.Errorf("node_id = %s, want %s", model.NodeID, nodeID)
+			}
+			if model.KeyType.ValueString() != name {
+				t.Errorf("key_type = %s, want %s", model.KeyType, name)
+			}
+		})
+	}
+}
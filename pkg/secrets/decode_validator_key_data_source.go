@@ -0,0 +1,125 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &decodeValidatorKeyDataSource{}
+	_ datasource.DataSourceWithConfigure = &decodeValidatorKeyDataSource{}
+)
+
+// decodeValidatorKeyDataSourceModel maps the data source schema data.
+type decodeValidatorKeyDataSourceModel struct {
+	ValidatorKeyEncoded types.String `tfsdk:"validator_key_encoded"`
+	IncludePrivateKey   types.Bool   `tfsdk:"include_private_key"`
+
+	Address    types.String `tfsdk:"address"`
+	PrivateKey types.String `tfsdk:"private_key"`
+}
+
+// NewDecodeValidatorKeyDataSource is a helper function to simplify the provider implementation.
+func NewDecodeValidatorKeyDataSource() datasource.DataSource {
+	return &decodeValidatorKeyDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// decodeValidatorKeyDataSource is the data source implementation.
+type decodeValidatorKeyDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *decodeValidatorKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_decode_validator_key"
+}
+
+// Schema defines the schema for the data source.
+func (d *decodeValidatorKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Decodes an encoded validator key and returns the address it derives.",
+		Attributes: map[string]schema.Attribute{
+			"validator_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Encoded validator key.",
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+			},
+			"include_private_key": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Also return the raw private key in `private_key`.",
+			},
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator address.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Raw validator private key, hex encoded. Only set when `include_private_key` is enabled.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *decodeValidatorKeyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read decodes the validator key.
+func (d *decodeValidatorKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state decodeValidatorKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := crypto.BytesToECDSAPrivateKey([]byte(state.ValidatorKeyEncoded.ValueString()))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("validator_key_encoded"), diagnostics.ValidatorKey, err))
+		return
+	}
+
+	state.Address = types.StringValue(d.providerData.FormatAddress(crypto.PubKeyToAddress(&key.PublicKey)))
+	state.PrivateKey = types.StringNull()
+	if state.IncludePrivateKey.ValueBool() {
+		raw, err := crypto.MarshalECDSAPrivateKey(key)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to encode private key", err.Error())
+			return
+		}
+		state.PrivateKey = types.StringValue(hex.EncodeToHex(raw))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,42 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDecodeValidatorKeyDataSource(t *testing.T) {
+	key, encoded, err := crypto.GenerateAndEncodeECDSAPrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate validator key: %v", err)
+	}
+	raw, err := crypto.MarshalECDSAPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal validator key: %v", err)
+	}
+
+	for _, includePrivateKey := range []bool{false, true} {
+		state := readDataSource(t, NewDecodeValidatorKeyDataSource(), map[string]tftypes.Value{
+			"validator_key_encoded": tftypes.NewValue(tftypes.String, string(encoded)),
+			"include_private_key":   tftypes.NewValue(tftypes.Bool, includePrivateKey),
+		})
+		var model decodeValidatorKeyDataSourceModel
+		if diags := state.Get(context.Background(), &model); diags.HasError() {
+			t.Fatalf("unable to get state: %v", diags)
+		}
+
+		if got, want := model.Address.ValueString(), crypto.PubKeyToAddress(&key.PublicKey).String(); got != want {
+			t.Errorf("address = %s, want %s", got, want)
+		}
+		if !includePrivateKey && !model.PrivateKey.IsNull() {
+			t.Errorf("private_key = %s without include_private_key, want null", model.PrivateKey)
+		}
+		if includePrivateKey && model.PrivateKey.ValueString() != hex.EncodeToHex(raw) {
+			t.Errorf("private_key does not round-trip the generated key")
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package secrets
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	edgehex "github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// ecdsaPrivateKeySize is the size of a big endian secp256k1 private key.
+const ecdsaPrivateKeySize = 32
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ecdsaFromHexDataSource{}
+	_ datasource.DataSourceWithConfigure = &ecdsaFromHexDataSource{}
+)
+
+// ecdsaFromHexDataSourceModel maps the data source schema data.
+type ecdsaFromHexDataSourceModel struct {
+	ValidatorKeyHex types.String `tfsdk:"validator_key_hex"`
+
+	ValidatorKeyEncoded types.String `tfsdk:"validator_key_encoded"`
+	Address             types.String `tfsdk:"address"`
+	Pubkey              types.String `tfsdk:"pubkey"`
+}
+
+// NewECDSAFromHexDataSource is a helper function to simplify the provider implementation.
+func NewECDSAFromHexDataSource() datasource.DataSource {
+	return &ecdsaFromHexDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// ecdsaFromHexDataSource is the data source implementation.
+type ecdsaFromHexDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *ecdsaFromHexDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ecdsa_from_hex"
+}
+
+// Schema defines the schema for the data source.
+func (d *ecdsaFromHexDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Builds an encoded validator key from a raw hex secp256k1 private key, " +
+			"and returns it with its address and public key, in the same format as the `polygonedge_secrets` resource.",
+		Attributes: map[string]schema.Attribute{
+			"validator_key_hex": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "0x prefixed hex, 32 byte big endian secp256k1 private key.",
+				Validators: []validator.String{
+					validators.SensitiveHex(),
+				},
+			},
+			"validator_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded validator key.",
+			},
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator address.",
+			},
+			"pubkey": schema.StringAttribute{
+				Computed:    true,
+				Description: "Compressed validator public key, hex encoded.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *ecdsaFromHexDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read builds the encoded validator key.
+func (d *ecdsaFromHexDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ecdsaFromHexDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	raw, err := edgehex.DecodeHex(state.ValidatorKeyHex.ValueString())
+	if err != nil || len(raw) != ecdsaPrivateKeySize {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validator_key_hex"),
+			"Invalid validator private key",
+			fmt.Sprintf("The validator private key must be %d bytes, hex encoded.", ecdsaPrivateKeySize),
+		)
+		return
+	}
+	if scalar := new(big.Int).SetBytes(raw); scalar.Sign() == 0 || scalar.Cmp(btcec.S256().N) >= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validator_key_hex"),
+			"Invalid validator private key",
+			"The validator private key must be a scalar greater than zero and lower than the secp256k1 curve order.",
+		)
+		return
+	}
+
+	// polygon-edge encodes validator keys as the hex of the big endian scalar, without the 0x prefix.
+	encoded := hex.EncodeToString(raw)
+	key, err := crypto.BytesToECDSAPrivateKey([]byte(encoded))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("validator_key_hex"), diagnostics.ValidatorKey, err))
+		return
+	}
+
+	state.ValidatorKeyEncoded = types.StringValue(encoded)
+	state.Address = types.StringValue(d.providerData.FormatAddress(crypto.PubKeyToAddress(&key.PublicKey)))
+	state.Pubkey = types.StringValue(edgehex.EncodeToHex((*btcec.PublicKey)(&key.PublicKey).SerializeCompressed()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
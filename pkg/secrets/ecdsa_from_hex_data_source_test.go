@@ -0,0 +1,45 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestECDSAFromHexDataSource(t *testing.T) {
+	// The first Hardhat development account.
+	state := readDataSource(t, NewECDSAFromHexDataSource(), map[string]tftypes.Value{
+		"validator_key_hex": tftypes.NewValue(tftypes.String, "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"),
+	})
+	var model ecdsaFromHexDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if got, want := model.ValidatorKeyEncoded.ValueString(), "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"; got != want {
+		t.Errorf("validator_key_encoded = %s, want %s", got, want)
+	}
+	if got, want := model.Address.ValueString(), "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"; got != want {
+		t.Errorf("address = %s, want %s", got, want)
+	}
+	if got, want := model.Pubkey.ValueString(), "0x038318535b54105d4a7aae60c08fc45f9687181b4fdfc625bd1a753fa7397fed75"; got != want {
+		t.Errorf("pubkey = %s, want %s", got, want)
+	}
+}
+
+func TestECDSAFromHexDataSourceInvalidScalar(t *testing.T) {
+	for name, value := range map[string]string{
+		"zero":        "0x0000000000000000000000000000000000000000000000000000000000000000",
+		"curve order": "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141",
+		"above order": "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364142",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, diags := readDataSourceDiagnostics(t, NewECDSAFromHexDataSource(), map[string]tftypes.Value{
+				"validator_key_hex": tftypes.NewValue(tftypes.String, value),
+			})
+			if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Invalid validator private key" {
+				t.Fatalf("expected an invalid validator private key error, got %v", diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package secrets
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"sync"
+
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/coinbase/kryptology/pkg/signatures/bls/bls_sig"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	bn256 "github.com/umbracle/go-eth-bn256"
+)
+
+// blsKeygenEntropy is the number of bytes of input key material a BLS secret key is generated from,
+// the same amount the BLS signature scheme reads itself.
+const blsKeygenEntropy = 32
+
+// keyGenerator generates keys encoded like polygon-edge encodes them, reading all randomness from its entropy source.
+// The generated keys are the same as the ones polygon-edge generates, the source only exists so that the
+// randomness can be replaced with a fixed reader to generate the same keys again.
+type keyGenerator struct {
+	entropy io.Reader
+}
+
+// keySource generates the keys of the secrets resource. It is implemented by keyGenerator, and replaced in tests
+// to check how the resource handles the generated keys.
+type keySource interface {
+	validatorKey() (*ecdsa.PrivateKey, []byte, error)
+	vanityValidatorKey(ctx context.Context, prefix string, maxAttempts int64) ([]byte, int64, error)
+	blsKey() (*bls_sig.SecretKey, []byte, error)
+	networkKey() (libp2pcrypto.PrivKey, []byte, error)
+	polybftBLSKey() ([]byte, *bls.PrivateKey, error)
+}
+
+// defaultKeyGenerator generates keys from crypto/rand.
+var defaultKeyGenerator = keyGenerator{entropy: rand.Reader}
+
+// validatorKey generates an encoded validator key.
+func (g keyGenerator) validatorKey() (*ecdsa.PrivateKey, []byte, error) {
+	scalar, err := randomSecp256k1Scalar(g.entropy)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := crypto.ParseECDSAPrivateKey(scalar)
+	if err != nil {
+		return nil, nil, err
+	}
+	// polygon-edge encodes validator keys as the hex of the big endian scalar.
+	return key, []byte(hex.EncodeToString(scalar)), nil
+}
+
+// blsKey generates an encoded validator BLS key.
+func (g keyGenerator) blsKey() (*bls_sig.SecretKey, []byte, error) {
+	ikm := make([]byte, blsKeygenEntropy)
+	if _, err := io.ReadFull(g.entropy, ikm); err != nil {
+		return nil, nil, fmt.Errorf("unable to read entropy: %w", err)
+	}
+	_, key, err := bls_sig.NewSigPop().KeygenWithSeed(ikm)
+	if err != nil {
+		return nil, nil, err
+	}
+	raw, err := key.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, []byte(hex.EncodeToString(raw)), nil
+}
+
+// networkKey generates an encoded secp256k1 libp2p network key.
+func (g keyGenerator) networkKey() (libp2pcrypto.PrivKey, []byte, error) {
+	scalar, err := randomSecp256k1Scalar(g.entropy)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := libp2pcrypto.UnmarshalSecp256k1PrivateKey(scalar)
+	if err != nil {
+		return nil, nil, err
+	}
+	// polygon-edge encodes network keys as the hex of the libp2p protobuf encoding.
+	raw, err := libp2pcrypto.MarshalPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, []byte(hex.EncodeToString(raw)), nil
+}
+
+// polybftBLSKey generates an encoded PolyBFT validator BLS key. The returned key is decoded from the
+// encoded one, so that everything derived from it matches the stored key.
+func (g keyGenerator) polybftBLSKey() ([]byte, *bls.PrivateKey, error) {
+	var scalar *big.Int
+	for scalar == nil || scalar.Sign() == 0 {
+		var err error
+		if scalar, err = rand.Int(g.entropy, bn256.Order); err != nil {
+			return nil, nil, err
+		}
+	}
+	// polygon-edge encodes PolyBFT BLS keys as the decimal text of the scalar.
+	encoded, err := scalar.MarshalText()
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := bls.UnmarshalPrivateKey(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encoded, key, nil
+}
+
+// concurrent returns a generator which can be used by several goroutines at once, for sources which are not
+// safe for concurrent use.
+func (g keyGenerator) concurrent() keyGenerator {
+	return keyGenerator{entropy: &lockedReader{r: g.entropy}}
+}
+
+// lockedReader serializes reads from a reader.
+type lockedReader struct {
+	mu sync.Mutex
+	r  io.Reader
+}
+
+func (l *lockedReader) Read(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Read(p)
+}
+
+// randomSecp256k1Scalar reads a secp256k1 private key from the entropy source. Values which are not a valid
+// scalar are rejected and read again, which almost never happens.
+func randomSecp256k1Scalar(entropy io.Reader) ([]byte, error) {
+	scalar := make([]byte, 32)
+	for {
+		if _, err := io.ReadFull(entropy, scalar); err != nil {
+			return nil, fmt.Errorf("unable to read entropy: %w", err)
+		}
+		if n := new(big.Int).SetBytes(scalar); n.Sign() > 0 && n.Cmp(btcec.S256().N) < 0 {
+			return scalar, nil
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	mathrand "math/rand"
+	"strings"
+	"testing"
+
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+)
+
+// fixedKeyGenerator returns a generator reading from a fixed stream of bytes, which is the same for the same seed.
+func fixedKeyGenerator(seed int64) keyGenerator {
+	return keyGenerator{entropy: mathrand.New(mathrand.NewSource(seed))}
+}
+
+// generatedKeys generates every kind of key with the generator, returning their encodings.
+func generatedKeys(t *testing.T, g keyGenerator) map[string][]byte {
+	t.Helper()
+	_, validatorKey, err := g.validatorKey()
+	if err != nil {
+		t.Fatalf("unable to generate validator key: %v", err)
+	}
+	_, blsKey, err := g.blsKey()
+	if err != nil {
+		t.Fatalf("unable to generate BLS key: %v", err)
+	}
+	_, networkKey, err := g.networkKey()
+	if err != nil {
+		t.Fatalf("unable to generate network key: %v", err)
+	}
+	polybftBLSKey, _, err := g.polybftBLSKey()
+	if err != nil {
+		t.Fatalf("unable to generate PolyBFT BLS key: %v", err)
+	}
+	return map[string][]byte{
+		"validator key":   validatorKey,
+		"BLS key":         blsKey,
+		"network key":     networkKey,
+		"PolyBFT BLS key": polybftBLSKey,
+	}
+}
+
+func TestKeyGeneratorIsDeterministic(t *testing.T) {
+	first, second, other := generatedKeys(t, fixedKeyGenerator(1)), generatedKeys(t, fixedKeyGenerator(1)), generatedKeys(t, fixedKeyGenerator(2))
+	for name, key := range first {
+		if !bytes.Equal(key, second[name]) {
+			t.Errorf("%s = %s, then %s from the same reader", name, key, second[name])
+		}
+		if bytes.Equal(key, other[name]) {
+			t.Errorf("%s = %s from another reader too", name, key)
+		}
+	}
+}
+
+func TestKeyGeneratorKeysDecode(t *testing.T) {
+	g := fixedKeyGenerator(1)
+
+	validatorKey, validatorKeyEncoded, err := g.validatorKey()
+	if err != nil {
+		t.Fatalf("unable to generate validator key: %v", err)
+	}
+	decodedValidatorKey, err := crypto.BytesToECDSAPrivateKey(validatorKeyEncoded)
+	if err != nil {
+		t.Fatalf("unable to decode validator key: %v", err)
+	}
+	if !decodedValidatorKey.Equal(validatorKey) {
+		t.Error("validator key does not decode to the generated key")
+	}
+
+	blsKey, blsKeyEncoded, err := g.blsKey()
+	if err != nil {
+		t.Fatalf("unable to generate BLS key: %v", err)
+	}
+	decodedBLSKey, err := crypto.BytesToBLSSecretKey(blsKeyEncoded)
+	if err != nil {
+		t.Fatalf("unable to decode BLS key: %v", err)
+	}
+	want, _ := blsKey.MarshalBinary()
+	if got, _ := decodedBLSKey.MarshalBinary(); !bytes.Equal(got, want) {
+		t.Error("BLS key does not decode to the generated key")
+	}
+
+	networkKey, networkKeyEncoded, err := g.networkKey()
+	if err != nil {
+		t.Fatalf("unable to generate network key: %v", err)
+	}
+	decodedNetworkKey, err := network.ParseLibp2pKey(networkKeyEncoded)
+	if err != nil {
+		t.Fatalf("unable to decode network key: %v", err)
+	}
+	if !decodedNetworkKey.Equals(networkKey) {
+		t.Error("network key does not decode to the generated key")
+	}
+
+	polybftBLSKeyEncoded, polybftBLSKey, err := g.polybftBLSKey()
+	if err != nil {
+		t.Fatalf("unable to generate PolyBFT BLS key: %v", err)
+	}
+	decodedPolyBFTBLSKey, err := bls.UnmarshalPrivateKey(polybftBLSKeyEncoded)
+	if err != nil {
+		t.Fatalf("unable to decode PolyBFT BLS key: %v", err)
+	}
+	if !bytes.Equal(decodedPolyBFTBLSKey.PublicKey().Marshal(), polybftBLSKey.PublicKey().Marshal()) {
+		t.Error("PolyBFT BLS key does not decode to the generated key")
+	}
+}
+
+func TestKeyGeneratorShortEntropy(t *testing.T) {
+	g := keyGenerator{entropy: bytes.NewReader(make([]byte, 16))}
+	if _, _, err := g.validatorKey(); err == nil || !strings.Contains(err.Error(), "unable to read entropy") {
+		t.Errorf("got %v, want an entropy error", err)
+	}
+}
+
+func TestSecretsResourceFixedEntropy(t *testing.T) {
+	create := func() secretsDataSourceModel {
+		r := newConfiguredSecretsResource(t, providerdata.Default()).(*secretsResource)
+		r.keys = fixedKeyGenerator(1)
+		state := createSecrets(t, r, map[string]tftypes.Value{})
+		var model secretsDataSourceModel
+		if diags := state.Get(context.Background(), &model); diags.HasError() {
+			t.Fatalf("unable to get state: %v", diags)
+		}
+		return model
+	}
+
+	first, second := create(), create()
+	if first.ValidatorKeyEncoded != second.ValidatorKeyEncoded || first.Address != second.Address {
+		t.Errorf("validator keys %s, %s created from the same reader", first.Address, second.Address)
+	}
+	if first.ValidatorBLSKeyEncoded != second.ValidatorBLSKeyEncoded || first.BLSPubkey != second.BLSPubkey {
+		t.Errorf("BLS keys %s, %s created from the same reader", first.BLSPubkey, second.BLSPubkey)
+	}
+	if first.NetworkKeyEncoded != second.NetworkKeyEncoded || first.NodeID != second.NodeID {
+		t.Errorf("network keys %s, %s created from the same reader", first.NodeID, second.NodeID)
+	}
+}
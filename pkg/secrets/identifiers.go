@@ -0,0 +1,165 @@
+package secrets
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+)
+
+// encodedKey is polygon-edge encoded key material, with the attribute it was read from for diagnostics.
+type encodedKey struct {
+	value []byte
+	attr  path.Path
+}
+
+// identifiers are the public values derived from a set of secrets.
+type identifiers struct {
+	Address   string
+	BLSPubkey string
+	NodeID    string
+}
+
+// deriveIdentifiers decodes the validator, BLS and network keys and derives their public identifiers.
+func deriveIdentifiers(validatorKey, blsKey, networkKey encodedKey) (identifiers, diag.Diagnostics) {
+	var (
+		ids   identifiers
+		diags diag.Diagnostics
+	)
+
+	ecdsaKey, err := crypto.BytesToECDSAPrivateKey(validatorKey.value)
+	if err != nil {
+		diags.Append(diagnostics.KeyParse(validatorKey.attr, diagnostics.ValidatorKey, err))
+	} else {
+		ids.Address = crypto.PubKeyToAddress(&ecdsaKey.PublicKey).String()
+	}
+
+	blsPubkey, blsDiags := deriveBLSPubkey(blsKey)
+	diags.Append(blsDiags...)
+	ids.BLSPubkey = blsPubkey
+
+	libp2pKey, err := network.ParseLibp2pKey(networkKey.value)
+	if err != nil {
+		diags.Append(diagnostics.KeyParse(networkKey.attr, diagnostics.NetworkKey, err))
+	} else if nodeID, err := peer.IDFromPrivateKey(libp2pKey); err != nil {
+		diags.Append(diagnostics.KeyDerivation(diagnostics.NetworkKey, "node ID", err))
+	} else {
+		ids.NodeID = nodeID.String()
+	}
+
+	return ids, diags
+}
+
+// checkRoundTrip compares the identifiers computed from freshly generated keys with the ones derived from their
+// encoded form. Empty generated identifiers, of keys which were supplied rather than generated, are not compared.
+func checkRoundTrip(generated, decoded identifiers) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, id := range []struct {
+		keyType   diagnostics.KeyType
+		derived   string
+		generated string
+		decoded   string
+	}{
+		{diagnostics.ValidatorKey, "address", generated.Address, decoded.Address},
+		{diagnostics.BLSKey, "BLS public key", generated.BLSPubkey, decoded.BLSPubkey},
+		{diagnostics.NetworkKey, "node ID", generated.NodeID, decoded.NodeID},
+	} {
+		if id.generated != "" && id.generated != id.decoded {
+			diags.Append(diagnostics.KeyRoundTrip(id.keyType, id.derived, id.generated, id.decoded))
+		}
+	}
+	return diags
+}
+
+// deriveBLSPubkey decodes the BLS key and derives its hex encoded public key.
+func deriveBLSPubkey(blsKey encodedKey) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	blsSecretKey, err := crypto.BytesToBLSSecretKey(blsKey.value)
+	if err != nil {
+		diags.Append(diagnostics.KeyParse(blsKey.attr, diagnostics.BLSKey, err))
+		return "", diags
+	}
+	pubkeyBytes, err := crypto.BLSSecretKeyToPubkeyBytes(blsSecretKey)
+	if err != nil {
+		diags.Append(diagnostics.KeyDerivation(diagnostics.BLSKey, "BLS public key", err))
+		return "", diags
+	}
+
+	return hex.EncodeToHex(pubkeyBytes), diags
+}
+
+// validatorKeyfile decodes the validator key and returns it as an unencrypted go-ethereum keyfile,
+// the hex private key without 0x prefix, followed by a newline.
+func validatorKeyfile(validatorKey []byte) (string, error) {
+	key, err := crypto.BytesToECDSAPrivateKey(validatorKey)
+	if err != nil {
+		return "", err
+	}
+	raw, err := crypto.MarshalECDSAPrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(raw) + "\n", nil
+}
+
+// blsKeyHex decodes the BLS key and returns the hex of its raw secret scalar, 0x prefixed if requested.
+func blsKeyHex(blsKey []byte, prefix bool) (string, error) {
+	key, err := crypto.BytesToBLSSecretKey(blsKey)
+	if err != nil {
+		return "", err
+	}
+	raw, err := key.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return setHexPrefix(hex.EncodeToString(raw), prefix), nil
+}
+
+// networkKeyLibp2p decodes the network key and returns it in the standard libp2p marshalled form, the base64 of its
+// protobuf encoding. polygon-edge encodes the same protobuf as hex.
+func networkKeyLibp2p(networkKey []byte) (string, error) {
+	key, err := network.ParseLibp2pKey(networkKey)
+	if err != nil {
+		return "", err
+	}
+	raw, err := libp2pcrypto.MarshalPrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
+
+// libp2pNodeID unmarshals a network key in the libp2p marshalled form and returns its node ID.
+func libp2pNodeID(marshalled string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(marshalled)
+	if err != nil {
+		return "", err
+	}
+	key, err := libp2pcrypto.UnmarshalPrivateKey(raw)
+	if err != nil {
+		return "", err
+	}
+	nodeID, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return nodeID.String(), nil
+}
+
+// setHexPrefix adds the 0x prefix to a hex value, or removes it.
+func setHexPrefix(value string, prefix bool) string {
+	value = strings.TrimPrefix(value, "0x")
+	if prefix {
+		return "0x" + value
+	}
+	return value
+}
@@ -0,0 +1,243 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	edgesecrets "github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &parseSecretsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &parseSecretsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &parseSecretsDataSource{}
+)
+
+// secretsFile is the JSON form of a set of polygon-edge secrets. The keys are named after the polygon-edge
+// secret names, the identifiers use the names of `polygon-edge secrets output --json` and are optional.
+type secretsFile struct {
+	ValidatorKey    *string `json:"validator-key"`
+	ValidatorBLSKey *string `json:"validator-bls-key"`
+	NetworkKey      *string `json:"network-key"`
+
+	Address   *string `json:"address"`
+	BLSPubkey *string `json:"bls"`
+	NodeID    *string `json:"node_id"`
+}
+
+// parseSecretsDataSourceModel maps the data source schema data.
+type parseSecretsDataSourceModel struct {
+	Path    types.String `tfsdk:"path"`
+	Content types.String `tfsdk:"content"`
+
+	ValidatorKeyEncoded    types.String `tfsdk:"validator_key_encoded"`
+	ValidatorBLSKeyEncoded types.String `tfsdk:"validator_bls_key_encoded"`
+	NetworkKeyEncoded      types.String `tfsdk:"network_key_encoded"`
+
+	Address   types.String `tfsdk:"address"`
+	BLSPubkey types.String `tfsdk:"bls_pubkey"`
+	NodeID    types.String `tfsdk:"node_id"`
+}
+
+// NewParseSecretsDataSource is a helper function to simplify the provider implementation.
+func NewParseSecretsDataSource() datasource.DataSource {
+	return &parseSecretsDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// parseSecretsDataSource is the data source implementation.
+type parseSecretsDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *parseSecretsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_parse_secrets"
+}
+
+// Schema defines the schema for the data source.
+func (d *parseSecretsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Parses a JSON secrets file and re-derives the identifiers of its keys. " +
+			"The file holds the encoded keys under their polygon-edge secret names, `" + edgesecrets.ValidatorKey + "`, `" +
+			edgesecrets.ValidatorBLSKey + "` and `" + edgesecrets.NetworkKey + "`. It may also hold the `address`, `bls` and `node_id` " +
+			"fields printed by `polygon-edge secrets output --json`, which are checked against the derived identifiers.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the secrets file. Conflicts with `content`.",
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Content of the secrets file. Conflicts with `path`.",
+			},
+			"validator_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded validator key.",
+			},
+			"validator_bls_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded validator BLS key.",
+			},
+			"network_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded network key.",
+			},
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator address.",
+			},
+			"bls_pubkey": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator BLS public key, hex encoded.",
+			},
+			"node_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Node ID.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *parseSecretsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// ValidateConfig ensures exactly one of `path` and `content` is set.
+func (d *parseSecretsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config parseSecretsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Path.IsNull() == config.Content.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid secrets source",
+			"Exactly one of `path` and `content` must be set.",
+		)
+	}
+}
+
+// Read parses the secrets file.
+func (d *parseSecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state parseSecretsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourcePath := path.Root("content")
+	content := []byte(state.Content.ValueString())
+	if !state.Path.IsNull() {
+		sourcePath = path.Root("path")
+		var err error
+		if content, err = os.ReadFile(state.Path.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(sourcePath, "Unable to read secrets file", err.Error())
+			return
+		}
+	}
+
+	var file secretsFile
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil {
+		resp.Diagnostics.AddAttributeError(sourcePath, "Invalid secrets file", fmt.Sprintf("Unable to decode the secrets JSON: %s", err))
+		return
+	}
+
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{edgesecrets.ValidatorKey, file.ValidatorKey},
+		{edgesecrets.ValidatorBLSKey, file.ValidatorBLSKey},
+		{edgesecrets.NetworkKey, file.NetworkKey},
+	} {
+		if field.value == nil || *field.value == "" {
+			resp.Diagnostics.AddAttributeError(
+				sourcePath,
+				"Missing secrets field",
+				fmt.Sprintf("The secrets JSON must set the %q field.", field.name),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := deriveIdentifiers(
+		encodedKey{value: []byte(*file.ValidatorKey), attr: sourcePath},
+		encodedKey{value: []byte(*file.ValidatorBLSKey), attr: sourcePath},
+		encodedKey{value: []byte(*file.NetworkKey), attr: sourcePath},
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Addresses and BLS public keys are hex, and may differ in case. Node IDs are base58, where case matters.
+	for _, field := range []struct {
+		name     string
+		value    *string
+		derived  string
+		foldCase bool
+	}{
+		{"address", file.Address, ids.Address, true},
+		{"bls", file.BLSPubkey, ids.BLSPubkey, true},
+		{"node_id", file.NodeID, ids.NodeID, false},
+	} {
+		if field.value == nil {
+			continue
+		}
+		if matches := *field.value == field.derived || field.foldCase && strings.EqualFold(*field.value, field.derived); !matches {
+			resp.Diagnostics.AddAttributeError(
+				sourcePath,
+				"Mismatched secrets field",
+				fmt.Sprintf("The %q field is %s, but the keys derive %s.", field.name, *field.value, field.derived),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ValidatorKeyEncoded = types.StringValue(*file.ValidatorKey)
+	state.ValidatorBLSKeyEncoded = types.StringValue(*file.ValidatorBLSKey)
+	state.NetworkKeyEncoded = types.StringValue(*file.NetworkKey)
+	state.Address = types.StringValue(d.providerData.FormatAddressString(ids.Address))
+	state.BLSPubkey = types.StringValue(ids.BLSPubkey)
+	state.NodeID = types.StringValue(ids.NodeID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
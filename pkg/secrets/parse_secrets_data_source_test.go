@@ -0,0 +1,124 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// readDataSource reads the data source with the given attributes set in its configuration, the others null,
+// failing the test on error.
+func readDataSource(t *testing.T, d datasource.DataSource, attrs map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	state, diags := readDataSourceDiagnostics(t, d, attrs)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	return state
+}
+
+// readDataSourceDiagnostics reads the data source with the given attributes set in its configuration,
+// the others null, and returns its state and diagnostics.
+func readDataSourceDiagnostics(t *testing.T, d datasource.DataSource, attrs map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, value := range attrs {
+		values[name] = value
+	}
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	return resp.State, resp.Diagnostics
+}
+
+// readSecretsFixture returns the secrets of testdata/secrets.json.
+func readSecretsFixture(t *testing.T) secretsFile {
+	t.Helper()
+	content, err := os.ReadFile("testdata/secrets.json")
+	if err != nil {
+		t.Fatalf("unable to read fixture: %v", err)
+	}
+	var file secretsFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		t.Fatalf("unable to decode fixture: %v", err)
+	}
+	return file
+}
+
+func TestParseSecretsDataSource(t *testing.T) {
+	state := readDataSource(t, NewParseSecretsDataSource(), map[string]tftypes.Value{
+		"path": tftypes.NewValue(tftypes.String, "testdata/secrets.json"),
+	})
+	var model parseSecretsDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	for name, tt := range map[string]struct{ got, want string }{
+		"validator_key_encoded":     {model.ValidatorKeyEncoded.ValueString(), "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"},
+		"validator_bls_key_encoded": {model.ValidatorBLSKeyEncoded.ValueString(), "41d0802d29cd2bd34f0b68a1ee66a39fbad99a6040050e6a80882646b51c116f"},
+		"network_key_encoded":       {model.NetworkKeyEncoded.ValueString(), "08021220713cbd1c2c2616f9b6bda3f943e8e4d9c7dce0c92834c19fcad55d67ba4a1514"},
+		// The address is checksummed, although the file holds it in lowercase.
+		"address":    {model.Address.ValueString(), "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+		"bls_pubkey": {model.BLSPubkey.ValueString(), "0xa6f186725723a5986dd6167ee083edb37c66756bbbfe08140148444ea78ac191b5478f9c98fb36b5335f2aee0466ef99"},
+		"node_id":    {model.NodeID.ValueString(), "16Uiu2HAm2ceCxUash63GbMjuzF1oXwMJuRnGnZggFcc1jHqcFW9a"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%s = %s, want %s", name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestParseSecretsDataSourceInvalid(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/secrets.json")
+	if err != nil {
+		t.Fatalf("unable to read fixture: %v", err)
+	}
+
+	tests := map[string]struct {
+		content string
+		want    string
+	}{
+		"mismatched node id": {
+			content: strings.Replace(string(fixture), "16Uiu2HAm2ceCxUash63GbMjuzF1oXwMJuRnGnZggFcc1jHqcFW9a", "16Uiu2HAm2ceCxUash63GbMjuzF1oXwMJuRnGnZggFcc1jHqcFW9A", 1),
+			want:    "Mismatched secrets field",
+		},
+		"missing key": {
+			content: strings.Replace(string(fixture), `"network-key"`, `"node-key"`, 1),
+			want:    "Invalid secrets file",
+		},
+		"empty key": {
+			content: `{"validator-key": "", "validator-bls-key": "", "network-key": ""}`,
+			want:    "Missing secrets field",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, diags := readDataSourceDiagnostics(t, NewParseSecretsDataSource(), map[string]tftypes.Value{
+				"content": tftypes.NewValue(tftypes.String, tt.content),
+			})
+			if !diags.HasError() || diags.Errors()[0].Summary() != tt.want {
+				t.Fatalf("expected a %q error, got %v", tt.want, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &secretsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &secretsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &secretsDataSource{}
+)
+
+// secretsBundleDataSourceModel maps the data source schema data.
+type secretsBundleDataSourceModel struct {
+	Seed types.String `tfsdk:"seed"`
+
+	ValidatorKeyEncoded    types.String `tfsdk:"validator_key_encoded"`
+	ValidatorBLSKeyEncoded types.String `tfsdk:"validator_bls_key_encoded"`
+	NetworkKeyEncoded      types.String `tfsdk:"network_key_encoded"`
+
+	Address   types.String `tfsdk:"address"`
+	BLSPubkey types.String `tfsdk:"bls_pubkey"`
+	NodeID    types.String `tfsdk:"node_id"`
+}
+
+// NewSecretsDataSource is a helper function to simplify the provider implementation.
+func NewSecretsDataSource() datasource.DataSource {
+	return &secretsDataSource{
+		providerData: providerdata.Default(),
+		keys:         defaultKeyGenerator,
+	}
+}
+
+// secretsDataSource is the data source implementation.
+type secretsDataSource struct {
+	providerData providerdata.Data
+	keys         keyGenerator
+}
+
+// Metadata returns the data source type name.
+func (d *secretsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets"
+}
+
+// Schema defines the schema for the data source.
+func (d *secretsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates polygon-edge secrets and their identifiers, in the same format as the `polygonedge_secrets` resource, " +
+			"without storing them in a managed resource. Data sources are read on every plan, so without a `seed` " +
+			"new secrets are generated each time; use the resource to keep them, or a seed to derive the same secrets every time.",
+		Attributes: map[string]schema.Attribute{
+			"seed": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Seed the secrets are derived from, of at least 32 bytes. The same seed always derives the same secrets, " +
+					"so it must be kept as secret as the keys themselves. Random secrets are generated if not set.",
+			},
+			"validator_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded validator key.",
+			},
+			"validator_bls_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded validator BLS key.",
+			},
+			"network_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded network key.",
+			},
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator address.",
+			},
+			"bls_pubkey": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator BLS public key, hex encoded.",
+			},
+			"node_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Node ID.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *secretsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// ValidateConfig ensures the seed is long enough.
+func (d *secretsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config secretsBundleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Seed.IsNull() || config.Seed.IsUnknown() {
+		return
+	}
+	if err := validateSeed([]byte(config.Seed.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("seed"), "Invalid seed", err.Error()+".")
+	}
+}
+
+// Read generates or derives the secrets.
+func (d *secretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state secretsBundleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var validatorKey, blsKey, networkKey []byte
+	var err error
+	if state.Seed.IsNull() {
+		start := time.Now()
+		if _, validatorKey, err = d.keys.validatorKey(); err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyGeneration(diagnostics.ValidatorKey, err))
+			return
+		}
+		logKeyGeneration(ctx, diagnostics.ValidatorKey, start, validatorKey)
+		start = time.Now()
+		if _, blsKey, err = d.keys.blsKey(); err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyGeneration(diagnostics.BLSKey, err))
+			return
+		}
+		logKeyGeneration(ctx, diagnostics.BLSKey, start, blsKey)
+		start = time.Now()
+		if _, networkKey, err = d.keys.networkKey(); err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyGeneration(diagnostics.NetworkKey, err))
+			return
+		}
+		logKeyGeneration(ctx, diagnostics.NetworkKey, start, networkKey)
+	} else {
+		var diags diag.Diagnostics
+		validatorKey, blsKey, networkKey, diags = deriveSeededKeys([]byte(state.Seed.ValueString()))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ids, diags := deriveIdentifiers(
+		encodedKey{value: validatorKey, attr: path.Root("validator_key_encoded")},
+		encodedKey{value: blsKey, attr: path.Root("validator_bls_key_encoded")},
+		encodedKey{value: networkKey, attr: path.Root("network_key_encoded")},
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ValidatorKeyEncoded = types.StringValue(string(validatorKey))
+	state.ValidatorBLSKeyEncoded = types.StringValue(string(blsKey))
+	state.NetworkKeyEncoded = types.StringValue(string(networkKey))
+	state.Address = types.StringValue(d.providerData.FormatAddressString(ids.Address))
+	state.BLSPubkey = types.StringValue(ids.BLSPubkey)
+	state.NodeID = types.StringValue(ids.NodeID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
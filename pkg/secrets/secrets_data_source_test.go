@@ -0,0 +1,42 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSecretsDataSourceSeeded(t *testing.T) {
+	read := func(seed string) secretsBundleDataSourceModel {
+		state := readDataSource(t, NewSecretsDataSource(), map[string]tftypes.Value{
+			"seed": tftypes.NewValue(tftypes.String, seed),
+		})
+		var model secretsBundleDataSourceModel
+		if diags := state.Get(context.Background(), &model); diags.HasError() {
+			t.Fatalf("unable to get state: %v", diags)
+		}
+		return model
+	}
+
+	first, second, other := read(string(testSeed)), read(string(testSeed)), read(string(otherTestSeed))
+	if !first.Address.Equal(second.Address) || !first.BLSPubkey.Equal(second.BLSPubkey) || !first.NodeID.Equal(second.NodeID) ||
+		!first.ValidatorKeyEncoded.Equal(second.ValidatorKeyEncoded) || !first.ValidatorBLSKeyEncoded.Equal(second.ValidatorBLSKeyEncoded) ||
+		!first.NetworkKeyEncoded.Equal(second.NetworkKeyEncoded) {
+		t.Errorf("two reads with the same seed differ:\n%+v\n%+v", first, second)
+	}
+	if first.Address.Equal(other.Address) || first.BLSPubkey.Equal(other.BLSPubkey) || first.NodeID.Equal(other.NodeID) {
+		t.Errorf("two reads with different seeds have the same identifiers: %+v", first)
+	}
+	if first.Address.ValueString() == "" || first.BLSPubkey.ValueString() == "" || first.NodeID.ValueString() == "" {
+		t.Errorf("identifiers not set: %+v", first)
+	}
+}
+
+func TestSecretsDataSourceUnseeded(t *testing.T) {
+	first := readDataSource(t, NewSecretsDataSource(), nil)
+	second := readDataSource(t, NewSecretsDataSource(), nil)
+	if first.Raw.Equal(second.Raw) {
+		t.Error("two reads without a seed generated the same secrets")
+	}
+}
@@ -0,0 +1,178 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+)
+
+// Default names of the environment variables holding the encoded keys.
+const (
+	defaultValidatorKeyEnv    = "POLYGON_EDGE_VALIDATOR_KEY"
+	defaultValidatorBLSKeyEnv = "POLYGON_EDGE_VALIDATOR_BLS_KEY"
+	defaultNetworkKeyEnv      = "POLYGON_EDGE_NETWORK_KEY"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &secretsEnvDataSource{}
+	_ datasource.DataSourceWithConfigure = &secretsEnvDataSource{}
+)
+
+// secretsEnvDataSourceModel maps the data source schema data.
+type secretsEnvDataSourceModel struct {
+	ValidatorKeyEnv    types.String `tfsdk:"validator_key_env"`
+	ValidatorBLSKeyEnv types.String `tfsdk:"validator_bls_key_env"`
+	NetworkKeyEnv      types.String `tfsdk:"network_key_env"`
+
+	ValidatorKeyEncoded    types.String `tfsdk:"validator_key_encoded"`
+	ValidatorBLSKeyEncoded types.String `tfsdk:"validator_bls_key_encoded"`
+	NetworkKeyEncoded      types.String `tfsdk:"network_key_encoded"`
+
+	Address   types.String `tfsdk:"address"`
+	BLSPubkey types.String `tfsdk:"bls_pubkey"`
+	NodeID    types.String `tfsdk:"node_id"`
+}
+
+// NewSecretsEnvDataSource is a helper function to simplify the provider implementation.
+func NewSecretsEnvDataSource() datasource.DataSource {
+	return &secretsEnvDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// secretsEnvDataSource is the data source implementation.
+type secretsEnvDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *secretsEnvDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_env"
+}
+
+// Schema defines the schema for the data source.
+func (d *secretsEnvDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the encoded keys from environment variables and re-derives their identifiers.",
+		Attributes: map[string]schema.Attribute{
+			"validator_key_env": schema.StringAttribute{
+				Optional:    true,
+				Description: "Environment variable holding the encoded validator key. Defaults to `" + defaultValidatorKeyEnv + "`.",
+			},
+			"validator_bls_key_env": schema.StringAttribute{
+				Optional:    true,
+				Description: "Environment variable holding the encoded validator BLS key. Defaults to `" + defaultValidatorBLSKeyEnv + "`.",
+			},
+			"network_key_env": schema.StringAttribute{
+				Optional:    true,
+				Description: "Environment variable holding the encoded network key. Defaults to `" + defaultNetworkKeyEnv + "`.",
+			},
+			"validator_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded validator key.",
+			},
+			"validator_bls_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded validator BLS key.",
+			},
+			"network_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded network key.",
+			},
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator address.",
+			},
+			"bls_pubkey": schema.StringAttribute{
+				Computed:    true,
+				Description: "Validator BLS public key, hex encoded.",
+			},
+			"node_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Node ID.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *secretsEnvDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// Read reads the keys from the environment.
+func (d *secretsEnvDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state secretsEnvDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys := make([]encodedKey, 0, 3)
+	for _, source := range []struct {
+		attr       string
+		name       types.String
+		defaultEnv string
+	}{
+		{"validator_key_env", state.ValidatorKeyEnv, defaultValidatorKeyEnv},
+		{"validator_bls_key_env", state.ValidatorBLSKeyEnv, defaultValidatorBLSKeyEnv},
+		{"network_key_env", state.NetworkKeyEnv, defaultNetworkKeyEnv},
+	} {
+		name := source.defaultEnv
+		if !source.name.IsNull() {
+			name = source.name.ValueString()
+		}
+
+		value, ok := os.LookupEnv(name)
+		if !ok || value == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(source.attr),
+				"Missing environment variable",
+				fmt.Sprintf("The environment variable %s is not set.", name),
+			)
+			continue
+		}
+		keys = append(keys, encodedKey{value: []byte(value), attr: path.Root(source.attr)})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := deriveIdentifiers(keys[0], keys[1], keys[2])
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ValidatorKeyEncoded = types.StringValue(string(keys[0].value))
+	state.ValidatorBLSKeyEncoded = types.StringValue(string(keys[1].value))
+	state.NetworkKeyEncoded = types.StringValue(string(keys[2].value))
+	state.Address = types.StringValue(d.providerData.FormatAddressString(ids.Address))
+	state.BLSPubkey = types.StringValue(ids.BLSPubkey)
+	state.NodeID = types.StringValue(ids.NodeID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,55 @@
+package secrets
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSecretsEnvDataSource(t *testing.T) {
+	fixture := readSecretsFixture(t)
+	t.Setenv(defaultValidatorKeyEnv, *fixture.ValidatorKey)
+	t.Setenv("CUSTOM_BLS_KEY", *fixture.ValidatorBLSKey)
+	t.Setenv(defaultNetworkKeyEnv, *fixture.NetworkKey)
+
+	state := readDataSource(t, NewSecretsEnvDataSource(), map[string]tftypes.Value{
+		"validator_bls_key_env": tftypes.NewValue(tftypes.String, "CUSTOM_BLS_KEY"),
+	})
+	var model secretsEnvDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if !strings.EqualFold(model.Address.ValueString(), *fixture.Address) || model.BLSPubkey.ValueString() != *fixture.BLSPubkey ||
+		model.NodeID.ValueString() != *fixture.NodeID {
+		t.Errorf("identifiers = %s, %s, %s, want the fixture ones", model.Address, model.BLSPubkey, model.NodeID)
+	}
+	if model.ValidatorBLSKeyEncoded.ValueString() != *fixture.ValidatorBLSKey {
+		t.Errorf("validator_bls_key_encoded was not read from the configured variable")
+	}
+
+	// The encoded keys are redacted from plan output.
+	schemaResp := &datasource.SchemaResponse{}
+	NewSecretsEnvDataSource().Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	for _, name := range []string{"validator_key_encoded", "validator_bls_key_encoded", "network_key_encoded"} {
+		if !schemaResp.Schema.Attributes[name].IsSensitive() {
+			t.Errorf("attribute %s is not sensitive", name)
+		}
+	}
+}
+
+func TestSecretsEnvDataSourceUnset(t *testing.T) {
+	fixture := readSecretsFixture(t)
+	t.Setenv(defaultValidatorKeyEnv, *fixture.ValidatorKey)
+	t.Setenv(defaultValidatorBLSKeyEnv, *fixture.ValidatorBLSKey)
+	t.Setenv("UNSET_NETWORK_KEY", "")
+
+	_, diags := readDataSourceDiagnostics(t, NewSecretsEnvDataSource(), map[string]tftypes.Value{
+		"network_key_env": tftypes.NewValue(tftypes.String, "UNSET_NETWORK_KEY"),
+	})
+	if diags.ErrorsCount() != 1 || !strings.Contains(diags.Errors()[0].Detail(), "UNSET_NETWORK_KEY") {
+		t.Fatalf("expected an error naming UNSET_NETWORK_KEY, got %v", diags)
+	}
+}
@@ -0,0 +1,179 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+)
+
+// emptyOutputValue is what `polygon-edge secrets output` prints for a secret the node does not have.
+const emptyOutputValue = "<none>"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &secretsOutputDataSource{}
+	_ datasource.DataSourceWithConfigure      = &secretsOutputDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &secretsOutputDataSource{}
+)
+
+// secretsOutputDataSourceModel maps the data source schema data.
+type secretsOutputDataSourceModel struct {
+	Address   types.String `tfsdk:"address"`
+	BLSPubkey types.String `tfsdk:"bls_pubkey"`
+	NodeID    types.String `tfsdk:"node_id"`
+
+	Output types.String `tfsdk:"output"`
+	JSON   types.String `tfsdk:"json"`
+}
+
+// NewSecretsOutputDataSource is a helper function to simplify the provider implementation.
+func NewSecretsOutputDataSource() datasource.DataSource {
+	return &secretsOutputDataSource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// secretsOutputDataSource is the data source implementation.
+type secretsOutputDataSource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the data source type name.
+func (d *secretsOutputDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_output"
+}
+
+// Schema defines the schema for the data source.
+func (d *secretsOutputDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Formats the identifiers of a set of secrets exactly as `polygon-edge secrets output` prints them, " +
+			"with the same labels and order, so the output of a node inspected by hand can be diffed against Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Validator address, like the `address` of `polygonedge_secrets`.",
+			},
+			"bls_pubkey": schema.StringAttribute{
+				Optional:    true,
+				Description: "Validator BLS public key, hex encoded, like the `bls_pubkey` of `polygonedge_secrets`.",
+			},
+			"node_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Node ID, like the `node_id` of `polygonedge_secrets`.",
+			},
+			"output": schema.StringAttribute{
+				Computed: true,
+				Description: "Text printed by `polygon-edge secrets output`, including its leading empty line, `[SECRETS OUTPUT]` header and trailing newlines. " +
+					"Identifiers which are not set are printed as `" + emptyOutputValue + "`.",
+			},
+			"json": schema.StringAttribute{
+				Computed:    true,
+				Description: "JSON printed by `polygon-edge secrets output --json`, with its trailing newline. Identifiers which are not set are empty strings.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the data source.
+func (d *secretsOutputDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.providerData = data
+}
+
+// ValidateConfig ensures at least one identifier is set.
+func (d *secretsOutputDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config secretsOutputDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Address.IsNull() && config.BLSPubkey.IsNull() && config.NodeID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("address"),
+			"Missing identifiers",
+			"At least one of `address`, `bls_pubkey` and `node_id` must be set.",
+		)
+	}
+}
+
+// Read formats the identifiers.
+func (d *secretsOutputDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state secretsOutputDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := identifiers{
+		Address:   d.providerData.FormatAddressString(state.Address.ValueString()),
+		BLSPubkey: state.BLSPubkey.ValueString(),
+		NodeID:    state.NodeID.ValueString(),
+	}
+	encoded, err := secretsOutputJSON(ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to encode secrets output", err.Error())
+		return
+	}
+	state.Output = types.StringValue(secretsOutputText(ids))
+	state.JSON = types.StringValue(encoded)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// secretsOutputText formats the identifiers like `polygon-edge secrets output`. The labels are aligned in a column
+// and separated from their values by ` = `, the way the CLI formats key-value output.
+func secretsOutputText(ids identifiers) string {
+	rows := []struct{ label, value string }{
+		{"Public key (address)", ids.Address},
+		{"BLS Public key", ids.BLSPubkey},
+		{"Node ID", ids.NodeID},
+	}
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row.label))
+	}
+
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		value := row.value
+		if value == "" {
+			value = emptyOutputValue
+		}
+		lines[i] = fmt.Sprintf("%-*s = %s", width, row.label, value)
+	}
+	// The CLI ends the output with a newline and prints it with another one.
+	return "\n[SECRETS OUTPUT]\n" + strings.Join(lines, "\n") + "\n\n"
+}
+
+// secretsOutputJSON formats the identifiers like `polygon-edge secrets output --json`.
+func secretsOutputJSON(ids identifiers) (string, error) {
+	encoded, err := json.Marshal(struct {
+		Address   string `json:"address"`
+		BLSPubkey string `json:"bls"`
+		NodeID    string `json:"node_id"`
+	}{ids.Address, ids.BLSPubkey, ids.NodeID})
+	if err != nil {
+		return "", err
+	}
+	return string(encoded) + "\n", nil
+}
@@ -0,0 +1,71 @@
+package secrets
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// readSecretsOutput formats the identifiers of testdata/secrets.json, leaving out those not named. The address is
+// checksummed, as polygonedge_secrets and the CLI output it.
+func readSecretsOutput(t *testing.T, names ...string) secretsOutputDataSourceModel {
+	t.Helper()
+	file := readSecretsFixture(t)
+	values := map[string]string{
+		"address":    edgetypes.StringToAddress(*file.Address).String(),
+		"bls_pubkey": *file.BLSPubkey,
+		"node_id":    *file.NodeID,
+	}
+	attrs := make(map[string]tftypes.Value, len(names))
+	for _, name := range names {
+		attrs[name] = tftypes.NewValue(tftypes.String, values[name])
+	}
+
+	state := readDataSource(t, NewSecretsOutputDataSource(), attrs)
+	var model secretsOutputDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	return model
+}
+
+func TestSecretsOutputDataSource(t *testing.T) {
+	model := readSecretsOutput(t, "address", "bls_pubkey", "node_id")
+
+	// The output of `polygon-edge secrets output` and `polygon-edge secrets output --json` for the secrets of
+	// testdata/secrets.json, as captured from the CLI.
+	for name, tt := range map[string]struct{ got, fixture string }{
+		"output": {model.Output.ValueString(), "testdata/secrets_output.txt"},
+		"json":   {model.JSON.ValueString(), "testdata/secrets_output.json"},
+	} {
+		want, err := os.ReadFile(tt.fixture)
+		if err != nil {
+			t.Fatalf("unable to read fixture: %v", err)
+		}
+		if tt.got != string(want) {
+			t.Errorf("%s =\n%q\nwant\n%q", name, tt.got, want)
+		}
+	}
+}
+
+func TestSecretsOutputDataSourcePartial(t *testing.T) {
+	model := readSecretsOutput(t, "node_id")
+
+	output := model.Output.ValueString()
+	for _, line := range []string{
+		"Public key (address) = <none>",
+		"BLS Public key       = <none>",
+		"Node ID              = 16Uiu2HAm2ceCxUash63GbMjuzF1oXwMJuRnGnZggFcc1jHqcFW9a",
+	} {
+		if !strings.Contains(output, line+"\n") {
+			t.Errorf("output %q does not contain the line %q", output, line)
+		}
+	}
+	if want := `{"address":"","bls":"","node_id":"16Uiu2HAm2ceCxUash63GbMjuzF1oXwMJuRnGnZggFcc1jHqcFW9a"}` + "\n"; model.JSON.ValueString() != want {
+		t.Errorf("json = %q, want %q", model.JSON.ValueString(), want)
+	}
+}
@@ -2,14 +2,35 @@ package secrets
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/crypto"
-	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/coinbase/kryptology/pkg/signatures/bls/bls_sig"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/polybft"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -23,23 +44,74 @@ type secretsDataSourceModel struct {
 	ValidatorBLSKeyEncoded types.String `tfsdk:"validator_bls_key_encoded"`
 	NetworkKeyEncoded      types.String `tfsdk:"network_key_encoded"`
 
+	ValidatorKeyEncodedWO    types.String `tfsdk:"validator_key_encoded_wo"`
+	ValidatorBLSKeyEncodedWO types.String `tfsdk:"validator_bls_key_encoded_wo"`
+	NetworkKeyEncodedWO      types.String `tfsdk:"network_key_encoded_wo"`
+	KeysWOVersion            types.Int64  `tfsdk:"keys_wo_version"`
+	RotateBLSKey             types.Int64  `tfsdk:"rotate_bls_key"`
+	AddressPrefix            types.String `tfsdk:"address_prefix"`
+	AddressPrefixMaxAttempts types.Int64  `tfsdk:"address_prefix_max_attempts"`
+	KeyEncoding              types.String `tfsdk:"key_encoding"`
+	NetworkKeySeed           types.String `tfsdk:"network_key_seed"`
+	NetworkKeySeedWO         types.String `tfsdk:"network_key_seed_wo"`
+	NetworkKeySeedReference  types.String `tfsdk:"network_key_seed_reference"`
+	ValidatorKeyfile         types.String `tfsdk:"validator_keyfile"`
+	BLSKeyHex                types.String `tfsdk:"bls_key_hex"`
+	NetworkKeyLibp2p         types.String `tfsdk:"network_key_libp2p"`
+	HexPrefix                types.Bool   `tfsdk:"hex_prefix"`
+	PolyBFTChainID           types.Int64  `tfsdk:"polybft_chain_id"`
+	PolyBFTBLSKeyEncoded     types.String `tfsdk:"polybft_bls_key_encoded"`
+	PolyBFTRegistration      types.Object `tfsdk:"polybft_registration"`
+
 	Address   types.String `tfsdk:"address"`
 	BLSPubkey types.String `tfsdk:"bls_pubkey"`
 	NodeID    types.String `tfsdk:"node_id"`
+
+	RedactIdentifiers   types.Bool   `tfsdk:"redact_identifiers"`
+	RedactedIdentifiers types.Object `tfsdk:"redacted_identifiers"`
+}
+
+// Encodings of the generated keys stored in state, selected with `key_encoding`.
+const (
+	keyEncodingDefault = "default"
+	keyEncodingBase64  = "base64"
+)
+
+// redactedIdentifiersAttrTypes are the attribute types of the `redacted_identifiers` object.
+var redactedIdentifiersAttrTypes = map[string]attr.Type{
+	"address":    types.StringType,
+	"bls_pubkey": types.StringType,
+	"node_id":    types.StringType,
+}
+
+// polybftRegistrationAttrTypes are the attribute types of the `polybft_registration` object.
+var polybftRegistrationAttrTypes = map[string]attr.Type{
+	"bls_pubkey":        types.StringType,
+	"signature":         types.StringType,
+	"register_calldata": types.StringType,
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &secretsResource{}
+	_ resource.Resource                   = &secretsResource{}
+	_ resource.ResourceWithConfigure      = &secretsResource{}
+	_ resource.ResourceWithValidateConfig = &secretsResource{}
+	_ resource.ResourceWithModifyPlan     = &secretsResource{}
+	_ resource.ResourceWithUpgradeState   = &secretsResource{}
 )
 
 // NewSecretsResource is a helper function to simplify the provider implementation.
 func NewSecretsResource() resource.Resource {
-	return &secretsResource{}
+	return &secretsResource{
+		providerData: providerdata.Default(),
+		keys:         defaultKeyGenerator,
+	}
 }
 
 // secretsResource is the data source implementation.
 type secretsResource struct {
+	providerData providerdata.Data
+	keys         keySource
 }
 
 // Metadata returns the data source type name.
@@ -50,22 +122,153 @@ func (d *secretsResource) Metadata(_ context.Context, req resource.MetadataReque
 // Schema defines the schema for the data source.
 func (d *secretsResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Version: 1,
+		Version: 2,
+		Description: "Generates polygon-edge secrets, or derives the identifiers of supplied ones. " +
+			"The keys never change in place: changing a supplied key, `keys_wo_version`, `address_prefix`, `network_key_seed`, `key_encoding` " +
+			"or `redact_identifiers` replaces the resource and generates new keys for the ones which are not supplied. " +
+			"The only in-place change of the keys is the BLS key rotation triggered by `rotate_bls_key`, " +
+			"other attributes, like `address_prefix_max_attempts`, are updated without touching the keys.",
 		Attributes: map[string]schema.Attribute{
 			"validator_key_encoded": schema.StringAttribute{
-				Computed:    true,
-				Sensitive:   true,
-				Description: "Encoded validator key. Must be stored in a polygon-edge supported secrets manager.",
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+				Description: "Encoded validator key. Must be stored in a polygon-edge supported secrets manager. " +
+					"If set, the given key is used instead of generating a new one.",
 			},
 			"validator_bls_key_encoded": schema.StringAttribute{
-				Computed:    true,
-				Sensitive:   true,
-				Description: "Encoded validator BLS key. Must be stored in a polygon-edge supported secrets manager.",
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					validators.BLSKey(),
+				},
+				Description: "Encoded validator BLS key. Must be stored in a polygon-edge supported secrets manager. " +
+					"If set, the given key is used instead of generating a new one.",
 			},
 			"network_key_encoded": schema.StringAttribute{
-				Computed:    true,
-				Sensitive:   true,
-				Description: "Encoded network key. Must be stored in a polygon-edge supported secrets manager.",
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					validators.NetworkKey(),
+				},
+				Description: "Encoded network key. Must be stored in a polygon-edge supported secrets manager. " +
+					"If set, the given key is used instead of generating a new one.",
+			},
+			"validator_key_encoded_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+				Description: "Write-only variant of `validator_key_encoded`, the key is used to derive the outputs " +
+					"but is never stored in state. Requires Terraform 1.11 or later.",
+			},
+			"validator_bls_key_encoded_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					validators.BLSKey(),
+				},
+				Description: "Write-only variant of `validator_bls_key_encoded`, the key is used to derive the outputs " +
+					"but is never stored in state. Requires Terraform 1.11 or later.",
+			},
+			"network_key_encoded_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					validators.NetworkKey(),
+				},
+				Description: "Write-only variant of `network_key_encoded`, the key is used to derive the outputs " +
+					"but is never stored in state. Requires Terraform 1.11 or later.",
+			},
+			"keys_wo_version": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Version of the write-only keys and seed. Write-only values are not stored, so changes to them " +
+					"cannot be detected; change this value to replace the resource with the current write-only keys and seed.",
+			},
+			"rotate_bls_key": schema.Int64Attribute{
+				Optional: true,
+				Description: "BLS key rotation trigger. Changing this value generates a new validator BLS key in place, " +
+					"keeping the validator key, the network key and their identifiers. " +
+					"Conflicts with `validator_bls_key_encoded` and `validator_bls_key_encoded_wo`.",
+			},
+			"address_prefix": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.AddressPrefix(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Hex prefix the generated validator address must start with, compared case-insensitively. " +
+					"Validator keys are generated until the address matches, every additional character makes the search " +
+					"16 times longer. Conflicts with `validator_key_encoded` and `validator_key_encoded_wo`.",
+			},
+			"address_prefix_max_attempts": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("Maximum number of validator keys generated when searching for `address_prefix`. "+
+					"Defaults to %d, which finds a 4 character prefix with a high probability.", defaultVanityMaxAttempts),
+			},
+			"network_key_seed": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Seed the network key is derived from, of at least 32 bytes, so the node ID stays the same " +
+					"when the resource is replaced. The network key is derived the same way as by the `seed` of the " +
+					"`polygonedge_secrets` data source. Conflicts with `network_key_encoded` and `network_key_encoded_wo`.",
+			},
+			"network_key_seed_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Description: "Write-only variant of `network_key_seed`, the seed is used to derive the network key " +
+					"but is never stored in state, only its `network_key_seed_reference`. Requires Terraform 1.11 or later.",
+			},
+			"network_key_seed_reference": schema.StringAttribute{
+				Computed: true,
+				Description: "Reference of the seed the network key is derived from, a hash which does not reveal a random seed. " +
+					"A seed kept outside of Terraform can be checked against it before it is used to derive the same network key again. " +
+					"Only set when `network_key_seed` or `network_key_seed_wo` is.",
+			},
+			"key_encoding": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = keyEncoding(req.StateValue) != keyEncoding(req.PlanValue)
+						},
+						"Changing the key encoding requires replacement.",
+						"Changing the key encoding requires replacement.",
+					),
+				},
+				Description: "Encoding of the generated keys stored in `validator_key_encoded`, `validator_bls_key_encoded`, " +
+					"`network_key_encoded` and `polybft_bls_key_encoded`. `" + keyEncodingDefault + "` stores them as polygon-edge encodes them, `" +
+					keyEncodingBase64 + "` stores the base64 encoding of the polygon-edge encoded keys, " +
+					"which `base64decode` turns back into the polygon-edge form. Defaults to `" + keyEncodingDefault + "`. " +
+					"Supplied keys are stored as given, so `" + keyEncodingBase64 + "` conflicts with the key attributes; " +
+					"their write-only variants can be used instead.",
 			},
 			"address": schema.StringAttribute{
 				Computed:    true,
@@ -73,60 +276,577 @@ func (d *secretsResource) Schema(_ context.Context, req resource.SchemaRequest,
 			},
 			"bls_pubkey": schema.StringAttribute{
 				Computed:    true,
-				Description: "Validator public key.",
+				Description: "Validator BLS public key, hex encoded.",
 			},
 			"node_id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Node ID.",
 			},
+			"redact_identifiers": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.StateValue.ValueBool() != req.PlanValue.ValueBool()
+						},
+						"Changing whether identifiers are redacted requires replacement.",
+						"Changing whether identifiers are redacted requires replacement.",
+					),
+				},
+				Description: "Treat the derived identifiers as sensitive. When set, `address`, `bls_pubkey` and `node_id` " +
+					"are left empty and the values are only available in the sensitive `redacted_identifiers` attribute, " +
+					"so they are redacted in plan output and state diffs.",
+			},
+			"validator_keyfile": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				Description: "Validator private key as an unencrypted go-ethereum keyfile, the bare hex key on a single line, " +
+					"as read by `geth account import`. Not set when the validator key is supplied write-only.",
+			},
+			"bls_key_hex": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				Description: "Raw validator BLS secret key, the hex of the 32 byte big endian scalar, for tooling which expects " +
+					"the bare key rather than the polygon-edge encoding. Not set when the BLS key is supplied write-only.",
+			},
+			"network_key_libp2p": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				Description: "Network key in the standard libp2p marshalled form, the base64 of its protobuf encoding, as found in " +
+					"the `Identity.PrivKey` of IPFS configs and read by `crypto.UnmarshalPrivateKey` of go-libp2p, for generic " +
+					"libp2p tooling. It derives the same `node_id`. Not set when the network key is supplied write-only.",
+			},
+			"hex_prefix": schema.BoolAttribute{
+				Optional: true,
+				Description: "Prefix `bls_key_hex` with 0x. Defaults to true, like the hex keys taken by `polygonedge_bls_from_hex` " +
+					"and `polygonedge_ecdsa_from_hex`. Changing it updates `bls_key_hex` in place.",
+			},
+			"polybft_chain_id": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Chain ID of the PolyBFT child chain the validator registers on. If set, a PolyBFT validator BLS key " +
+					"is generated as well and `polybft_registration` is computed from it. The PolyBFT BLS key is separate from " +
+					"`validator_bls_key_encoded` and is not rotated by `rotate_bls_key`.",
+			},
+			"polybft_bls_key_encoded": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				Description: "Encoded PolyBFT validator BLS key, as produced by `polygon-edge polybft-secrets`, in the configured " +
+					"`key_encoding`. Only set when `polybft_chain_id` is.",
+			},
+			"polybft_registration": schema.SingleNestedAttribute{
+				Computed: true,
+				Description: "Registration of the validator on the child validator set contract, derived from the validator key " +
+					"and the PolyBFT BLS key. Only set when `polybft_chain_id` is.",
+				Attributes: map[string]schema.Attribute{
+					"bls_pubkey": schema.StringAttribute{
+						Computed:    true,
+						Description: "Validator PolyBFT BLS public key, hex encoded.",
+					},
+					"signature": schema.StringAttribute{
+						Computed:    true,
+						Description: "BLS proof of possession of the validator key for `polybft_chain_id`, hex encoded.",
+					},
+					"register_calldata": schema.StringAttribute{
+						Computed: true,
+						Description: "Hex encoded calldata of the `register` call, to be sent from `address` " +
+							"to the child validator set contract.",
+					},
+				},
+			},
+			"redacted_identifiers": schema.SingleNestedAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Derived identifiers, only set when `redact_identifiers` is enabled.",
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						Computed:    true,
+						Description: "Validator address.",
+					},
+					"bls_pubkey": schema.StringAttribute{
+						Computed:    true,
+						Description: "Validator BLS public key, hex encoded.",
+					},
+					"node_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "Node ID.",
+					},
+				},
+			},
 		},
 	}
 }
 
-func (d *secretsResource) Create(ctx context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
-	// Validator Key
-	validatorKey, validatorKeyEncoded, err := crypto.GenerateAndEncodeECDSAPrivateKey()
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to generate ECDSA key", err.Error())
+// Configure adds the provider data to the resource.
+func (d *secretsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
 		return
 	}
-	// Validator BLS key
-	blsSecretKey, blsSecretKeyEncoded, err := crypto.GenerateAndEncodeBLSSecretKey()
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to create generate BLS ket", err.Error())
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
 		return
 	}
+	d.providerData = data
+}
 
-	pubkeyBytes, err := crypto.BLSSecretKeyToPubkeyBytes(blsSecretKey)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to get BLS public key", err.Error())
+// ValidateConfig ensures a key is not supplied both as a regular and a write-only attribute,
+// that a supplied BLS key is not rotated, that a supplied validator key is not searched for a vanity address,
+// that a supplied network key is not derived from a seed and that the key encoding is known and only applies to generated keys.
+func (d *secretsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config secretsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Network key
-	libp2pKey, libp2pKeyEncoded, err := network.GenerateAndEncodeLibp2pKey()
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to generate network key", err.Error())
+	for name, values := range map[string][2]types.String{
+		"validator_key_encoded":     {config.ValidatorKeyEncoded, config.ValidatorKeyEncodedWO},
+		"validator_bls_key_encoded": {config.ValidatorBLSKeyEncoded, config.ValidatorBLSKeyEncodedWO},
+		"network_key_encoded":       {config.NetworkKeyEncoded, config.NetworkKeyEncodedWO},
+	} {
+		if !values[0].IsNull() && !values[1].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name+"_wo"),
+				"Conflicting key attributes",
+				fmt.Sprintf("Only one of `%s` and `%s_wo` can be set.", name, name),
+			)
+		}
+	}
+
+	if !config.RotateBLSKey.IsNull() && (!config.ValidatorBLSKeyEncoded.IsNull() || !config.ValidatorBLSKeyEncodedWO.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotate_bls_key"),
+			"Conflicting key attributes",
+			"A supplied BLS key cannot be rotated, `rotate_bls_key` conflicts with `validator_bls_key_encoded` and `validator_bls_key_encoded_wo`.",
+		)
+	}
+
+	if !config.AddressPrefix.IsNull() && (!config.ValidatorKeyEncoded.IsNull() || !config.ValidatorKeyEncodedWO.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("address_prefix"),
+			"Conflicting key attributes",
+			"The address of a supplied validator key cannot be chosen, `address_prefix` conflicts with `validator_key_encoded` and `validator_key_encoded_wo`.",
+		)
+	}
+	if !config.NetworkKeySeed.IsNull() && !config.NetworkKeySeedWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("network_key_seed_wo"),
+			"Conflicting key attributes",
+			"Only one of `network_key_seed` and `network_key_seed_wo` can be set.",
+		)
+	}
+	for name, seed := range map[string]types.String{
+		"network_key_seed":    config.NetworkKeySeed,
+		"network_key_seed_wo": config.NetworkKeySeedWO,
+	} {
+		if seed.IsNull() {
+			continue
+		}
+		if !config.NetworkKeyEncoded.IsNull() || !config.NetworkKeyEncodedWO.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Conflicting key attributes",
+				fmt.Sprintf("A supplied network key cannot be derived from a seed, `%s` conflicts with `network_key_encoded` and `network_key_encoded_wo`.", name),
+			)
+		}
+		if seed.IsUnknown() {
+			continue
+		}
+		if err := validateSeed([]byte(seed.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid seed", err.Error()+".")
+		}
+	}
+	if !config.PolyBFTChainID.IsNull() && !config.PolyBFTChainID.IsUnknown() && config.PolyBFTChainID.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("polybft_chain_id"),
+			"Invalid chain id",
+			"The PolyBFT chain id must be greater than zero.",
+		)
+	}
+	if !config.AddressPrefixMaxAttempts.IsNull() && !config.AddressPrefixMaxAttempts.IsUnknown() && config.AddressPrefixMaxAttempts.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("address_prefix_max_attempts"),
+			"Invalid attempt limit",
+			"The vanity address attempt limit must be greater than zero.",
+		)
+	}
+
+	if config.KeyEncoding.IsUnknown() {
 		return
 	}
+	switch encoding := keyEncoding(config.KeyEncoding); encoding {
+	case keyEncodingDefault:
+	case keyEncodingBase64:
+		if !config.ValidatorKeyEncoded.IsNull() || !config.ValidatorBLSKeyEncoded.IsNull() || !config.NetworkKeyEncoded.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("key_encoding"),
+				"Conflicting key attributes",
+				"Supplied keys are stored as given and cannot be base64 encoded, use the write-only key attributes instead.",
+			)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("key_encoding"),
+			"Invalid key encoding",
+			fmt.Sprintf("The key encoding must be %q or %q, got: %q.", keyEncodingDefault, keyEncodingBase64, encoding),
+		)
+	}
+}
 
-	nodeID, err := peer.IDFromPrivateKey(libp2pKey)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to get nodeID", err.Error())
+// ModifyPlan keeps the keys and identifiers of an updated resource, unless `rotate_bls_key` changes,
+// in which case the BLS key and public key are regenerated. The address is reformatted when the provider
+// `address_format` changes.
+func (d *secretsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to keep on create and destroy, and a replacement generates new keys anyway.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || len(resp.RequiresReplace) > 0 {
+		return
+	}
+
+	var state, plan secretsDataSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ValidatorKeyEncoded = state.ValidatorKeyEncoded
+	plan.NetworkKeyEncoded = state.NetworkKeyEncoded
+	plan.Address = state.Address
+	plan.NodeID = state.NodeID
+	plan.ValidatorBLSKeyEncoded = state.ValidatorBLSKeyEncoded
+	plan.BLSPubkey = state.BLSPubkey
+	plan.RedactedIdentifiers = state.RedactedIdentifiers
+	plan.ValidatorKeyfile = state.ValidatorKeyfile
+	plan.BLSKeyHex = state.BLSKeyHex
+	plan.NetworkKeyLibp2p = state.NetworkKeyLibp2p
+	plan.NetworkKeySeedReference = state.NetworkKeySeedReference
+	if !state.BLSKeyHex.IsNull() {
+		plan.BLSKeyHex = types.StringValue(setHexPrefix(state.BLSKeyHex.ValueString(), hexPrefix(plan.HexPrefix)))
+	}
+	plan.PolyBFTBLSKeyEncoded = state.PolyBFTBLSKeyEncoded
+	plan.PolyBFTRegistration = state.PolyBFTRegistration
+	resp.Diagnostics.Append(d.formatAddress(&plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	diags := resp.State.Set(ctx, &secretsDataSourceModel{
-		ValidatorKeyEncoded:    types.StringValue(string(validatorKeyEncoded)),
-		Address:                types.StringValue(crypto.PubKeyToAddress(&validatorKey.PublicKey).String()),
-		ValidatorBLSKeyEncoded: types.StringValue(string(blsSecretKeyEncoded)),
-		BLSPubkey:              types.StringValue(string(pubkeyBytes)),
-		NetworkKeyEncoded:      types.StringValue(string(libp2pKeyEncoded)),
-		NodeID:                 types.StringValue(nodeID.String()),
-	})
+	if !plan.RotateBLSKey.Equal(state.RotateBLSKey) {
+		plan.ValidatorBLSKeyEncoded = types.StringUnknown()
+		plan.BLSKeyHex = types.StringUnknown()
+		if plan.RedactIdentifiers.ValueBool() {
+			plan.RedactedIdentifiers = types.ObjectUnknown(redactedIdentifiersAttrTypes)
+		} else {
+			plan.BLSPubkey = types.StringUnknown()
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("rotate_bls_key"),
+			"BLS key rotation",
+			"The validator BLS key will be regenerated. If the validator is registered with a staked BLS public key, "+
+				"it must be re-registered on-chain with the new BLS public key.",
+		)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (d *secretsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config secretsDataSourceModel
+	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	// Write-only values are always null in the plan, they are only available in the config.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Keys which are not supplied are generated. The identifiers of randomly generated keys are computed from the
+	// keys themselves, to check them against the ones derived from the encoded keys.
+	var (
+		generated identifiers
+		err       error
+	)
+	validatorKey, validatorKeyPath, ok := suppliedKey(plan.ValidatorKeyEncoded, config.ValidatorKeyEncodedWO, "validator_key_encoded")
+	switch {
+	case ok:
+	case !plan.AddressPrefix.IsNull():
+		maxAttempts := int64(defaultVanityMaxAttempts)
+		if !plan.AddressPrefixMaxAttempts.IsNull() {
+			maxAttempts = plan.AddressPrefixMaxAttempts.ValueInt64()
+		}
+		var attempts int64
+		start := time.Now()
+		validatorKey, attempts, err = d.keys.vanityValidatorKey(ctx, plan.AddressPrefix.ValueString(), maxAttempts)
+		if errors.Is(err, errVanityAttemptsExhausted) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("address_prefix"),
+				"Vanity address not found",
+				fmt.Sprintf("No validator address starting with %s was found in %d attempts. "+
+					"Increase `address_prefix_max_attempts` or use a shorter prefix.", plan.AddressPrefix.ValueString(), attempts),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyGeneration(diagnostics.ValidatorKey, err))
+			return
+		}
+		tflog.Debug(ctx, "Generated vanity validator key", map[string]interface{}{"attempts": attempts})
+		logKeyGeneration(ctx, diagnostics.ValidatorKey, start, validatorKey)
+	default:
+		var key *ecdsa.PrivateKey
+		start := time.Now()
+		if key, validatorKey, err = d.keys.validatorKey(); err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyGeneration(diagnostics.ValidatorKey, err))
+			return
+		}
+		logKeyGeneration(ctx, diagnostics.ValidatorKey, start, validatorKey)
+		generated.Address = crypto.PubKeyToAddress(&key.PublicKey).String()
+	}
+	blsKey, blsKeyPath, ok := suppliedKey(plan.ValidatorBLSKeyEncoded, config.ValidatorBLSKeyEncodedWO, "validator_bls_key_encoded")
+	if !ok {
+		var key *bls_sig.SecretKey
+		start := time.Now()
+		if key, blsKey, err = d.keys.blsKey(); err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyGeneration(diagnostics.BLSKey, err))
+			return
+		}
+		logKeyGeneration(ctx, diagnostics.BLSKey, start, blsKey)
+		pubkeyBytes, err := crypto.BLSSecretKeyToPubkeyBytes(key)
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyDerivation(diagnostics.BLSKey, "BLS public key", err))
+			return
+		}
+		generated.BLSPubkey = hex.EncodeToHex(pubkeyBytes)
+	}
+	plan.NetworkKeySeedReference = types.StringNull()
+	networkKey, networkKeyPath, ok := suppliedKey(plan.NetworkKeyEncoded, config.NetworkKeyEncodedWO, "network_key_encoded")
+	seed, _, seeded := suppliedKey(plan.NetworkKeySeed, config.NetworkKeySeedWO, "network_key_seed")
+	switch {
+	case ok:
+	case seeded:
+		if networkKey, err = deriveSeededNetworkKey(seed); err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyGeneration(diagnostics.NetworkKey, err))
+			return
+		}
+		plan.NetworkKeySeedReference = types.StringValue(seedReference(seed))
+	default:
+		var key libp2pcrypto.PrivKey
+		start := time.Now()
+		if key, networkKey, err = d.keys.networkKey(); err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyGeneration(diagnostics.NetworkKey, err))
+			return
+		}
+		logKeyGeneration(ctx, diagnostics.NetworkKey, start, networkKey)
+		nodeID, err := peer.IDFromPrivateKey(key)
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyDerivation(diagnostics.NetworkKey, "node ID", err))
+			return
+		}
+		generated.NodeID = nodeID.String()
+	}
+
+	ids, diags := deriveIdentifiers(
+		encodedKey{value: validatorKey, attr: validatorKeyPath},
+		encodedKey{value: blsKey, attr: blsKeyPath},
+		encodedKey{value: networkKey, attr: networkKeyPath},
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkRoundTrip(generated, ids)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ValidatorKeyEncoded = encodedKeyState(validatorKey, config.ValidatorKeyEncodedWO, plan.KeyEncoding)
+	plan.ValidatorKeyfile = types.StringNull()
+	if config.ValidatorKeyEncodedWO.IsNull() {
+		keyfile, err := validatorKeyfile(validatorKey)
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyParse(validatorKeyPath, diagnostics.ValidatorKey, err))
+			return
+		}
+		plan.ValidatorKeyfile = types.StringValue(keyfile)
+	}
+	plan.ValidatorBLSKeyEncoded = encodedKeyState(blsKey, config.ValidatorBLSKeyEncodedWO, plan.KeyEncoding)
+	plan.BLSKeyHex = types.StringNull()
+	if config.ValidatorBLSKeyEncodedWO.IsNull() {
+		keyHex, diags := checkedBLSKeyHex(blsKey, blsKeyPath, ids.BLSPubkey, hexPrefix(plan.HexPrefix))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.BLSKeyHex = types.StringValue(keyHex)
+	}
+	plan.NetworkKeyEncoded = encodedKeyState(networkKey, config.NetworkKeyEncodedWO, plan.KeyEncoding)
+	plan.NetworkKeyLibp2p = types.StringNull()
+	if config.NetworkKeyEncodedWO.IsNull() {
+		marshalled, diags := checkedNetworkKeyLibp2p(networkKey, networkKeyPath, ids.NodeID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.NetworkKeyLibp2p = types.StringValue(marshalled)
+	}
+
+	plan.PolyBFTBLSKeyEncoded = types.StringNull()
+	plan.PolyBFTRegistration = types.ObjectNull(polybftRegistrationAttrTypes)
+	if !plan.PolyBFTChainID.IsNull() {
+		start := time.Now()
+		polybftKey, key, err := d.keys.polybftBLSKey()
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyGeneration(diagnostics.PolyBFTBLSKey, err))
+			return
+		}
+		logKeyGeneration(ctx, diagnostics.PolyBFTBLSKey, start, polybftKey)
+		registration, err := polybft.NewRegistration(key, edgetypes.StringToAddress(ids.Address), plan.PolyBFTChainID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to compute PolyBFT registration", err.Error())
+			return
+		}
+		plan.PolyBFTBLSKeyEncoded = encodedKeyState(polybftKey, types.StringNull(), plan.KeyEncoding)
+		plan.PolyBFTRegistration, diags = types.ObjectValue(polybftRegistrationAttrTypes, map[string]attr.Value{
+			"bls_pubkey":        types.StringValue(hex.EncodeToHex(registration.BLSPubkey)),
+			"signature":         types.StringValue(hex.EncodeToHex(registration.Signature)),
+			"register_calldata": types.StringValue(hex.EncodeToHex(registration.RegisterCalldata)),
+		})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	address := types.StringValue(d.providerData.FormatAddressString(ids.Address))
+	blsPubkey := types.StringValue(ids.BLSPubkey)
+	nodeIDValue := types.StringValue(ids.NodeID)
+	if plan.RedactIdentifiers.ValueBool() {
+		plan.Address = types.StringNull()
+		plan.BLSPubkey = types.StringNull()
+		plan.NodeID = types.StringNull()
+		plan.RedactedIdentifiers, diags = types.ObjectValue(redactedIdentifiersAttrTypes, map[string]attr.Value{
+			"address":    address,
+			"bls_pubkey": blsPubkey,
+			"node_id":    nodeIDValue,
+		})
+		resp.Diagnostics.Append(diags...)
+	} else {
+		plan.Address = address
+		plan.BLSPubkey = blsPubkey
+		plan.NodeID = nodeIDValue
+		plan.RedactedIdentifiers = types.ObjectNull(redactedIdentifiersAttrTypes)
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// formatAddress formats the address of the resource in the provider address format, within `redacted_identifiers`
+// when identifiers are redacted. The address is unknown while the format is.
+func (d *secretsResource) formatAddress(m *secretsDataSourceModel) diag.Diagnostics {
+	format := func(address types.String) types.String {
+		switch {
+		case address.IsNull() || address.IsUnknown():
+			return address
+		case d.providerData.AddressFormatUnknown:
+			return types.StringUnknown()
+		}
+		return types.StringValue(d.providerData.FormatAddress(edgetypes.StringToAddress(address.ValueString())))
+	}
+
+	m.Address = format(m.Address)
+	if m.RedactedIdentifiers.IsNull() || m.RedactedIdentifiers.IsUnknown() {
+		return nil
+	}
+	redacted := m.RedactedIdentifiers.Attributes()
+	address, ok := redacted["address"].(types.String)
+	if !ok {
+		return nil
+	}
+	redacted["address"] = format(address)
+	var diags diag.Diagnostics
+	m.RedactedIdentifiers, diags = types.ObjectValue(redactedIdentifiersAttrTypes, redacted)
+	return diags
+}
+
+// suppliedKey returns the key material supplied through either the regular or the write-only attribute,
+// together with the attribute it was supplied with. It returns false if no key is supplied.
+func suppliedKey(value, writeOnly types.String, name string) ([]byte, path.Path, bool) {
+	if !writeOnly.IsNull() && !writeOnly.IsUnknown() {
+		return []byte(writeOnly.ValueString()), path.Root(name + "_wo"), true
+	}
+	if !value.IsNull() && !value.IsUnknown() {
+		return []byte(value.ValueString()), path.Root(name), true
+	}
+	return nil, path.Root(name), false
+}
+
+// encodedKeyState returns the value of an encoded key attribute to store in state, in the configured key encoding.
+// Keys supplied through a write-only attribute are never stored.
+func encodedKeyState(encoded []byte, writeOnly, encoding types.String) types.String {
+	if !writeOnly.IsNull() {
+		return types.StringNull()
+	}
+	if keyEncoding(encoding) == keyEncodingBase64 {
+		return types.StringValue(base64.StdEncoding.EncodeToString(encoded))
+	}
+	return types.StringValue(string(encoded))
+}
+
+// hexPrefix returns whether raw hex keys are 0x prefixed, which they are unless disabled.
+func hexPrefix(prefix types.Bool) bool {
+	return prefix.IsNull() || prefix.IsUnknown() || prefix.ValueBool()
+}
+
+// checkedBLSKeyHex returns the raw hex of the BLS key, after checking it imports back to the given public key.
+func checkedBLSKeyHex(blsKey []byte, attr path.Path, blsPubkey string, prefix bool) (string, diag.Diagnostics) {
+	keyHex, err := blsKeyHex(blsKey, prefix)
+	if err != nil {
+		return "", diag.Diagnostics{diagnostics.KeyParse(attr, diagnostics.BLSKey, err)}
+	}
+	imported, diags := deriveBLSPubkey(encodedKey{value: []byte(setHexPrefix(keyHex, false)), attr: attr})
+	if diags.HasError() {
+		return "", diags
+	}
+	if imported != blsPubkey {
+		diags.Append(diagnostics.KeyRoundTrip(diagnostics.BLSKey, "BLS public key", blsPubkey, imported))
+	}
+	return keyHex, diags
+}
+
+// checkedNetworkKeyLibp2p returns the libp2p marshalled form of the network key, after checking it unmarshals back to
+// a key of the given node ID.
+func checkedNetworkKeyLibp2p(networkKey []byte, attr path.Path, nodeID string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	marshalled, err := networkKeyLibp2p(networkKey)
+	if err != nil {
+		diags.Append(diagnostics.KeyParse(attr, diagnostics.NetworkKey, err))
+		return "", diags
+	}
+	imported, err := libp2pNodeID(marshalled)
+	if err != nil {
+		diags.Append(diagnostics.KeyDerivation(diagnostics.NetworkKey, "node ID", err))
+		return "", diags
+	}
+	if imported != nodeID {
+		diags.Append(diagnostics.KeyRoundTrip(diagnostics.NetworkKey, "node ID", nodeID, imported))
+	}
+	return marshalled, diags
+}
+
+// keyEncoding returns the configured key encoding, or the default one if it is not set.
+func keyEncoding(encoding types.String) string {
+	if encoding.IsNull() || encoding.IsUnknown() {
+		return keyEncodingDefault
+	}
+	return encoding.ValueString()
 }
 
 func (d *secretsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -135,9 +855,141 @@ func (d *secretsResource) Read(ctx context.Context, request resource.ReadRequest
 }
 
 func (d *secretsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
-	// NO-OP: since this resource cannot change
+	// Only the BLS key can change, when `rotate_bls_key` does, and the format of the address. Otherwise carry over
+	// settings which do not affect the keys, like an unset `redact_identifiers` becoming false.
+	var state, plan secretsDataSourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(d.formatAddress(&state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RotateBLSKey.Equal(state.RotateBLSKey) {
+		start := time.Now()
+		_, blsKey, err := d.keys.blsKey()
+		if err != nil {
+			response.Diagnostics.Append(diagnostics.KeyGeneration(diagnostics.BLSKey, err))
+			return
+		}
+		logKeyGeneration(ctx, diagnostics.BLSKey, start, blsKey)
+		blsPubkey, diags := deriveBLSPubkey(encodedKey{value: blsKey, attr: path.Root("validator_bls_key_encoded")})
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		state.ValidatorBLSKeyEncoded = encodedKeyState(blsKey, types.StringNull(), state.KeyEncoding)
+		keyHex, diags := checkedBLSKeyHex(blsKey, path.Root("validator_bls_key_encoded"), blsPubkey, hexPrefix(plan.HexPrefix))
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		state.BLSKeyHex = types.StringValue(keyHex)
+		if state.RedactIdentifiers.ValueBool() {
+			redacted := state.RedactedIdentifiers.Attributes()
+			redacted["bls_pubkey"] = types.StringValue(blsPubkey)
+			state.RedactedIdentifiers, diags = types.ObjectValue(redactedIdentifiersAttrTypes, redacted)
+			response.Diagnostics.Append(diags...)
+		} else {
+			state.BLSPubkey = types.StringValue(blsPubkey)
+		}
+		tflog.Debug(ctx, "Rotated validator BLS key")
+	}
+
+	if !state.BLSKeyHex.IsNull() {
+		state.BLSKeyHex = types.StringValue(setHexPrefix(state.BLSKeyHex.ValueString(), hexPrefix(plan.HexPrefix)))
+	}
+	state.HexPrefix = plan.HexPrefix
+	state.RedactIdentifiers = plan.RedactIdentifiers
+	state.RotateBLSKey = plan.RotateBLSKey
+	state.AddressPrefixMaxAttempts = plan.AddressPrefixMaxAttempts
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
 func (d *secretsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	tflog.Debug(ctx, "Removing secrets from state")
 }
+
+// secretsResourceModelV1 maps the state of version 1 of the resource, which stored the raw bytes of the BLS public key
+// in `bls_pubkey`.
+type secretsResourceModelV1 struct {
+	ValidatorKeyEncoded    types.String `tfsdk:"validator_key_encoded"`
+	ValidatorBLSKeyEncoded types.String `tfsdk:"validator_bls_key_encoded"`
+	NetworkKeyEncoded      types.String `tfsdk:"network_key_encoded"`
+	Address                types.String `tfsdk:"address"`
+	BLSPubkey              types.String `tfsdk:"bls_pubkey"`
+	NodeID                 types.String `tfsdk:"node_id"`
+}
+
+// UpgradeState upgrades the state of previous versions of the resource.
+func (d *secretsResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	computed := func(sensitive bool) schema.StringAttribute {
+		return schema.StringAttribute{Computed: true, Sensitive: sensitive}
+	}
+	return map[int64]resource.StateUpgrader{
+		1: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"validator_key_encoded":     computed(true),
+					"validator_bls_key_encoded": computed(true),
+					"network_key_encoded":       computed(true),
+					"address":                   computed(false),
+					"bls_pubkey":                computed(false),
+					"node_id":                   computed(false),
+				},
+			},
+			StateUpgrader: d.upgradeStateV1,
+		},
+	}
+}
+
+// upgradeStateV1 hex encodes `bls_pubkey`, and computes the attributes added since version 1 from the keys.
+// The BLS public key is derived again from the BLS key, as its raw bytes may not survive the JSON encoding of the state.
+func (d *secretsResource) upgradeStateV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior secretsResourceModelV1
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validatorKey := []byte(prior.ValidatorKeyEncoded.ValueString())
+	blsKey := []byte(prior.ValidatorBLSKeyEncoded.ValueString())
+	blsKeyPath := path.Root("validator_bls_key_encoded")
+	blsPubkey, diags := deriveBLSPubkey(encodedKey{value: blsKey, attr: blsKeyPath})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keyfile, err := validatorKeyfile(validatorKey)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("validator_key_encoded"), diagnostics.ValidatorKey, err))
+		return
+	}
+	keyHex, diags := checkedBLSKeyHex(blsKey, blsKeyPath, blsPubkey, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	marshalled, diags := checkedNetworkKeyLibp2p([]byte(prior.NetworkKeyEncoded.ValueString()), path.Root("network_key_encoded"), prior.NodeID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := secretsDataSourceModel{
+		ValidatorKeyEncoded:    prior.ValidatorKeyEncoded,
+		ValidatorBLSKeyEncoded: prior.ValidatorBLSKeyEncoded,
+		NetworkKeyEncoded:      prior.NetworkKeyEncoded,
+		ValidatorKeyfile:       types.StringValue(keyfile),
+		BLSKeyHex:              types.StringValue(keyHex),
+		NetworkKeyLibp2p:       types.StringValue(marshalled),
+		PolyBFTRegistration:    types.ObjectNull(polybftRegistrationAttrTypes),
+		Address:                prior.Address,
+		BLSPubkey:              types.StringValue(blsPubkey),
+		NodeID:                 prior.NodeID,
+		RedactedIdentifiers:    types.ObjectNull(redactedIdentifiersAttrTypes),
+	}
+	tflog.Debug(ctx, "Upgraded secrets state from version 1")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
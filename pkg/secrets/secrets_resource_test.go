@@ -0,0 +1,911 @@
+package secrets
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/network"
+	edgetypes "github.com/0xPolygon/polygon-edge/types"
+	"github.com/coinbase/kryptology/pkg/signatures/bls/bls_sig"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/umbracle/ethgo/wallet"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/polybft"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+)
+
+// newConfiguredSecretsResource returns a secrets resource configured with the given provider data.
+func newConfiguredSecretsResource(t *testing.T, data providerdata.Data) resource.Resource {
+	t.Helper()
+	r := NewSecretsResource()
+	resp := &resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{ProviderData: data}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", resp.Diagnostics)
+	}
+	return r
+}
+
+// createSecrets creates the resource with the given attributes set in its configuration, the others null.
+// Write-only attributes are only set in the configuration, as Terraform does.
+func createSecrets(t *testing.T, r resource.Resource, attrs map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	state, diags := createSecretsDiagnostics(t, r, attrs)
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+	return state
+}
+
+// createSecretsDiagnostics creates the resource like createSecrets, and returns its state and diagnostics.
+func createSecretsDiagnostics(t *testing.T, r resource.Resource, attrs map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	plan := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		config[name] = tftypes.NewValue(typ, nil)
+		plan[name] = tftypes.NewValue(typ, nil)
+		if schemaResp.Schema.Attributes[name].IsComputed() {
+			plan[name] = tftypes.NewValue(typ, tftypes.UnknownValue)
+		}
+	}
+	for name, value := range attrs {
+		config[name] = value
+		if !schemaResp.Schema.Attributes[name].IsWriteOnly() {
+			plan[name] = value
+		}
+	}
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, config)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, plan)},
+	}, resp)
+	return resp.State, resp.Diagnostics
+}
+
+// planSecrets plans the resource with an unchanged configuration.
+func planSecrets(t *testing.T, r resource.Resource, state tfsdk.State) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	planResp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}}
+	r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw.Copy()},
+		State:  state,
+		Plan:   tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()},
+	}, planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %v", planResp.Diagnostics)
+	}
+	return planResp.Plan
+}
+
+// updateSecrets applies the plan to the resource.
+func updateSecrets(t *testing.T, r resource.Resource, state tfsdk.State, plan tfsdk.Plan) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()}}
+	r.Update(ctx, resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: state.Schema, Raw: plan.Raw.Copy()},
+		State:  state,
+		Plan:   plan,
+	}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", updateResp.Diagnostics)
+	}
+	return updateResp.State
+}
+
+// stateAddress returns the address of the resource, read from `redacted_identifiers` when identifiers are redacted.
+func stateAddress(t *testing.T, state tfsdk.State, redacted bool) string {
+	t.Helper()
+	var model secretsDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if redacted {
+		return model.RedactedIdentifiers.Attributes()["address"].String()
+	}
+	return model.Address.ValueString()
+}
+
+func TestSecretsResourceWriteOnlyKeys(t *testing.T) {
+	_, blsKey, err := crypto.GenerateAndEncodeBLSSecretKey()
+	if err != nil {
+		t.Fatalf("unable to generate BLS key: %v", err)
+	}
+	networkKey, networkKeyEncoded, err := network.GenerateAndEncodeLibp2pKey()
+	if err != nil {
+		t.Fatalf("unable to generate network key: %v", err)
+	}
+	nodeID, err := peer.IDFromPrivateKey(networkKey)
+	if err != nil {
+		t.Fatalf("unable to derive node ID: %v", err)
+	}
+	// The first Hardhat development account.
+	const validatorKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+	state := createSecrets(t, newConfiguredSecretsResource(t, providerdata.Default()), map[string]tftypes.Value{
+		"validator_key_encoded_wo":     tftypes.NewValue(tftypes.String, validatorKey),
+		"validator_bls_key_encoded_wo": tftypes.NewValue(tftypes.String, string(blsKey)),
+		"network_key_encoded_wo":       tftypes.NewValue(tftypes.String, string(networkKeyEncoded)),
+	})
+	var model secretsDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	// The outputs are derived from the write-only keys, which are absent from the state.
+	if got, want := model.Address.ValueString(), "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"; got != want {
+		t.Errorf("address = %s, want %s", got, want)
+	}
+	if got, want := model.NodeID.ValueString(), nodeID.String(); got != want {
+		t.Errorf("node_id = %s, want %s", got, want)
+	}
+	for name, key := range map[string]string{
+		"validator_key_encoded":     validatorKey,
+		"validator_bls_key_encoded": string(blsKey),
+		"network_key_encoded":       string(networkKeyEncoded),
+	} {
+		for _, attr := range []string{name, name + "_wo"} {
+			value, err := state.Raw.ApplyTerraform5AttributePathStep(tftypes.AttributeName(attr))
+			if err != nil || !value.(tftypes.Value).IsNull() {
+				t.Errorf("%s = %v, want null", attr, value)
+			}
+		}
+		if strings.Contains(state.Raw.String(), key) {
+			t.Errorf("the state contains the write-only %s", name)
+		}
+	}
+}
+
+func TestSecretsResourceRedactIdentifiers(t *testing.T) {
+	for _, redacted := range []bool{false, true} {
+		state := createSecrets(t, newConfiguredSecretsResource(t, providerdata.Default()), map[string]tftypes.Value{
+			"redact_identifiers": tftypes.NewValue(tftypes.Bool, redacted),
+		})
+		var model secretsDataSourceModel
+		if diags := state.Get(context.Background(), &model); diags.HasError() {
+			t.Fatalf("unable to get state: %v", diags)
+		}
+
+		plain := []types.String{model.Address, model.BLSPubkey, model.NodeID}
+		for _, id := range plain {
+			if id.IsNull() != redacted {
+				t.Errorf("redact_identifiers %t: identifier %s, want null: %t", redacted, id, redacted)
+			}
+		}
+		if model.RedactedIdentifiers.IsNull() == redacted {
+			t.Fatalf("redact_identifiers %t: redacted_identifiers %s, want null: %t", redacted, model.RedactedIdentifiers, !redacted)
+		}
+		if redacted {
+			for name, id := range model.RedactedIdentifiers.Attributes() {
+				if id.(types.String).ValueString() == "" {
+					t.Errorf("redacted identifier %s is empty", name)
+				}
+			}
+		}
+	}
+
+	// Only the redacted identifiers are hidden from plan output.
+	resp := &resource.SchemaResponse{}
+	NewSecretsResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+	for name, sensitive := range map[string]bool{"address": false, "bls_pubkey": false, "node_id": false, "redacted_identifiers": true} {
+		if resp.Schema.Attributes[name].IsSensitive() != sensitive {
+			t.Errorf("attribute %s is sensitive: %t, want %t", name, !sensitive, sensitive)
+		}
+	}
+}
+
+func TestSecretsResourceAddressFormat(t *testing.T) {
+	for _, redacted := range []bool{false, true} {
+		name := "plain"
+		if redacted {
+			name = "redacted"
+		}
+		t.Run(name, func(t *testing.T) {
+			state := createSecrets(t, newConfiguredSecretsResource(t, providerdata.Default()), map[string]tftypes.Value{
+				"redact_identifiers": tftypes.NewValue(tftypes.Bool, redacted),
+			})
+			checksummed := strings.Trim(stateAddress(t, state, redacted), `"`)
+			if checksummed == strings.ToLower(checksummed) {
+				t.Fatalf("created address %s is not checksummed", checksummed)
+			}
+
+			lowercase := providerdata.Default()
+			lowercase.AddressFormat = providerdata.AddressFormatLowercase
+			r := newConfiguredSecretsResource(t, lowercase)
+			plan := planSecrets(t, r, state)
+			if plan.Raw.Equal(state.Raw) {
+				t.Error("changing the address format planned no change")
+			}
+			updated := updateSecrets(t, r, state, plan)
+			if !updated.Raw.Equal(plan.Raw) {
+				t.Error("the updated state differs from the plan")
+			}
+			if got := strings.Trim(stateAddress(t, updated, redacted), `"`); got != strings.ToLower(checksummed) {
+				t.Errorf("updated address = %s, want %s", got, strings.ToLower(checksummed))
+			}
+
+			unknown := providerdata.Default()
+			unknown.AddressFormatUnknown = true
+			plan = planSecrets(t, newConfiguredSecretsResource(t, unknown), state)
+			if !redacted {
+				var model secretsDataSourceModel
+				if diags := plan.Get(context.Background(), &model); diags.HasError() {
+					t.Fatalf("unable to get plan: %v", diags)
+				}
+				if !model.Address.IsUnknown() {
+					t.Errorf("planned address = %s with an unknown format, want unknown", model.Address)
+				}
+			}
+
+			plan = planSecrets(t, newConfiguredSecretsResource(t, providerdata.Default()), state)
+			if !plan.Raw.Equal(state.Raw) {
+				t.Error("an unchanged address format planned a change")
+			}
+		})
+	}
+}
+
+func TestSecretsResourceUpgradeStateV1(t *testing.T) {
+	ctx := context.Background()
+	validatorKey, blsKey, networkKey, diags := deriveSeededKeys(testSeed)
+	if diags.HasError() {
+		t.Fatalf("unable to derive keys: %v", diags)
+	}
+	ids, diags := deriveIdentifiers(
+		encodedKey{value: validatorKey}, encodedKey{value: blsKey}, encodedKey{value: networkKey},
+	)
+	if diags.HasError() {
+		t.Fatalf("unable to derive identifiers: %v", diags)
+	}
+	rawPubkey, err := hex.DecodeString(strings.TrimPrefix(ids.BLSPubkey, "0x"))
+	if err != nil {
+		t.Fatalf("unable to decode the BLS public key: %v", err)
+	}
+
+	r := NewSecretsResource()
+	upgrader := r.(resource.ResourceWithUpgradeState).UpgradeState(ctx)[1]
+	priorType := upgrader.PriorSchema.Type().TerraformType(ctx)
+	prior := tfsdk.State{Schema: *upgrader.PriorSchema, Raw: tftypes.NewValue(priorType, map[string]tftypes.Value{
+		"validator_key_encoded":     tftypes.NewValue(tftypes.String, string(validatorKey)),
+		"validator_bls_key_encoded": tftypes.NewValue(tftypes.String, string(blsKey)),
+		"network_key_encoded":       tftypes.NewValue(tftypes.String, string(networkKey)),
+		"address":                   tftypes.NewValue(tftypes.String, ids.Address),
+		"bls_pubkey":                tftypes.NewValue(tftypes.String, string(rawPubkey)),
+		"node_id":                   tftypes.NewValue(tftypes.String, ids.NodeID),
+	})}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &prior}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected upgrade diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state secretsDataSourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if got := state.BLSPubkey.ValueString(); got != ids.BLSPubkey {
+		t.Errorf("bls_pubkey = %q, want %q", got, ids.BLSPubkey)
+	}
+	if state.Address.ValueString() != ids.Address || state.NodeID.ValueString() != ids.NodeID ||
+		state.ValidatorKeyEncoded.ValueString() != string(validatorKey) || state.ValidatorBLSKeyEncoded.ValueString() != string(blsKey) ||
+		state.NetworkKeyEncoded.ValueString() != string(networkKey) {
+		t.Errorf("the keys or identifiers changed: %+v", state)
+	}
+	if state.ValidatorKeyfile.IsNull() || state.BLSKeyHex.IsNull() || state.NetworkKeyLibp2p.IsNull() {
+		t.Errorf("the attributes derived from the keys are not set: %+v", state)
+	}
+	if !state.RedactIdentifiers.IsNull() || !state.RedactedIdentifiers.IsNull() || !state.PolyBFTRegistration.IsNull() {
+		t.Errorf("the options added since version 1 are set: %+v", state)
+	}
+}
+
+func TestSecretsResourceRotateBLSKey(t *testing.T) {
+	ctx := context.Background()
+	r := newConfiguredSecretsResource(t, providerdata.Default())
+	state := createSecrets(t, r, nil)
+
+	config := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+	if diags := config.SetAttribute(ctx, path.Root("rotate_bls_key"), int64(1)); diags.HasError() {
+		t.Fatalf("unable to set rotate_bls_key: %v", diags)
+	}
+	planResp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: state.Schema, Raw: config.Raw.Copy()}}
+	r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: state.Schema, Raw: config.Raw.Copy()},
+		State:  state,
+		Plan:   tfsdk.Plan{Schema: state.Schema, Raw: config.Raw.Copy()},
+	}, planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %v", planResp.Diagnostics)
+	}
+	if planResp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("rotating the BLS key planned without a re-registration warning: %v", planResp.Diagnostics)
+	}
+
+	var before, planned, after secretsDataSourceModel
+	if diags := state.Get(ctx, &before); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if diags := planResp.Plan.Get(ctx, &planned); diags.HasError() {
+		t.Fatalf("unable to get plan: %v", diags)
+	}
+	if !planned.ValidatorBLSKeyEncoded.IsUnknown() || !planned.BLSPubkey.IsUnknown() {
+		t.Errorf("planned BLS key %s and public key %s, want unknown", planned.ValidatorBLSKeyEncoded, planned.BLSPubkey)
+	}
+	if diags := updateSecrets(t, r, state, planResp.Plan).Get(ctx, &after); diags.HasError() {
+		t.Fatalf("unable to get updated state: %v", diags)
+	}
+
+	for name, values := range map[string][3]types.String{
+		"validator_key_encoded": {before.ValidatorKeyEncoded, planned.ValidatorKeyEncoded, after.ValidatorKeyEncoded},
+		"network_key_encoded":   {before.NetworkKeyEncoded, planned.NetworkKeyEncoded, after.NetworkKeyEncoded},
+		"address":               {before.Address, planned.Address, after.Address},
+		"node_id":               {before.NodeID, planned.NodeID, after.NodeID},
+	} {
+		if !values[1].Equal(values[0]) || !values[2].Equal(values[0]) {
+			t.Errorf("%s changed with the BLS key: planned %s, updated %s, want %s", name, values[1], values[2], values[0])
+		}
+	}
+	if after.ValidatorBLSKeyEncoded.Equal(before.ValidatorBLSKeyEncoded) || after.BLSPubkey.Equal(before.BLSPubkey) {
+		t.Error("the BLS key was not rotated")
+	}
+	pubkey, diags := deriveBLSPubkey(encodedKey{value: []byte(after.ValidatorBLSKeyEncoded.ValueString())})
+	if diags.HasError() {
+		t.Fatalf("unable to derive the rotated BLS public key: %v", diags)
+	}
+	if pubkey != after.BLSPubkey.ValueString() {
+		t.Errorf("bls_pubkey = %s, want %s derived from the rotated key", after.BLSPubkey.ValueString(), pubkey)
+	}
+}
+
+func TestSecretsResourceAddressPrefix(t *testing.T) {
+	r := newConfiguredSecretsResource(t, providerdata.Default())
+	for _, prefix := range []string{"0xAb", "c"} {
+		state := createSecrets(t, r, map[string]tftypes.Value{
+			"address_prefix": tftypes.NewValue(tftypes.String, prefix),
+		})
+		address := strings.ToLower(stateAddress(t, state, false))
+		if want := "0x" + strings.ToLower(strings.TrimPrefix(prefix, "0x")); !strings.HasPrefix(address, want) {
+			t.Errorf("address %s does not start with %s", address, want)
+		}
+	}
+}
+
+func TestSecretsResourceAddressPrefixAttemptsExhausted(t *testing.T) {
+	state, diags := createSecretsDiagnostics(t, newConfiguredSecretsResource(t, providerdata.Default()), map[string]tftypes.Value{
+		"address_prefix":              tftypes.NewValue(tftypes.String, "0123456789abcdef"),
+		"address_prefix_max_attempts": tftypes.NewValue(tftypes.Number, 10),
+	})
+	if !diags.HasError() {
+		t.Fatalf("finding a 16 character prefix in 10 attempts succeeded: %s", stateAddress(t, state, false))
+	}
+	if got := diags.Errors()[0]; !strings.Contains(got.Detail(), "address_prefix_max_attempts") {
+		t.Errorf("got %q: %q, want a hint to raise the attempt limit", got.Summary(), got.Detail())
+	}
+}
+
+func TestSecretsResourceKeyEncoding(t *testing.T) {
+	for _, encoding := range []string{keyEncodingDefault, keyEncodingBase64} {
+		t.Run(encoding, func(t *testing.T) {
+			state := createSecrets(t, newConfiguredSecretsResource(t, providerdata.Default()), map[string]tftypes.Value{
+				"key_encoding": tftypes.NewValue(tftypes.String, encoding),
+			})
+			var model secretsDataSourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("unable to get state: %v", diags)
+			}
+
+			keys := make([]encodedKey, 3)
+			for i, value := range []types.String{model.ValidatorKeyEncoded, model.ValidatorBLSKeyEncoded, model.NetworkKeyEncoded} {
+				keys[i].value = []byte(value.ValueString())
+				if encoding == keyEncodingBase64 {
+					decoded, err := base64.StdEncoding.DecodeString(value.ValueString())
+					if err != nil {
+						t.Fatalf("key %d is not base64: %v", i, err)
+					}
+					keys[i].value = decoded
+				}
+			}
+			ids, diags := deriveIdentifiers(keys[0], keys[1], keys[2])
+			if diags.HasError() {
+				t.Fatalf("the %s encoded keys do not decode: %v", encoding, diags)
+			}
+			if ids.Address != model.Address.ValueString() || ids.BLSPubkey != model.BLSPubkey.ValueString() || ids.NodeID != model.NodeID.ValueString() {
+				t.Errorf("the decoded keys derive %+v, want the identifiers of the resource", ids)
+			}
+		})
+	}
+}
+
+// secretsProvider serves the secrets resource alone, to plan it the way Terraform does.
+type secretsProvider struct{}
+
+func (p *secretsProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "polygonedge"
+}
+
+func (p *secretsProvider) Schema(_ context.Context, _ provider.SchemaRequest, _ *provider.SchemaResponse) {
+}
+
+func (p *secretsProvider) Configure(_ context.Context, _ provider.ConfigureRequest, _ *provider.ConfigureResponse) {
+}
+
+func (p *secretsProvider) DataSources(_ context.Context) []func() datasource.DataSource { return nil }
+
+func (p *secretsProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{NewSecretsResource}
+}
+
+// planSecretsChange plans the configuration on the prior state of the secrets resource, through the provider server,
+// and returns the attributes which require replacement.
+func planSecretsChange(t *testing.T, prior tfsdk.State, attrs map[string]tftypes.Value) []string {
+	t.Helper()
+	ctx := context.Background()
+	objectType := prior.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	priorValues := map[string]tftypes.Value{}
+	if err := prior.Raw.As(&priorValues); err != nil {
+		t.Fatalf("unable to read state: %v", err)
+	}
+	// Terraform proposes the configured values, and the prior values of the computed attributes left unset.
+	config := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	proposed := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		config[name] = tftypes.NewValue(typ, nil)
+		if value, ok := attrs[name]; ok {
+			config[name] = value
+		}
+		proposed[name] = config[name]
+		if attr := prior.Schema.GetAttributes()[name]; attr.IsComputed() && config[name].IsNull() {
+			proposed[name] = priorValues[name]
+		}
+	}
+
+	dynamicValue := func(values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+		value, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, values))
+		if err != nil {
+			t.Fatalf("unable to encode value: %v", err)
+		}
+		return &value
+	}
+	server := providerserver.NewProtocol6(&secretsProvider{})()
+	resp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "polygonedge_secrets",
+		PriorState:       dynamicValue(priorValues),
+		ProposedNewState: dynamicValue(proposed),
+		Config:           dynamicValue(config),
+	})
+	if err != nil {
+		t.Fatalf("unable to plan: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("unexpected plan diagnostic: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	replace := make([]string, len(resp.RequiresReplace))
+	for i, p := range resp.RequiresReplace {
+		replace[i] = p.String()
+	}
+	return replace
+}
+
+func TestSecretsResourceRequiresReplace(t *testing.T) {
+	_, validatorKey, err := crypto.GenerateAndEncodeECDSAPrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate validator key: %v", err)
+	}
+	_, otherValidatorKey, err := crypto.GenerateAndEncodeECDSAPrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate validator key: %v", err)
+	}
+	_, networkKey, err := network.GenerateAndEncodeLibp2pKey()
+	if err != nil {
+		t.Fatalf("unable to generate network key: %v", err)
+	}
+	str := func(value string) tftypes.Value { return tftypes.NewValue(tftypes.String, value) }
+	num := func(value int64) tftypes.Value { return tftypes.NewValue(tftypes.Number, value) }
+
+	tests := []struct {
+		name        string
+		created     map[string]tftypes.Value
+		changed     map[string]tftypes.Value
+		wantReplace string
+	}{
+		{
+			name:        "supplied validator key",
+			created:     map[string]tftypes.Value{"validator_key_encoded": str(string(validatorKey))},
+			changed:     map[string]tftypes.Value{"validator_key_encoded": str(string(otherValidatorKey))},
+			wantReplace: `AttributeName("validator_key_encoded")`,
+		},
+		{
+			name:        "keys_wo_version",
+			created:     map[string]tftypes.Value{"network_key_encoded_wo": str(string(networkKey)), "keys_wo_version": num(1)},
+			changed:     map[string]tftypes.Value{"network_key_encoded_wo": str(string(networkKey)), "keys_wo_version": num(2)},
+			wantReplace: `AttributeName("keys_wo_version")`,
+		},
+		{
+			name:        "address_prefix",
+			created:     map[string]tftypes.Value{"address_prefix": str("a")},
+			changed:     map[string]tftypes.Value{"address_prefix": str("b")},
+			wantReplace: `AttributeName("address_prefix")`,
+		},
+		{
+			name:        "key_encoding",
+			created:     nil,
+			changed:     map[string]tftypes.Value{"key_encoding": str(keyEncodingBase64)},
+			wantReplace: `AttributeName("key_encoding")`,
+		},
+		{
+			name:        "redact_identifiers",
+			created:     nil,
+			changed:     map[string]tftypes.Value{"redact_identifiers": tftypes.NewValue(tftypes.Bool, true)},
+			wantReplace: `AttributeName("redact_identifiers")`,
+		},
+		{
+			name:    "explicit default key_encoding",
+			created: nil,
+			changed: map[string]tftypes.Value{"key_encoding": str(keyEncodingDefault)},
+		},
+		{
+			name:    "address_prefix_max_attempts",
+			created: map[string]tftypes.Value{"address_prefix": str("a")},
+			changed: map[string]tftypes.Value{"address_prefix": str("a"), "address_prefix_max_attempts": num(100)},
+		},
+		{
+			name:    "rotate_bls_key",
+			created: nil,
+			changed: map[string]tftypes.Value{"rotate_bls_key": num(1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prior := createSecrets(t, newConfiguredSecretsResource(t, providerdata.Default()), tt.created)
+
+			if got := planSecretsChange(t, prior, tt.created); len(got) != 0 {
+				t.Fatalf("an unchanged configuration requires replacement of %v", got)
+			}
+			got := planSecretsChange(t, prior, tt.changed)
+			if tt.wantReplace == "" {
+				if len(got) != 0 {
+					t.Errorf("the change requires replacement of %v, want an in-place update", got)
+				}
+				return
+			}
+			if len(got) != 1 || got[0] != tt.wantReplace {
+				t.Errorf("the change requires replacement of %v, want %s", got, tt.wantReplace)
+			}
+		})
+	}
+}
+
+func TestSecretsResourceValidatorKeyfile(t *testing.T) {
+	// The first Hardhat development account.
+	const validatorKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+	tests := []struct {
+		name  string
+		attrs map[string]tftypes.Value
+	}{
+		{name: "generated"},
+		{name: "supplied", attrs: map[string]tftypes.Value{"validator_key_encoded": tftypes.NewValue(tftypes.String, validatorKey)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := createSecrets(t, newConfiguredSecretsResource(t, providerdata.Default()), tt.attrs)
+			var model secretsDataSourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("unable to get state: %v", diags)
+			}
+
+			keyfile := model.ValidatorKeyfile.ValueString()
+			if strings.Count(keyfile, "\n") != 1 || !strings.HasSuffix(keyfile, "\n") {
+				t.Fatalf("keyfile = %q, want a single line", keyfile)
+			}
+			if tt.attrs != nil && keyfile != validatorKey+"\n" {
+				t.Errorf("keyfile = %q, want the supplied key %s", keyfile, validatorKey)
+			}
+
+			// Import the keyfile the way go-ethereum tooling does, as a bare hex private key.
+			raw, err := hex.DecodeString(strings.TrimSuffix(keyfile, "\n"))
+			if err != nil {
+				t.Fatalf("keyfile is not hex: %v", err)
+			}
+			key, err := wallet.NewWalletFromPrivKey(raw)
+			if err != nil {
+				t.Fatalf("unable to import keyfile: %v", err)
+			}
+			if got, want := key.Address().String(), model.Address.ValueString(); got != want {
+				t.Errorf("keyfile imports to %s, want the validator address %s", got, want)
+			}
+		})
+	}
+}
+
+func TestSecretsResourceWriteOnlySeed(t *testing.T) {
+	ctx := context.Background()
+	read := func(attrs map[string]tftypes.Value) secretsDataSourceModel {
+		state := createSecrets(t, newConfiguredSecretsResource(t, providerdata.Default()), attrs)
+		var model secretsDataSourceModel
+		if diags := state.Get(ctx, &model); diags.HasError() {
+			t.Fatalf("unable to get state: %v", diags)
+		}
+		return model
+	}
+
+	writeOnly := read(map[string]tftypes.Value{"network_key_seed_wo": tftypes.NewValue(tftypes.String, string(testSeed))})
+	if !writeOnly.NetworkKeySeed.IsNull() || !writeOnly.NetworkKeySeedWO.IsNull() {
+		t.Errorf("the write-only seed is stored in state: %s, %s", writeOnly.NetworkKeySeed, writeOnly.NetworkKeySeedWO)
+	}
+	if got, want := writeOnly.NetworkKeySeedReference.ValueString(), seedReference(testSeed); got != want {
+		t.Errorf("network_key_seed_reference = %s, want %s", got, want)
+	}
+	if strings.Contains(writeOnly.NetworkKeySeedReference.ValueString(), hex.EncodeToString(testSeed)) {
+		t.Error("network_key_seed_reference reveals the seed")
+	}
+
+	// A seed kept outside of Terraform, checked against the reference, derives the same network key again.
+	restored := read(map[string]tftypes.Value{"network_key_seed_wo": tftypes.NewValue(tftypes.String, string(testSeed))})
+	if !restored.NetworkKeyEncoded.Equal(writeOnly.NetworkKeyEncoded) || !restored.NodeID.Equal(writeOnly.NodeID) {
+		t.Errorf("the same write-only seed derived a different network key: node ID %s, want %s", restored.NodeID, writeOnly.NodeID)
+	}
+	if !restored.NetworkKeySeedReference.Equal(writeOnly.NetworkKeySeedReference) {
+		t.Error("the same write-only seed has a different reference")
+	}
+
+	stored := read(map[string]tftypes.Value{"network_key_seed": tftypes.NewValue(tftypes.String, string(testSeed))})
+	if !stored.NodeID.Equal(writeOnly.NodeID) || !stored.NetworkKeySeedReference.Equal(writeOnly.NetworkKeySeedReference) {
+		t.Errorf("network_key_seed and network_key_seed_wo derive different node IDs: %s and %s", stored.NodeID, writeOnly.NodeID)
+	}
+
+	other := read(map[string]tftypes.Value{"network_key_seed_wo": tftypes.NewValue(tftypes.String, string(otherTestSeed))})
+	if other.NodeID.Equal(writeOnly.NodeID) || other.NetworkKeySeedReference.Equal(writeOnly.NetworkKeySeedReference) {
+		t.Error("different seeds derived the same network key or reference")
+	}
+
+	unseeded := read(nil)
+	if !unseeded.NetworkKeySeedReference.IsNull() {
+		t.Errorf("network_key_seed_reference = %s without a seed, want null", unseeded.NetworkKeySeedReference)
+	}
+}
+
+// brokenEncoder generates keys whose encoding is of another key than the one returned, for the key type broken.
+type brokenEncoder struct {
+	keyGenerator
+	broken diagnostics.KeyType
+}
+
+func (g brokenEncoder) validatorKey() (*ecdsa.PrivateKey, []byte, error) {
+	key, encoded, err := g.keyGenerator.validatorKey()
+	if err == nil && g.broken == diagnostics.ValidatorKey {
+		_, encoded, err = g.keyGenerator.validatorKey()
+	}
+	return key, encoded, err
+}
+
+func (g brokenEncoder) blsKey() (*bls_sig.SecretKey, []byte, error) {
+	key, encoded, err := g.keyGenerator.blsKey()
+	if err == nil && g.broken == diagnostics.BLSKey {
+		_, encoded, err = g.keyGenerator.blsKey()
+	}
+	return key, encoded, err
+}
+
+func (g brokenEncoder) networkKey() (libp2pcrypto.PrivKey, []byte, error) {
+	key, encoded, err := g.keyGenerator.networkKey()
+	if err == nil && g.broken == diagnostics.NetworkKey {
+		_, encoded, err = g.keyGenerator.networkKey()
+	}
+	return key, encoded, err
+}
+
+func TestSecretsResourceBrokenEncoder(t *testing.T) {
+	for _, keyType := range []diagnostics.KeyType{diagnostics.ValidatorKey, diagnostics.BLSKey, diagnostics.NetworkKey} {
+		t.Run(string(keyType), func(t *testing.T) {
+			r := newConfiguredSecretsResource(t, providerdata.Default()).(*secretsResource)
+			r.keys = brokenEncoder{keyGenerator: defaultKeyGenerator, broken: keyType}
+
+			state, diags := createSecretsDiagnostics(t, r, nil)
+			if !diags.HasError() {
+				t.Fatal("creating secrets with a broken encoder succeeded")
+			}
+			if got, want := diags.Errors()[0].Summary(), "Invalid encoding of generated "+string(keyType); got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+			if !state.Raw.IsNull() {
+				t.Error("the broken key was stored in state")
+			}
+		})
+	}
+
+	// The same generator creates secrets when its encoding is not broken.
+	r := newConfiguredSecretsResource(t, providerdata.Default()).(*secretsResource)
+	r.keys = brokenEncoder{keyGenerator: defaultKeyGenerator}
+	if _, diags := createSecretsDiagnostics(t, r, nil); diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+}
+
+func TestSecretsResourceBLSKeyHex(t *testing.T) {
+	ctx := context.Background()
+	r := newConfiguredSecretsResource(t, providerdata.Default())
+
+	// importedBLSPubkey returns the public key of the raw BLS key hex, which must match the prefix setting.
+	importedBLSPubkey := func(keyHex string, prefix bool) string {
+		t.Helper()
+		if strings.HasPrefix(keyHex, "0x") != prefix {
+			t.Errorf("bls_key_hex = %s, want the 0x prefix %t", keyHex, prefix)
+		}
+		raw, err := hex.DecodeString(strings.TrimPrefix(keyHex, "0x"))
+		if err != nil {
+			t.Fatalf("unable to decode bls_key_hex: %v", err)
+		}
+		key := new(bls_sig.SecretKey)
+		if err := key.UnmarshalBinary(raw); err != nil {
+			t.Fatalf("unable to import bls_key_hex: %v", err)
+		}
+		pubkey, err := crypto.BLSSecretKeyToPubkeyBytes(key)
+		if err != nil {
+			t.Fatalf("unable to derive the BLS public key: %v", err)
+		}
+		return "0x" + hex.EncodeToString(pubkey)
+	}
+
+	// The prefix defaults to true.
+	for _, tt := range []struct {
+		hexPrefix interface{}
+		want      bool
+	}{{nil, true}, {true, true}, {false, false}} {
+		state := createSecrets(t, r, map[string]tftypes.Value{
+			"hex_prefix": tftypes.NewValue(tftypes.Bool, tt.hexPrefix),
+		})
+		var model secretsDataSourceModel
+		if diags := state.Get(ctx, &model); diags.HasError() {
+			t.Fatalf("unable to get state: %v", diags)
+		}
+		if got := importedBLSPubkey(model.BLSKeyHex.ValueString(), tt.want); got != model.BLSPubkey.ValueString() {
+			t.Errorf("bls_key_hex imports to %s, want bls_pubkey %s", got, model.BLSPubkey)
+		}
+	}
+
+	// Toggling the prefix updates the same key in place.
+	state := createSecrets(t, r, map[string]tftypes.Value{})
+	var before secretsDataSourceModel
+	if diags := state.Get(ctx, &before); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+	if diags := plan.SetAttribute(ctx, path.Root("hex_prefix"), false); diags.HasError() {
+		t.Fatalf("unable to set hex_prefix: %v", diags)
+	}
+	var after secretsDataSourceModel
+	if diags := updateSecrets(t, r, state, plan).Get(ctx, &after); diags.HasError() {
+		t.Fatalf("unable to get updated state: %v", diags)
+	}
+	if got, want := after.BLSKeyHex.ValueString(), strings.TrimPrefix(before.BLSKeyHex.ValueString(), "0x"); got != want {
+		t.Errorf("bls_key_hex = %s without prefix, want %s", got, want)
+	}
+}
+
+func TestSecretsResourceNetworkKeyLibp2p(t *testing.T) {
+	r := newConfiguredSecretsResource(t, providerdata.Default())
+	for name, attrs := range map[string]map[string]tftypes.Value{
+		"generated": nil,
+		"seeded":    {"network_key_seed_wo": tftypes.NewValue(tftypes.String, string(testSeed))},
+	} {
+		t.Run(name, func(t *testing.T) {
+			state := createSecrets(t, r, attrs)
+			var model secretsDataSourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("unable to get state: %v", diags)
+			}
+
+			// go-libp2p reads the marshalled key back to the key of the node ID.
+			raw, err := base64.StdEncoding.DecodeString(model.NetworkKeyLibp2p.ValueString())
+			if err != nil {
+				t.Fatalf("network_key_libp2p is not base64: %v", err)
+			}
+			key, err := libp2pcrypto.UnmarshalPrivateKey(raw)
+			if err != nil {
+				t.Fatalf("unable to unmarshal network_key_libp2p: %v", err)
+			}
+			nodeID, err := peer.IDFromPrivateKey(key)
+			if err != nil {
+				t.Fatalf("unable to derive node ID: %v", err)
+			}
+			if nodeID.String() != model.NodeID.ValueString() {
+				t.Errorf("network_key_libp2p has node ID %s, want node_id %s", nodeID, model.NodeID)
+			}
+		})
+	}
+
+	// A write-only network key is not written in another form either.
+	_, networkKeyEncoded, err := network.GenerateAndEncodeLibp2pKey()
+	if err != nil {
+		t.Fatalf("unable to generate network key: %v", err)
+	}
+	state := createSecrets(t, r, map[string]tftypes.Value{
+		"network_key_encoded_wo": tftypes.NewValue(tftypes.String, string(networkKeyEncoded)),
+	})
+	var model secretsDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if !model.NetworkKeyLibp2p.IsNull() {
+		t.Error("network_key_libp2p is set for a write-only network key")
+	}
+}
+
+func TestSecretsResourcePolyBFTRegistration(t *testing.T) {
+	ctx := context.Background()
+	state := createSecrets(t, newConfiguredSecretsResource(t, providerdata.Default()), map[string]tftypes.Value{
+		"polybft_chain_id": tftypes.NewValue(tftypes.Number, 100),
+	})
+	var model secretsDataSourceModel
+	if diags := state.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	// The registration is the one of the stored PolyBFT BLS key and the validator address.
+	key, err := bls.UnmarshalPrivateKey([]byte(model.PolyBFTBLSKeyEncoded.ValueString()))
+	if err != nil {
+		t.Fatalf("unable to decode polybft_bls_key_encoded: %v", err)
+	}
+	want, err := polybft.NewRegistration(key, edgetypes.StringToAddress(model.Address.ValueString()), 100)
+	if err != nil {
+		t.Fatalf("unable to compute the registration: %v", err)
+	}
+	attrs := model.PolyBFTRegistration.Attributes()
+	for name, value := range map[string][]byte{
+		"bls_pubkey":        want.BLSPubkey,
+		"signature":         want.Signature,
+		"register_calldata": want.RegisterCalldata,
+	} {
+		if got := attrs[name].(types.String).ValueString(); got != "0x"+hex.EncodeToString(value) {
+			t.Errorf("polybft_registration.%s = %s, want 0x%x", name, got, value)
+		}
+	}
+
+	state = createSecrets(t, newConfiguredSecretsResource(t, providerdata.Default()), nil)
+	if diags := state.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if !model.PolyBFTBLSKeyEncoded.IsNull() || !model.PolyBFTRegistration.IsNull() {
+		t.Error("the PolyBFT key and registration are set without polybft_chain_id")
+	}
+}
@@ -0,0 +1,270 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+)
+
+// previousIdentifiersAttrTypes are the attribute types of the `previous` object.
+var previousIdentifiersAttrTypes = map[string]attr.Type{
+	"address":    types.StringType,
+	"bls_pubkey": types.StringType,
+	"node_id":    types.StringType,
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &secretsRotationResource{}
+	_ resource.ResourceWithConfigure  = &secretsRotationResource{}
+	_ resource.ResourceWithModifyPlan = &secretsRotationResource{}
+)
+
+// secretsRotationResourceModel maps the resource schema data.
+type secretsRotationResourceModel struct {
+	Triggers types.Map `tfsdk:"triggers"`
+
+	ValidatorKeyEncoded    types.String `tfsdk:"validator_key_encoded"`
+	ValidatorBLSKeyEncoded types.String `tfsdk:"validator_bls_key_encoded"`
+	NetworkKeyEncoded      types.String `tfsdk:"network_key_encoded"`
+
+	Address   types.String `tfsdk:"address"`
+	BLSPubkey types.String `tfsdk:"bls_pubkey"`
+	NodeID    types.String `tfsdk:"node_id"`
+
+	Previous types.Object `tfsdk:"previous"`
+}
+
+// NewSecretsRotationResource is a helper function to simplify the provider implementation.
+func NewSecretsRotationResource() resource.Resource {
+	return &secretsRotationResource{
+		providerData: providerdata.Default(),
+		keys:         defaultKeyGenerator,
+	}
+}
+
+// secretsRotationResource is the resource implementation.
+type secretsRotationResource struct {
+	providerData providerdata.Data
+	keys         keyGenerator
+}
+
+// Metadata returns the resource type name.
+func (r *secretsRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_rotation"
+}
+
+// Schema defines the schema for the resource.
+func (r *secretsRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	identifierAttributes := map[string]schema.Attribute{
+		"address": schema.StringAttribute{
+			Computed:    true,
+			Description: "Validator address.",
+		},
+		"bls_pubkey": schema.StringAttribute{
+			Computed:    true,
+			Description: "Validator BLS public key, hex encoded.",
+		},
+		"node_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Node ID.",
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Generates polygon-edge secrets which are rotated in place whenever `triggers` change. " +
+			"The identifiers of the replaced secrets are kept in `previous`, so both the old and the new validator " +
+			"are known while the validator is swapped on-chain.",
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values which rotate the secrets when they change, like the `keepers` of the random provider.",
+			},
+			"validator_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded validator key.",
+			},
+			"validator_bls_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded validator BLS key.",
+			},
+			"network_key_encoded": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Encoded network key.",
+			},
+			"address":    identifierAttributes["address"],
+			"bls_pubkey": identifierAttributes["bls_pubkey"],
+			"node_id":    identifierAttributes["node_id"],
+			"previous": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Identifiers of the secrets replaced by the last rotation. Not set until the first rotation.",
+				Attributes:  identifierAttributes,
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the resource.
+func (r *secretsRotationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.providerData = data
+}
+
+// ModifyPlan keeps the secrets of an updated resource, unless `triggers` change, in which case they are rotated.
+func (r *secretsRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Everything is generated on create, and nothing is left to plan on destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan secretsRotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Triggers.Equal(state.Triggers) {
+		plan.ValidatorKeyEncoded = state.ValidatorKeyEncoded
+		plan.ValidatorBLSKeyEncoded = state.ValidatorBLSKeyEncoded
+		plan.NetworkKeyEncoded = state.NetworkKeyEncoded
+		plan.Address = state.Address
+		plan.BLSPubkey = state.BLSPubkey
+		plan.NodeID = state.NodeID
+		plan.Previous = state.Previous
+	} else {
+		// The current identifiers become the previous ones, the new secrets are only known once generated.
+		var diags diag.Diagnostics
+		plan.Previous, diags = types.ObjectValue(previousIdentifiersAttrTypes, map[string]attr.Value{
+			"address":    state.Address,
+			"bls_pubkey": state.BLSPubkey,
+			"node_id":    state.NodeID,
+		})
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("triggers"),
+			"Secrets rotation",
+			"All the secrets will be regenerated. The new validator must be registered on-chain, and the previous one removed, "+
+				"before the nodes are restarted with the new secrets.",
+		)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *secretsRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan secretsRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.generate(ctx, r.keys, r.providerData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Previous = types.ObjectNull(previousIdentifiersAttrTypes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *secretsRotationResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	// NO-OP: all there is to read is in the State, and response is already populated with that.
+	tflog.Debug(ctx, "Reading rotated secrets from state")
+}
+
+func (r *secretsRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan secretsRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The plan already holds the kept secrets, or the previous identifiers of a rotation, in which case the
+	// new secrets are unknown. Triggers which were unknown when planning may turn out unchanged, but are still a rotation.
+	if plan.ValidatorKeyEncoded.IsUnknown() {
+		resp.Diagnostics.Append(plan.generate(ctx, r.keys, r.providerData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Debug(ctx, "Rotated secrets")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *secretsRotationResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing rotated secrets from state")
+}
+
+// generate generates new secrets with the key generator and sets them, with their identifiers, on the model.
+// The address is formatted as the provider data configures.
+func (m *secretsRotationResourceModel) generate(ctx context.Context, keys keyGenerator, data providerdata.Data) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	start := time.Now()
+	_, validatorKey, err := keys.validatorKey()
+	if err != nil {
+		diags.Append(diagnostics.KeyGeneration(diagnostics.ValidatorKey, err))
+		return diags
+	}
+	logKeyGeneration(ctx, diagnostics.ValidatorKey, start, validatorKey)
+	start = time.Now()
+	_, blsKey, err := keys.blsKey()
+	if err != nil {
+		diags.Append(diagnostics.KeyGeneration(diagnostics.BLSKey, err))
+		return diags
+	}
+	logKeyGeneration(ctx, diagnostics.BLSKey, start, blsKey)
+	start = time.Now()
+	_, networkKey, err := keys.networkKey()
+	if err != nil {
+		diags.Append(diagnostics.KeyGeneration(diagnostics.NetworkKey, err))
+		return diags
+	}
+	logKeyGeneration(ctx, diagnostics.NetworkKey, start, networkKey)
+
+	ids, idDiags := deriveIdentifiers(
+		encodedKey{value: validatorKey, attr: path.Root("validator_key_encoded")},
+		encodedKey{value: blsKey, attr: path.Root("validator_bls_key_encoded")},
+		encodedKey{value: networkKey, attr: path.Root("network_key_encoded")},
+	)
+	diags.Append(idDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ValidatorKeyEncoded = types.StringValue(string(validatorKey))
+	m.ValidatorBLSKeyEncoded = types.StringValue(string(blsKey))
+	m.NetworkKeyEncoded = types.StringValue(string(networkKey))
+	m.Address = types.StringValue(data.FormatAddressString(ids.Address))
+	m.BLSPubkey = types.StringValue(ids.BLSPubkey)
+	m.NodeID = types.StringValue(ids.NodeID)
+	return diags
+}
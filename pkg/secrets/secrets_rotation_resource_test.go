@@ -0,0 +1,142 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// rotationTriggers returns the triggers map holding a single version.
+func rotationTriggers(version string) tftypes.Value {
+	typ := tftypes.Map{ElementType: tftypes.String}
+	return tftypes.NewValue(typ, map[string]tftypes.Value{"version": tftypes.NewValue(tftypes.String, version)})
+}
+
+// rotationConfig returns the configuration of a rotation resource with the triggers, computed attributes unknown
+// if unknown is set and null otherwise.
+func rotationConfig(t *testing.T, triggers tftypes.Value, unknown bool) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewSecretsRotationResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+		if unknown {
+			values[name] = tftypes.NewValue(typ, tftypes.UnknownValue)
+		}
+	}
+	values["triggers"] = triggers
+	return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+}
+
+// rotate plans and applies the triggers on the state of the rotation resource, returning the plan and the new state.
+func rotate(t *testing.T, r resource.Resource, state tfsdk.State, triggers tftypes.Value) (secretsRotationResourceModel, secretsRotationResourceModel, tfsdk.State) {
+	t.Helper()
+	ctx := context.Background()
+	proposed := rotationConfig(t, triggers, true)
+	config := rotationConfig(t, triggers, false)
+
+	// Terraform proposes the prior state of computed attributes, or unknown values if the configuration changed.
+	prior := map[string]tftypes.Value{}
+	if err := state.Raw.As(&prior); err != nil {
+		t.Fatalf("unable to read state: %v", err)
+	}
+	if prior["triggers"].Equal(triggers) {
+		proposed.Raw = state.Raw.Copy()
+	}
+
+	planResp := &resource.ModifyPlanResponse{Plan: proposed}
+	r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		State:  state,
+		Plan:   proposed,
+	}, planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %v", planResp.Diagnostics)
+	}
+
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()}}
+	r.Update(ctx, resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		State:  state,
+		Plan:   planResp.Plan,
+	}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", updateResp.Diagnostics)
+	}
+
+	var planned, updated secretsRotationResourceModel
+	if diags := planResp.Plan.Get(ctx, &planned); diags.HasError() {
+		t.Fatalf("unable to get plan: %v", diags)
+	}
+	if diags := updateResp.State.Get(ctx, &updated); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	return planned, updated, updateResp.State
+}
+
+// previousIdentifiers returns the address, BLS public key and node ID of the previous object.
+func previousIdentifiers(t *testing.T, m secretsRotationResourceModel) [3]types.String {
+	t.Helper()
+	if m.Previous.IsNull() || m.Previous.IsUnknown() {
+		t.Fatalf("previous = %s, want the identifiers of the replaced secrets", m.Previous)
+	}
+	attrs := m.Previous.Attributes()
+	return [3]types.String{attrs["address"].(types.String), attrs["bls_pubkey"].(types.String), attrs["node_id"].(types.String)}
+}
+
+func currentIdentifiers(m secretsRotationResourceModel) [3]types.String {
+	return [3]types.String{m.Address, m.BLSPubkey, m.NodeID}
+}
+
+func TestSecretsRotationResource(t *testing.T) {
+	ctx := context.Background()
+	r := NewSecretsRotationResource()
+	plan := rotationConfig(t, rotationTriggers("1"), true)
+	config := rotationConfig(t, rotationTriggers("1"), false)
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}, Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", createResp.Diagnostics)
+	}
+	var first secretsRotationResourceModel
+	if diags := createResp.State.Get(ctx, &first); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if !first.Previous.IsNull() {
+		t.Errorf("previous = %s before any rotation, want null", first.Previous)
+	}
+
+	planned, kept, state := rotate(t, r, createResp.State, rotationTriggers("1"))
+	if currentIdentifiers(planned) != currentIdentifiers(first) || currentIdentifiers(kept) != currentIdentifiers(first) {
+		t.Errorf("unchanged triggers rotated the secrets: %v, want %v", currentIdentifiers(kept), currentIdentifiers(first))
+	}
+
+	planned, second, state := rotate(t, r, state, rotationTriggers("2"))
+	if !planned.Address.IsUnknown() || previousIdentifiers(t, planned) != currentIdentifiers(first) {
+		t.Errorf("the rotation plan does not show the current identifiers as previous: %s", planned.Previous)
+	}
+	if previousIdentifiers(t, second) != currentIdentifiers(first) {
+		t.Errorf("previous = %v, want the identifiers before the rotation %v", previousIdentifiers(t, second), currentIdentifiers(first))
+	}
+	for i, id := range currentIdentifiers(second) {
+		if id.IsNull() || id.Equal(currentIdentifiers(first)[i]) {
+			t.Errorf("identifier %d = %s after the rotation, want a new one", i, id)
+		}
+	}
+	if second.ValidatorKeyEncoded.Equal(first.ValidatorKeyEncoded) || second.NetworkKeyEncoded.Equal(first.NetworkKeyEncoded) {
+		t.Error("the rotation kept the keys")
+	}
+
+	_, third, _ := rotate(t, r, state, rotationTriggers("3"))
+	if previousIdentifiers(t, third) != currentIdentifiers(second) {
+		t.Errorf("previous = %v after a second rotation, want %v", previousIdentifiers(t, third), currentIdentifiers(second))
+	}
+}
@@ -0,0 +1,131 @@
+package secrets
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/coinbase/kryptology/pkg/signatures/bls/bls_sig"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+)
+
+// minSeedLength is the minimum length of a seed, in bytes, so it holds at least 256 bits of entropy
+// when it is random.
+const minSeedLength = 32
+
+// Labels separating the keys derived from the same seed.
+const (
+	seedLabelValidatorKey    = "polygonedge/validator-key"
+	seedLabelValidatorBLSKey = "polygonedge/validator-bls-key"
+	seedLabelNetworkKey      = "polygonedge/network-key"
+	seedLabelReference       = "polygonedge/seed-reference"
+)
+
+// validateSeed checks the seed is long enough to derive keys from.
+func validateSeed(seed []byte) error {
+	if len(seed) < minSeedLength {
+		return fmt.Errorf("the seed must be at least %d bytes, got %d", minSeedLength, len(seed))
+	}
+	return nil
+}
+
+// seedReference returns a reference of the seed which can be stored in place of the seed: the hex of a labelled hash,
+// which identifies the seed without revealing it as seeds hold at least 256 bits.
+func seedReference(seed []byte) string {
+	reference := sha256.Sum256(append([]byte(seedLabelReference+"\x00"), seed...))
+	return hex.EncodeToString(reference[:])
+}
+
+// seededSecp256k1Scalar derives a secp256k1 private key from the seed, for the given label.
+// The hash of the label, the seed and a counter is taken until it is a valid scalar, which almost always
+// takes a single attempt.
+func seededSecp256k1Scalar(seed []byte, label string) []byte {
+	for counter := uint32(0); ; counter++ {
+		h := sha256.New()
+		h.Write([]byte(label))
+		h.Write([]byte{0})
+		h.Write(seed)
+		_ = binary.Write(h, binary.BigEndian, counter)
+		scalar := h.Sum(nil)
+
+		if n := new(big.Int).SetBytes(scalar); n.Sign() > 0 && n.Cmp(btcec.S256().N) < 0 {
+			return scalar
+		}
+	}
+}
+
+// deriveSeededValidatorKey derives an encoded validator key from the seed.
+func deriveSeededValidatorKey(seed []byte) []byte {
+	// polygon-edge encodes validator keys as the hex of the big endian scalar.
+	return []byte(hex.EncodeToString(seededSecp256k1Scalar(seed, seedLabelValidatorKey)))
+}
+
+// deriveSeededBLSKey derives an encoded validator BLS key from the seed, with the key generation of the BLS signature scheme.
+func deriveSeededBLSKey(seed []byte) ([]byte, error) {
+	ikm := sha256.Sum256(append([]byte(seedLabelValidatorBLSKey+"\x00"), seed...))
+	_, key, err := bls_sig.NewSigPop().KeygenWithSeed(ikm[:])
+	if err != nil {
+		return nil, err
+	}
+	raw, err := key.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(hex.EncodeToString(raw)), nil
+}
+
+// deriveSeededNetworkKey derives an encoded secp256k1 libp2p network key from the seed.
+func deriveSeededNetworkKey(seed []byte) ([]byte, error) {
+	key, err := libp2pcrypto.UnmarshalSecp256k1PrivateKey(seededSecp256k1Scalar(seed, seedLabelNetworkKey))
+	if err != nil {
+		return nil, err
+	}
+	// polygon-edge encodes network keys as the hex of the libp2p protobuf encoding.
+	raw, err := libp2pcrypto.MarshalPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(hex.EncodeToString(raw)), nil
+}
+
+// deriveSeededKeys derives the encoded validator, BLS and network keys from the seed.
+func deriveSeededKeys(seed []byte) (validatorKey, blsKey, networkKey []byte, diags diag.Diagnostics) {
+	var err error
+	validatorKey = deriveSeededValidatorKey(seed)
+	if blsKey, err = deriveSeededBLSKey(seed); err != nil {
+		diags.Append(diagnostics.KeyGeneration(diagnostics.BLSKey, err))
+		return nil, nil, nil, diags
+	}
+	if networkKey, err = deriveSeededNetworkKey(seed); err != nil {
+		diags.Append(diagnostics.KeyGeneration(diagnostics.NetworkKey, err))
+		return nil, nil, nil, diags
+	}
+	return validatorKey, blsKey, networkKey, diags
+}
+
+// SeededIdentifiers returns the checksummed address, the BLS public key and the node ID of the keys derived from
+// the seed, the same identifiers the `polygonedge_secrets` data source returns for it.
+func SeededIdentifiers(seed []byte) (address, blsPubkey, nodeID string, diags diag.Diagnostics) {
+	if err := validateSeed(seed); err != nil {
+		diags.AddError("Invalid seed", err.Error()+".")
+		return "", "", "", diags
+	}
+	validatorKey, blsKey, networkKey, diags := deriveSeededKeys(seed)
+	if diags.HasError() {
+		return "", "", "", diags
+	}
+
+	ids, diags := deriveIdentifiers(
+		encodedKey{value: validatorKey, attr: path.Root("validator_key_encoded")},
+		encodedKey{value: blsKey, attr: path.Root("validator_bls_key_encoded")},
+		encodedKey{value: networkKey, attr: path.Root("network_key_encoded")},
+	)
+	return ids.Address, ids.BLSPubkey, ids.NodeID, diags
+}
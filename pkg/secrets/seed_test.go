@@ -0,0 +1,91 @@
+package secrets
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+)
+
+var (
+	testSeed      = []byte("0123456789abcdef0123456789abcdef")
+	otherTestSeed = []byte("fedcba9876543210fedcba9876543210")
+)
+
+func TestValidateSeed(t *testing.T) {
+	if err := validateSeed(testSeed); err != nil {
+		t.Errorf("unexpected error for a %d byte seed: %s", len(testSeed), err)
+	}
+	if err := validateSeed(testSeed[:minSeedLength-1]); err == nil {
+		t.Errorf("expected an error for a %d byte seed", minSeedLength-1)
+	}
+}
+
+func TestSeededKeysAreDeterministic(t *testing.T) {
+	derive := func(seed []byte) [][]byte {
+		blsKey, err := deriveSeededBLSKey(seed)
+		if err != nil {
+			t.Fatalf("unable to derive BLS key: %s", err)
+		}
+		networkKey, err := deriveSeededNetworkKey(seed)
+		if err != nil {
+			t.Fatalf("unable to derive network key: %s", err)
+		}
+		return [][]byte{deriveSeededValidatorKey(seed), blsKey, networkKey}
+	}
+
+	first, second, other := derive(testSeed), derive(append([]byte{}, testSeed...)), derive(otherTestSeed)
+	for i, name := range []string{"validator key", "BLS key", "network key"} {
+		if !bytes.Equal(first[i], second[i]) {
+			t.Errorf("%s differs between two derivations from the same seed", name)
+		}
+		if bytes.Equal(first[i], other[i]) {
+			t.Errorf("%s is the same for two different seeds", name)
+		}
+	}
+	if bytes.Equal(first[0], first[2]) {
+		t.Error("the validator and network keys derived from the same seed are the same")
+	}
+}
+
+func TestSeededNodeIDIsStable(t *testing.T) {
+	nodeID := func() string {
+		networkKey, err := deriveSeededNetworkKey(testSeed)
+		if err != nil {
+			t.Fatalf("unable to derive network key: %s", err)
+		}
+		key, err := network.ParseLibp2pKey(networkKey)
+		if err != nil {
+			t.Fatalf("the derived network key does not parse: %s", err)
+		}
+		if key.Type() != libp2pcrypto.Secp256k1 {
+			t.Errorf("the derived network key is a %s key, want secp256k1", key.Type())
+		}
+
+		ids, diags := deriveIdentifiers(
+			encodedKey{value: deriveSeededValidatorKey(testSeed), attr: path.Root("validator_key_encoded")},
+			encodedKey{value: mustDeriveSeededBLSKey(t, testSeed), attr: path.Root("validator_bls_key_encoded")},
+			encodedKey{value: networkKey, attr: path.Root("network_key_encoded")},
+		)
+		if diags.HasError() {
+			t.Fatalf("unable to derive identifiers: %v", diags)
+		}
+		return ids.NodeID
+	}
+
+	first, second := nodeID(), nodeID()
+	if first == "" || first != second {
+		t.Errorf("node ID is not stable across derivations: %q and %q", first, second)
+	}
+}
+
+func mustDeriveSeededBLSKey(t *testing.T, seed []byte) []byte {
+	t.Helper()
+	key, err := deriveSeededBLSKey(seed)
+	if err != nil {
+		t.Fatalf("unable to derive BLS key: %s", err)
+	}
+	return key
+}
@@ -0,0 +1,20 @@
+package secrets
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+)
+
+// logKeyGeneration logs how long generating a key took, since start, and the size of the encoded key.
+// It is logged at debug level only, to profile slow generation with TF_LOG=DEBUG.
+func logKeyGeneration(ctx context.Context, keyType diagnostics.KeyType, start time.Time, encoded []byte) {
+	tflog.Debug(ctx, "Generated key", map[string]interface{}{
+		"key_type":    string(keyType),
+		"duration":    time.Since(start).String(),
+		"encoded_len": len(encoded),
+	})
+}
@@ -0,0 +1,64 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+)
+
+func TestKeyGenerationLogged(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	d := NewSecretsDataSource()
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", resp.Diagnostics)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode logs: %v", err)
+	}
+	logged := make(map[string]bool)
+	for _, entry := range entries {
+		if entry["@message"] != "Generated key" {
+			continue
+		}
+		keyType, _ := entry["key_type"].(string)
+		logged[keyType] = true
+		if entry["@level"] != "debug" {
+			t.Errorf("%s generation logged at %v, want debug", keyType, entry["@level"])
+		}
+		if duration, _ := entry["duration"].(string); duration == "" {
+			t.Errorf("%s generation logged without its duration: %v", keyType, entry)
+		}
+		if encodedLen, _ := entry["encoded_len"].(float64); encodedLen <= 0 {
+			t.Errorf("%s generation logged with encoded_len %v, want the size of the encoded key", keyType, entry["encoded_len"])
+		}
+	}
+	for _, keyType := range []diagnostics.KeyType{diagnostics.ValidatorKey, diagnostics.BLSKey, diagnostics.NetworkKey} {
+		if !logged[string(keyType)] {
+			t.Errorf("generating the %s was not logged: %v", keyType, entries)
+		}
+	}
+}
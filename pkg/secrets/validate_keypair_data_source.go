@@ -0,0 +1,208 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Key pair types checked by the polygonedge_validate_keypair data source.
+const (
+	keypairTypeECDSA = "ecdsa"
+	keypairTypeBLS   = "bls"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &validateKeypairDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &validateKeypairDataSource{}
+)
+
+// validateKeypairDataSourceModel maps the data source schema data.
+type validateKeypairDataSourceModel struct {
+	Type              types.String `tfsdk:"type"`
+	PrivateKeyEncoded types.String `tfsdk:"private_key_encoded"`
+	PublicKey         types.String `tfsdk:"public_key"`
+	Address           types.String `tfsdk:"address"`
+
+	Valid types.Bool `tfsdk:"valid"`
+}
+
+// NewValidateKeypairDataSource is a helper function to simplify the provider implementation.
+func NewValidateKeypairDataSource() datasource.DataSource {
+	return &validateKeypairDataSource{}
+}
+
+// validateKeypairDataSource is the data source implementation.
+type validateKeypairDataSource struct{}
+
+// Metadata returns the data source type name.
+func (d *validateKeypairDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_validate_keypair"
+}
+
+// Schema defines the schema for the data source.
+func (d *validateKeypairDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks that an encoded private key matches a public key or address recorded separately, " +
+			"to audit key stores. A mismatch is reported as a warning and sets `valid` to false, " +
+			"use it in a `postcondition` or `check` block to fail the run.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Required: true,
+				Description: "Type of the key pair, `" + keypairTypeECDSA + "` for validator keys or `" + keypairTypeBLS +
+					"` for validator BLS keys.",
+			},
+			"private_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Encoded private key, a validator key or a validator BLS key depending on `type`.",
+			},
+			"public_key": schema.StringAttribute{
+				Optional: true,
+				Description: "Expected public key, hex encoded. Compressed and uncompressed ECDSA public keys are accepted. " +
+					"Conflicts with `address`.",
+				Validators: []validator.String{
+					validators.Hex(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Expected address of an `" + keypairTypeECDSA + "` key pair. Conflicts with `public_key`.",
+				Validators: []validator.String{
+					validators.Address(),
+				},
+			},
+			"valid": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the private key matches the expected public key or address.",
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the type is known, the private key decodes as a key of that type,
+// and exactly one of `public_key` and `address` is set.
+func (d *validateKeypairDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config validateKeypairDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Type.IsUnknown() && config.Type.ValueString() != keypairTypeECDSA && config.Type.ValueString() != keypairTypeBLS {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid key pair type",
+			fmt.Sprintf("The key pair type must be %q or %q, got: %q.", keypairTypeECDSA, keypairTypeBLS, config.Type.ValueString()),
+		)
+	}
+	// The private key is validated here rather than by an attribute validator, as its encoding depends on the type.
+	var keyValidator validator.String
+	switch config.Type.ValueString() {
+	case keypairTypeECDSA:
+		keyValidator = validators.ValidatorKey()
+	case keypairTypeBLS:
+		keyValidator = validators.BLSKey()
+	}
+	if keyValidator != nil {
+		keyResp := &validator.StringResponse{}
+		keyValidator.ValidateString(ctx, validator.StringRequest{
+			Path:           path.Root("private_key_encoded"),
+			PathExpression: path.MatchRoot("private_key_encoded"),
+			Config:         req.Config,
+			ConfigValue:    config.PrivateKeyEncoded,
+		}, keyResp)
+		resp.Diagnostics.Append(keyResp.Diagnostics...)
+	}
+	if config.PublicKey.IsNull() == config.Address.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("public_key"),
+			"Invalid expected key",
+			"Exactly one of `public_key` and `address` must be set.",
+		)
+	}
+	if !config.Address.IsNull() && config.Type.ValueString() == keypairTypeBLS {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("address"),
+			"Invalid expected key",
+			"BLS keys have no address, set `public_key` instead.",
+		)
+	}
+}
+
+// Read compares the key derived from the private key with the expected one.
+func (d *validateKeypairDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state validateKeypairDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyPath := path.Root("private_key_encoded")
+	expectedPath, expected := path.Root("public_key"), state.PublicKey.ValueString()
+	if !state.Address.IsNull() {
+		expectedPath, expected = path.Root("address"), state.Address.ValueString()
+	}
+
+	var valid bool
+	var derived string
+	switch state.Type.ValueString() {
+	case keypairTypeECDSA:
+		key, err := crypto.BytesToECDSAPrivateKey([]byte(state.PrivateKeyEncoded.ValueString()))
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.KeyParse(keyPath, diagnostics.ValidatorKey, err))
+			return
+		}
+		pub := (*btcec.PublicKey)(&key.PublicKey)
+		if !state.Address.IsNull() {
+			derived = crypto.PubKeyToAddress(&key.PublicKey).String()
+			valid = strings.EqualFold(derived, expected)
+			break
+		}
+
+		raw, _ := hex.DecodeHex(expected)
+		expectedPub, err := btcec.ParsePubKey(raw, btcec.S256())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(expectedPath, "Invalid public key", fmt.Sprintf("Unable to parse the ECDSA public key: %s.", err))
+			return
+		}
+		// Report the derived key in the same form as the expected one.
+		derived = hex.EncodeToHex(pub.SerializeCompressed())
+		if len(raw) == btcec.PubKeyBytesLenUncompressed {
+			derived = hex.EncodeToHex(pub.SerializeUncompressed())
+		}
+		valid = expectedPub.IsEqual(pub)
+	case keypairTypeBLS:
+		blsPubkey, diags := deriveBLSPubkey(encodedKey{value: []byte(state.PrivateKeyEncoded.ValueString()), attr: keyPath})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		derived = blsPubkey
+		valid = strings.EqualFold(derived, expected)
+	}
+
+	if !valid {
+		resp.Diagnostics.AddAttributeWarning(
+			expectedPath,
+			"Mismatched key pair",
+			fmt.Sprintf("The private key derives %s, which does not match the expected %s.", derived, expected),
+		)
+	}
+	state.Valid = types.BoolValue(valid)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
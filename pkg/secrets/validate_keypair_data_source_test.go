@@ -0,0 +1,142 @@
+package secrets
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	edgehex "github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testValidatorKey is an encoded validator key, the first Hardhat development account, with its address.
+const (
+	testValidatorKey     = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	testValidatorAddress = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+)
+
+// generateBLSKey returns a new encoded validator BLS key and its public key.
+func generateBLSKey(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := crypto.GenerateBLSKey()
+	if err != nil {
+		t.Fatalf("unable to generate BLS key: %v", err)
+	}
+	raw, err := key.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unable to marshal BLS key: %v", err)
+	}
+	pubkey, err := crypto.BLSSecretKeyToPubkeyBytes(key)
+	if err != nil {
+		t.Fatalf("unable to derive BLS public key: %v", err)
+	}
+	return hex.EncodeToString(raw), edgehex.EncodeToHex(pubkey)
+}
+
+func TestValidateKeypairDataSource(t *testing.T) {
+	blsKey, blsPubkey := generateBLSKey(t)
+	_, otherBLSPubkey := generateBLSKey(t)
+
+	tests := []struct {
+		name      string
+		attrs     map[string]string
+		wantValid bool
+	}{
+		{
+			name:      "ecdsa matching address",
+			attrs:     map[string]string{"type": "ecdsa", "private_key_encoded": testValidatorKey, "address": strings.ToLower(testValidatorAddress)},
+			wantValid: true,
+		},
+		{
+			name:      "ecdsa matching public key",
+			attrs:     map[string]string{"type": "ecdsa", "private_key_encoded": testValidatorKey, "public_key": "0x038318535b54105d4a7aae60c08fc45f9687181b4fdfc625bd1a753fa7397fed75"},
+			wantValid: true,
+		},
+		{
+			name:  "ecdsa mismatching address",
+			attrs: map[string]string{"type": "ecdsa", "private_key_encoded": testValidatorKey, "address": "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"},
+		},
+		{
+			name:      "bls matching public key",
+			attrs:     map[string]string{"type": "bls", "private_key_encoded": blsKey, "public_key": blsPubkey},
+			wantValid: true,
+		},
+		{
+			name:  "bls mismatching public key",
+			attrs: map[string]string{"type": "bls", "private_key_encoded": blsKey, "public_key": otherBLSPubkey},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := make(map[string]tftypes.Value, len(tt.attrs))
+			for name, value := range tt.attrs {
+				attrs[name] = tftypes.NewValue(tftypes.String, value)
+			}
+			state, diags := readDataSourceDiagnostics(t, NewValidateKeypairDataSource(), attrs)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+			var model validateKeypairDataSourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("unable to get state: %v", diags)
+			}
+			if model.Valid.ValueBool() != tt.wantValid {
+				t.Errorf("valid = %s, want %t", model.Valid, tt.wantValid)
+			}
+			if mismatched := diags.WarningsCount() > 0; mismatched == tt.wantValid {
+				t.Errorf("unexpected diagnostics %v, want a mismatch warning: %t", diags, !tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestValidateKeypairDataSourceValidateConfig(t *testing.T) {
+	blsKey, blsPubkey := generateBLSKey(t)
+	// secret is a key with a non hex character, which a decoding error would quote.
+	const secret = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ffg0"
+
+	tests := []struct {
+		name    string
+		attrs   map[string]string
+		wantErr bool
+	}{
+		{name: "ecdsa", attrs: map[string]string{"type": "ecdsa", "private_key_encoded": testValidatorKey, "address": testValidatorAddress}},
+		{name: "bls", attrs: map[string]string{"type": "bls", "private_key_encoded": blsKey, "public_key": blsPubkey}},
+		{name: "ecdsa invalid key", attrs: map[string]string{"type": "ecdsa", "private_key_encoded": secret, "address": testValidatorAddress}, wantErr: true},
+		{name: "bls invalid key", attrs: map[string]string{"type": "bls", "private_key_encoded": secret, "public_key": blsPubkey}, wantErr: true},
+		{name: "bls short key", attrs: map[string]string{"type": "bls", "private_key_encoded": blsKey[:62], "public_key": blsPubkey}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			d := NewValidateKeypairDataSource()
+			schemaResp := &datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, typ := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(typ, nil)
+			}
+			for name, value := range tt.attrs {
+				values[name] = tftypes.NewValue(tftypes.String, value)
+			}
+
+			resp := &datasource.ValidateConfigResponse{}
+			d.(datasource.DataSourceWithValidateConfig).ValidateConfig(ctx, datasource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("ValidateConfig() diagnostics = %v, want error %t", resp.Diagnostics, tt.wantErr)
+			}
+			for _, d := range resp.Diagnostics {
+				if strings.Contains(d.Detail(), tt.attrs["private_key_encoded"][:8]) || strings.Contains(d.Detail(), "'g'") {
+					t.Errorf("ValidateConfig() detail %q quotes the private key", d.Detail())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+)
+
+// defaultVanityMaxAttempts is the number of keys generated when searching for a vanity address, unless configured.
+// It finds a 4 character prefix with a high probability, every additional character needs 16 times more attempts.
+const defaultVanityMaxAttempts = 1_000_000
+
+// errVanityAttemptsExhausted is returned when no address matching the prefix is found within the attempt limit.
+var errVanityAttemptsExhausted = errors.New("attempt limit reached")
+
+// vanityValidatorKey generates validator keys until the derived address starts with the hex prefix,
+// in case-insensitive comparison. The keys are generated by one worker per CPU, sharing the attempt limit and
+// the entropy source. It returns the key encoded like polygon-edge encodes validator keys, and the number of attempts it took.
+func (g keyGenerator) vanityValidatorKey(ctx context.Context, prefix string, maxAttempts int64) ([]byte, int64, error) {
+	g = g.concurrent()
+	prefix = strings.ToLower(strings.TrimPrefix(prefix, "0x"))
+
+	parent := ctx
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	var (
+		attempts atomic.Int64
+		wg       sync.WaitGroup
+		once     sync.Once
+		found    []byte
+		foundErr error
+	)
+	finish := func(key []byte, err error) {
+		once.Do(func() {
+			found, foundErr = key, err
+			cancel()
+		})
+	}
+
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil && attempts.Add(1) <= maxAttempts {
+				key, encoded, err := g.validatorKey()
+				if err != nil {
+					finish(nil, err)
+					return
+				}
+				address := crypto.PubKeyToAddress(&key.PublicKey).String()
+				if !strings.HasPrefix(strings.ToLower(address[2:]), prefix) {
+					continue
+				}
+
+				finish(encoded, nil)
+				return
+			}
+		}()
+	}
+	wg.Wait()
+
+	total := min(attempts.Load(), maxAttempts)
+	if found != nil || foundErr != nil {
+		return found, total, foundErr
+	}
+	if err := parent.Err(); err != nil {
+		return nil, total, err
+	}
+	return nil, total, errVanityAttemptsExhausted
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/network/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/p2p/security/noise"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// defaultDialTimeout is the default timeout of a bootnode dial.
+const defaultDialTimeout = "10s"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &bootnodeReachableDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &bootnodeReachableDataSource{}
+)
+
+// bootnodeReachableDataSourceModel maps the data source schema data.
+type bootnodeReachableDataSourceModel struct {
+	Multiaddr types.String `tfsdk:"multiaddr"`
+	Timeout   types.String `tfsdk:"timeout"`
+
+	Reachable types.Bool   `tfsdk:"reachable"`
+	PeerID    types.String `tfsdk:"peer_id"`
+}
+
+// NewBootnodeReachableDataSource is a helper function to simplify the provider implementation.
+func NewBootnodeReachableDataSource() datasource.DataSource {
+	return &bootnodeReachableDataSource{}
+}
+
+// bootnodeReachableDataSource is the data source implementation.
+type bootnodeReachableDataSource struct{}
+
+// Metadata returns the data source type name.
+func (d *bootnodeReachableDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bootnode_reachable"
+}
+
+// Schema defines the schema for the data source.
+func (d *bootnodeReachableDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Dials a bootnode over libp2p, the same way polygon-edge nodes do, to catch a misconfigured or " +
+			"down bootnode before it is written into a genesis. An unreachable bootnode is not an error, " +
+			"`reachable` is false and the dial error is reported as a warning.",
+		Attributes: map[string]schema.Attribute{
+			"multiaddr": schema.StringAttribute{
+				Required:    true,
+				Description: "Bootnode multiaddr, including the `/p2p/` node ID, as in the genesis bootnodes.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout of the dial, as a Go duration. Defaults to `" + defaultDialTimeout + "`.",
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"reachable": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the bootnode was dialed and its identity verified.",
+			},
+			"peer_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Peer ID the bootnode authenticated with. Null if it is not reachable.",
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the multiaddr is a valid bootnode multiaddr.
+func (d *bootnodeReachableDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config bootnodeReachableDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Multiaddr.IsNull() || config.Multiaddr.IsUnknown() {
+		return
+	}
+	if _, err := common.StringToAddrInfo(config.Multiaddr.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("multiaddr"),
+			"Invalid bootnode multiaddr",
+			fmt.Sprintf("Unable to parse the bootnode multiaddr: %s.", err),
+		)
+	}
+}
+
+// Read dials the bootnode.
+func (d *bootnodeReachableDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state bootnodeReachableDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addrInfo, err := common.StringToAddrInfo(state.Multiaddr.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("multiaddr"),
+			"Invalid bootnode multiaddr",
+			fmt.Sprintf("Unable to parse the bootnode multiaddr: %s.", err),
+		)
+		return
+	}
+	timeoutValue := defaultDialTimeout
+	if !state.Timeout.IsNull() {
+		timeoutValue = state.Timeout.ValueString()
+	}
+	// The duration is validated by the schema.
+	timeout, _ := time.ParseDuration(timeoutValue)
+
+	// A throwaway host with a random identity, which only dials out, with the transport and security
+	// protocol of polygon-edge nodes.
+	host, err := libp2p.New(
+		libp2p.Security(noise.ID, noise.New),
+		libp2p.Transport(tcp.NewTCPTransport),
+		libp2p.NoListenAddrs,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create libp2p host", err.Error())
+		return
+	}
+	defer host.Close()
+
+	dialCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	state.Reachable = types.BoolValue(false)
+	state.PeerID = types.StringNull()
+	if err := host.Connect(dialCtx, *addrInfo); err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("multiaddr"),
+			"Bootnode unreachable",
+			fmt.Sprintf("Unable to dial the bootnode %s: %s.", state.Multiaddr.ValueString(), err),
+		)
+	} else if conns := host.Network().ConnsToPeer(addrInfo.ID); len(conns) > 0 {
+		state.Reachable = types.BoolValue(true)
+		state.PeerID = types.StringValue(conns[0].RemotePeer().String())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/p2p/security/noise"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+)
+
+// listenBootnode starts a libp2p host listening on a local port, with the transport and security protocol
+// of polygon-edge nodes.
+func listenBootnode(t *testing.T) host.Host {
+	t.Helper()
+	bootnode, err := libp2p.New(
+		libp2p.Security(noise.ID, noise.New),
+		libp2p.Transport(tcp.NewTCPTransport),
+		libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"),
+	)
+	if err != nil {
+		t.Fatalf("unable to start bootnode: %v", err)
+	}
+	t.Cleanup(func() { _ = bootnode.Close() })
+	return bootnode
+}
+
+func TestBootnodeReachableDataSource(t *testing.T) {
+	bootnode := listenBootnode(t)
+	multiaddr := bootnode.Addrs()[0].String() + "/p2p/" + bootnode.ID().String()
+
+	state, diags := readDataSourceDiagnostics(t, NewBootnodeReachableDataSource(), map[string]tftypes.Value{
+		"multiaddr": tftypes.NewValue(tftypes.String, multiaddr),
+		"timeout":   tftypes.NewValue(tftypes.String, "5s"),
+	})
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	var model bootnodeReachableDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if !model.Reachable.ValueBool() {
+		t.Errorf("the bootnode %s is unreachable", multiaddr)
+	}
+	if got, want := model.PeerID.ValueString(), bootnode.ID().String(); got != want {
+		t.Errorf("peer_id = %s, want %s", got, want)
+	}
+}
+
+func TestBootnodeReachableDataSourceUnreachable(t *testing.T) {
+	bootnode := listenBootnode(t)
+	other := listenBootnode(t)
+	closed := listenBootnode(t)
+	closedAddr := closed.Addrs()[0].String() + "/p2p/" + closed.ID().String()
+	_ = closed.Close()
+
+	tests := []struct {
+		name      string
+		multiaddr string
+	}{
+		{
+			// The bootnode authenticates with another identity than the multiaddr expects.
+			name:      "other peer id",
+			multiaddr: bootnode.Addrs()[0].String() + "/p2p/" + other.ID().String(),
+		},
+		{
+			name:      "closed port",
+			multiaddr: closedAddr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, diags := readDataSourceDiagnostics(t, NewBootnodeReachableDataSource(), map[string]tftypes.Value{
+				"multiaddr": tftypes.NewValue(tftypes.String, tt.multiaddr),
+				"timeout":   tftypes.NewValue(tftypes.String, "2s"),
+			})
+			if diags.HasError() {
+				t.Fatalf("an unreachable bootnode is an error: %v", diags)
+			}
+			var model bootnodeReachableDataSourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("unable to get state: %v", diags)
+			}
+			if model.Reachable.ValueBool() || !model.PeerID.IsNull() {
+				t.Errorf("reachable, peer_id = %s, %s, want false, null", model.Reachable, model.PeerID)
+			}
+			// The dial error is reported as a warning.
+			if diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), "Unable to dial the bootnode "+tt.multiaddr) {
+				t.Errorf("warnings = %v, want the dial error", diags.Warnings())
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/network/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// defaultLibp2pPort is the port of the default polygon-edge libp2p listen address.
+const defaultLibp2pPort = 1478
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &bootnodesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &bootnodesDataSource{}
+)
+
+// bootnodesDataSourceModel maps the data source schema data.
+type bootnodesDataSourceModel struct {
+	NodeID           types.String `tfsdk:"node_id"`
+	Addresses        types.List   `tfsdk:"addresses"`
+	Port             types.Int64  `tfsdk:"port"`
+	ListenAddress    types.String `tfsdk:"listen_address"`
+	AdvertiseAddress types.String `tfsdk:"advertise_address"`
+	AdvertisePort    types.Int64  `tfsdk:"advertise_port"`
+
+	Multiaddrs         types.List   `tfsdk:"multiaddrs"`
+	ListenMultiaddr    types.String `tfsdk:"listen_multiaddr"`
+	AdvertiseMultiaddr types.String `tfsdk:"advertise_multiaddr"`
+}
+
+// NewBootnodesDataSource is a helper function to simplify the provider implementation.
+func NewBootnodesDataSource() datasource.DataSource {
+	return &bootnodesDataSource{}
+}
+
+// bootnodesDataSource is the data source implementation.
+type bootnodesDataSource struct{}
+
+// Metadata returns the data source type name.
+func (d *bootnodesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bootnodes"
+}
+
+// Schema defines the schema for the data source.
+func (d *bootnodesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Computes the bootnode multiaddrs of a node advertised on several addresses, " +
+			"in the form polygon-edge expects in the genesis bootnodes, one per address. A node behind NAT or a load balancer " +
+			"can set its private `listen_address` and public `advertise_address` instead, or as well, to get both multiaddrs separately.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Node ID, the libp2p peer ID derived from the network key.",
+			},
+			"addresses": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IPv4 addresses, IPv6 addresses or DNS names the node is reachable on. " +
+					"At least one of `addresses`, `listen_address` and `advertise_address` must be set.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Port of the libp2p network interface. Defaults to `%d`.", defaultLibp2pPort),
+			},
+			"listen_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "IP address or DNS name the node listens on, such as its private address behind NAT.",
+			},
+			"advertise_address": schema.StringAttribute{
+				Optional: true,
+				Description: "Public IP address or DNS name other nodes reach the node on, such as the address of a NAT gateway " +
+					"or a load balancer, as set with `polygon-edge server --nat` or `--dns`.",
+			},
+			"advertise_port": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Public port forwarded to the libp2p network interface. Defaults to `port`.",
+			},
+			"multiaddrs": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Bootnode multiaddrs, in the order of `addresses`. DNS names use the `/dns/` protocol, " +
+					"which resolves both IPv4 and IPv6 addresses. Null if `addresses` is not set.",
+			},
+			"listen_multiaddr": schema.StringAttribute{
+				Computed:    true,
+				Description: "Multiaddr of the node on `listen_address` and `port`, for peers on the same private network. Null if `listen_address` is not set.",
+			},
+			"advertise_multiaddr": schema.StringAttribute{
+				Computed:    true,
+				Description: "Multiaddr of the node on `advertise_address` and `advertise_port`, for the genesis bootnodes. Null if `advertise_address` is not set.",
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the node ID, the ports and the addresses are valid.
+func (d *bootnodesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config bootnodesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.NodeID.IsNull() && !config.NodeID.IsUnknown() {
+		if _, err := peer.Decode(config.NodeID.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("node_id"), "Invalid node id", fmt.Sprintf("Unable to decode the node id: %s.", err))
+		}
+	}
+	for attr, port := range map[string]types.Int64{"port": config.Port, "advertise_port": config.AdvertisePort} {
+		if port.IsNull() || port.IsUnknown() {
+			continue
+		}
+		if value := port.ValueInt64(); value <= 0 || value > 65535 {
+			resp.Diagnostics.AddAttributeError(path.Root(attr), "Invalid port", fmt.Sprintf("The port must be between 1 and 65535, got: %d.", value))
+		}
+	}
+	for attr, address := range map[string]types.String{"listen_address": config.ListenAddress, "advertise_address": config.AdvertiseAddress} {
+		if address.IsNull() || address.IsUnknown() {
+			continue
+		}
+		if _, err := bootnodeTransport(address.ValueString(), defaultLibp2pPort); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Invalid address",
+				fmt.Sprintf("The address %q is %s.", address.ValueString(), err),
+			)
+		}
+	}
+
+	if config.Addresses.IsNull() && config.ListenAddress.IsNull() && config.AdvertiseAddress.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("addresses"),
+			"Missing addresses",
+			"At least one of `addresses`, `listen_address` and `advertise_address` must be set.",
+		)
+		return
+	}
+	if config.Addresses.IsNull() || config.Addresses.IsUnknown() {
+		return
+	}
+	if len(config.Addresses.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("addresses"), "Missing addresses", "At least one address must be set.")
+	}
+	for i, element := range config.Addresses.Elements() {
+		address, ok := element.(types.String)
+		if !ok || address.IsUnknown() {
+			continue
+		}
+		if _, err := bootnodeTransport(address.ValueString(), defaultLibp2pPort); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("addresses").AtListIndex(i),
+				"Invalid address",
+				fmt.Sprintf("The address %q is %s.", address.ValueString(), err),
+			)
+		}
+	}
+}
+
+// Read computes the bootnode multiaddrs.
+func (d *bootnodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state bootnodesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeID := state.NodeID.ValueString()
+	port := int64(defaultLibp2pPort)
+	if !state.Port.IsNull() {
+		port = state.Port.ValueInt64()
+	}
+	advertisePort := port
+	if !state.AdvertisePort.IsNull() {
+		advertisePort = state.AdvertisePort.ValueInt64()
+	}
+
+	state.Multiaddrs = types.ListNull(types.StringType)
+	if !state.Addresses.IsNull() {
+		var addresses []string
+		resp.Diagnostics.Append(state.Addresses.ElementsAs(ctx, &addresses, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		multiaddrs := make([]string, len(addresses))
+		for i, address := range addresses {
+			multiaddr, diags := nodeMultiaddr(path.Root("addresses").AtListIndex(i), address, port, nodeID)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			multiaddrs[i] = multiaddr
+		}
+
+		var diags diag.Diagnostics
+		state.Multiaddrs, diags = types.ListValueFrom(ctx, types.StringType, multiaddrs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state.ListenMultiaddr = types.StringNull()
+	if !state.ListenAddress.IsNull() {
+		multiaddr, diags := nodeMultiaddr(path.Root("listen_address"), state.ListenAddress.ValueString(), port, nodeID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.ListenMultiaddr = types.StringValue(multiaddr)
+	}
+	state.AdvertiseMultiaddr = types.StringNull()
+	if !state.AdvertiseAddress.IsNull() {
+		multiaddr, diags := nodeMultiaddr(path.Root("advertise_address"), state.AdvertiseAddress.ValueString(), advertisePort, nodeID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.AdvertiseMultiaddr = types.StringValue(multiaddr)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// nodeMultiaddr returns the multiaddr of the node on the IP address or DNS name, checked the same way
+// polygon-edge parses its bootnodes.
+func nodeMultiaddr(attrPath path.Path, address string, port int64, nodeID string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transport, err := bootnodeTransport(address, port)
+	if err != nil {
+		diags.AddAttributeError(attrPath, "Invalid address", fmt.Sprintf("The address %q is %s.", address, err))
+		return "", diags
+	}
+
+	multiaddr := transport + "/p2p/" + nodeID
+	if _, err := common.StringToAddrInfo(multiaddr); err != nil {
+		diags.AddAttributeError(attrPath, "Invalid bootnode multiaddr", fmt.Sprintf("The bootnode multiaddr %s is invalid: %s.", multiaddr, err))
+		return "", diags
+	}
+	return multiaddr, diags
+}
+
+// bootnodeTransport returns the TCP transport multiaddr of the IP address or DNS name.
+func bootnodeTransport(address string, port int64) (string, error) {
+	if ip := net.ParseIP(strings.TrimSuffix(strings.TrimPrefix(address, "["), "]")); ip != nil {
+		if ip.To4() != nil {
+			return fmt.Sprintf("/ip4/%s/tcp/%d", ip, port), nil
+		}
+		return fmt.Sprintf("/ip6/%s/tcp/%d", ip, port), nil
+	}
+
+	dnsAddr, err := common.MultiAddrFromDNS("/dns/"+address, int(port))
+	if err != nil {
+		return "", fmt.Errorf("neither an IP address nor a valid DNS name")
+	}
+	return dnsAddr.String(), nil
+}
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/network/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// testNodeID returns the node ID of a new network key.
+func testNodeID(t *testing.T) string {
+	t.Helper()
+	key, _, err := network.GenerateAndEncodeLibp2pKey()
+	if err != nil {
+		t.Fatalf("unable to generate network key: %v", err)
+	}
+	nodeID, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to derive node ID: %v", err)
+	}
+	return nodeID.String()
+}
+
+// addresses returns the list value of the addresses.
+func addresses(values ...string) tftypes.Value {
+	elements := make([]tftypes.Value, len(values))
+	for i, value := range values {
+		elements[i] = tftypes.NewValue(tftypes.String, value)
+	}
+	return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+}
+
+func TestBootnodesDataSource(t *testing.T) {
+	nodeID := testNodeID(t)
+	state := readDataSource(t, NewBootnodesDataSource(), map[string]tftypes.Value{
+		"node_id":   tftypes.NewValue(tftypes.String, nodeID),
+		"addresses": addresses("10.0.0.1", "[2001:db8::1]", "node-1.example.com", "fe80::1"),
+		"port":      tftypes.NewValue(tftypes.Number, 10001),
+	})
+	var model bootnodesDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	var multiaddrs []string
+	if diags := model.Multiaddrs.ElementsAs(context.Background(), &multiaddrs, false); diags.HasError() {
+		t.Fatalf("unable to read multiaddrs: %v", diags)
+	}
+
+	want := []string{
+		"/ip4/10.0.0.1/tcp/10001/p2p/" + nodeID,
+		"/ip6/2001:db8::1/tcp/10001/p2p/" + nodeID,
+		"/dns/node-1.example.com/tcp/10001/p2p/" + nodeID,
+		"/ip6/fe80::1/tcp/10001/p2p/" + nodeID,
+	}
+	if strings.Join(multiaddrs, ",") != strings.Join(want, ",") {
+		t.Fatalf("multiaddrs = %v, want %v", multiaddrs, want)
+	}
+	for _, multiaddr := range multiaddrs {
+		info, err := common.StringToAddrInfo(multiaddr)
+		if err != nil {
+			t.Errorf("polygon-edge cannot parse the bootnode %s: %v", multiaddr, err)
+			continue
+		}
+		if info.ID.String() != nodeID {
+			t.Errorf("bootnode %s has node ID %s, want %s", multiaddr, info.ID, nodeID)
+		}
+	}
+}
+
+func TestBootnodesDataSourceDefaultPort(t *testing.T) {
+	nodeID := testNodeID(t)
+	state := readDataSource(t, NewBootnodesDataSource(), map[string]tftypes.Value{
+		"node_id":   tftypes.NewValue(tftypes.String, nodeID),
+		"addresses": addresses("10.0.0.1"),
+	})
+	var model bootnodesDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if got, want := model.Multiaddrs.Elements()[0].String(), `"/ip4/10.0.0.1/tcp/1478/p2p/`+nodeID+`"`; got != want {
+		t.Errorf("multiaddr = %s, want %s", got, want)
+	}
+}
+
+func TestBootnodesDataSourceInvalidAddress(t *testing.T) {
+	ctx := context.Background()
+	d := NewBootnodesDataSource().(datasource.DataSourceWithValidateConfig)
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	for _, address := range []string{"10.0.0.256", "localhost", "-node.example.com", "node.example.com/p2p", ""} {
+		t.Run(address, func(t *testing.T) {
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, typ := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(typ, nil)
+			}
+			values["node_id"] = tftypes.NewValue(tftypes.String, testNodeID(t))
+			values["addresses"] = addresses("10.0.0.1", address)
+
+			resp := &datasource.ValidateConfigResponse{}
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("the address %q passed validation", address)
+			}
+			if got := resp.Diagnostics.Errors()[0].Summary(); got != "Invalid address" {
+				t.Errorf("got %q, want the invalid address error", got)
+			}
+		})
+	}
+}
+
+func TestBootnodesDataSourceListenAndAdvertise(t *testing.T) {
+	nodeID := testNodeID(t)
+	tests := []struct {
+		name          string
+		attrs         map[string]tftypes.Value
+		wantListen    string
+		wantAdvertise string
+	}{
+		{
+			name: "behind nat",
+			attrs: map[string]tftypes.Value{
+				"listen_address":    tftypes.NewValue(tftypes.String, "10.0.0.1"),
+				"advertise_address": tftypes.NewValue(tftypes.String, "203.0.113.7"),
+				"port":              tftypes.NewValue(tftypes.Number, 10001),
+				"advertise_port":    tftypes.NewValue(tftypes.Number, 30001),
+			},
+			wantListen:    "/ip4/10.0.0.1/tcp/10001/p2p/" + nodeID,
+			wantAdvertise: "/ip4/203.0.113.7/tcp/30001/p2p/" + nodeID,
+		},
+		{
+			name: "advertised on the listen port",
+			attrs: map[string]tftypes.Value{
+				"listen_address":    tftypes.NewValue(tftypes.String, "10.0.0.1"),
+				"advertise_address": tftypes.NewValue(tftypes.String, "node-1.example.com"),
+			},
+			wantListen:    "/ip4/10.0.0.1/tcp/1478/p2p/" + nodeID,
+			wantAdvertise: "/dns/node-1.example.com/tcp/1478/p2p/" + nodeID,
+		},
+		{
+			name: "advertise only",
+			attrs: map[string]tftypes.Value{
+				"advertise_address": tftypes.NewValue(tftypes.String, "203.0.113.7"),
+				"advertise_port":    tftypes.NewValue(tftypes.Number, 30001),
+			},
+			wantAdvertise: "/ip4/203.0.113.7/tcp/30001/p2p/" + nodeID,
+		},
+		{
+			name: "listen only",
+			attrs: map[string]tftypes.Value{
+				"listen_address": tftypes.NewValue(tftypes.String, "10.0.0.1"),
+			},
+			wantListen: "/ip4/10.0.0.1/tcp/1478/p2p/" + nodeID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.attrs["node_id"] = tftypes.NewValue(tftypes.String, nodeID)
+			state := readDataSource(t, NewBootnodesDataSource(), tt.attrs)
+			var model bootnodesDataSourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("unable to get state: %v", diags)
+			}
+
+			if !model.Multiaddrs.IsNull() {
+				t.Errorf("multiaddrs = %s, want null without addresses", model.Multiaddrs)
+			}
+			for _, output := range []struct {
+				attr string
+				got  types.String
+				want string
+			}{
+				{attr: "listen_multiaddr", got: model.ListenMultiaddr, want: tt.wantListen},
+				{attr: "advertise_multiaddr", got: model.AdvertiseMultiaddr, want: tt.wantAdvertise},
+			} {
+				if output.want == "" {
+					if !output.got.IsNull() {
+						t.Errorf("%s = %s, want null", output.attr, output.got)
+					}
+					continue
+				}
+				if output.got.ValueString() != output.want {
+					t.Errorf("%s = %s, want %s", output.attr, output.got, output.want)
+				}
+				if _, err := common.StringToAddrInfo(output.got.ValueString()); err != nil {
+					t.Errorf("polygon-edge cannot parse the %s %s: %v", output.attr, output.got, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command/server/config"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Default listen addresses of the polygon-edge server command flags, which a config file replaces.
+const (
+	defaultGRPCAddr    = "127.0.0.1:9632"
+	defaultJSONRPCAddr = "0.0.0.0:8545"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &serverConfigDataSource{}
+)
+
+// serverConfigDataSourceModel maps the data source schema data.
+type serverConfigDataSourceModel struct {
+	DataDir           types.String `tfsdk:"data_dir"`
+	GenesisPath       types.String `tfsdk:"genesis_path"`
+	SecretsConfigPath types.String `tfsdk:"secrets_config_path"`
+	GRPCAddr          types.String `tfsdk:"grpc_addr"`
+	JSONRPCAddr       types.String `tfsdk:"jsonrpc_addr"`
+	Libp2pAddr        types.String `tfsdk:"libp2p_addr"`
+	PrometheusAddr    types.String `tfsdk:"prometheus_addr"`
+	LogLevel          types.String `tfsdk:"log_level"`
+	Seal              types.Bool   `tfsdk:"seal"`
+
+	JSON types.String `tfsdk:"json"`
+	YAML types.String `tfsdk:"yaml"`
+}
+
+// NewServerConfigDataSource is a helper function to simplify the provider implementation.
+func NewServerConfigDataSource() datasource.DataSource {
+	return &serverConfigDataSource{}
+}
+
+// serverConfigDataSource is the data source implementation.
+type serverConfigDataSource struct{}
+
+// Metadata returns the data source type name.
+func (d *serverConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_config"
+}
+
+// Schema defines the schema for the data source.
+func (d *serverConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Renders a polygon-edge server configuration file, to run a node with `polygon-edge server --config`. " +
+			"polygon-edge reads JSON, YAML and HCL config files, so both the JSON and YAML forms are rendered. " +
+			"Settings that are not set keep the polygon-edge defaults.",
+		Attributes: map[string]schema.Attribute{
+			"data_dir": schema.StringAttribute{
+				Required:    true,
+				Description: "Data directory of the node.",
+			},
+			"genesis_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the genesis file. Defaults to `./genesis.json`.",
+			},
+			"secrets_config_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the secrets manager configuration. The local secrets manager in the data directory is used if not set.",
+			},
+			"grpc_addr": schema.StringAttribute{
+				Optional:    true,
+				Description: "Listen address of the GRPC interface. Defaults to `" + defaultGRPCAddr + "`.",
+				Validators: []validator.String{
+					validators.HostPort(),
+				},
+			},
+			"jsonrpc_addr": schema.StringAttribute{
+				Optional:    true,
+				Description: "Listen address of the JSON-RPC interface. Defaults to `" + defaultJSONRPCAddr + "`.",
+				Validators: []validator.String{
+					validators.HostPort(),
+				},
+			},
+			"libp2p_addr": schema.StringAttribute{
+				Optional:    true,
+				Description: "Listen address of the libp2p network interface. Defaults to `127.0.0.1:1478`.",
+				Validators: []validator.String{
+					validators.HostPort(),
+				},
+			},
+			"prometheus_addr": schema.StringAttribute{
+				Optional:    true,
+				Description: "Listen address of the prometheus metrics endpoint. Metrics are disabled if not set.",
+				Validators: []validator.String{
+					validators.HostPort(),
+				},
+			},
+			"log_level": schema.StringAttribute{
+				Optional:    true,
+				Description: "Log level, one of `TRACE`, `DEBUG`, `INFO`, `WARN` or `ERROR`. Defaults to `INFO`.",
+				Validators: []validator.String{
+					validators.LogLevel(),
+				},
+			},
+			"seal": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the node seals blocks. Defaults to `true`.",
+			},
+			"json": schema.StringAttribute{
+				Computed:    true,
+				Description: "Server configuration, JSON encoded. Save it with a `.json` extension.",
+			},
+			"yaml": schema.StringAttribute{
+				Computed:    true,
+				Description: "Server configuration, YAML encoded. Save it with a `.yaml` extension.",
+			},
+		},
+	}
+}
+
+// Read renders the server configuration.
+func (d *serverConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state serverConfigDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverConfig := config.DefaultConfig()
+	serverConfig.DataDir = state.DataDir.ValueString()
+	serverConfig.GRPCAddr = defaultGRPCAddr
+	serverConfig.JSONRPCAddr = defaultJSONRPCAddr
+	if !state.GenesisPath.IsNull() {
+		serverConfig.GenesisPath = state.GenesisPath.ValueString()
+	}
+	if !state.SecretsConfigPath.IsNull() {
+		serverConfig.SecretsConfigPath = state.SecretsConfigPath.ValueString()
+	}
+	if !state.GRPCAddr.IsNull() {
+		serverConfig.GRPCAddr = state.GRPCAddr.ValueString()
+	}
+	if !state.JSONRPCAddr.IsNull() {
+		serverConfig.JSONRPCAddr = state.JSONRPCAddr.ValueString()
+	}
+	if !state.Libp2pAddr.IsNull() {
+		serverConfig.Network.Libp2pAddr = state.Libp2pAddr.ValueString()
+	}
+	if !state.PrometheusAddr.IsNull() {
+		serverConfig.Telemetry.PrometheusAddr = state.PrometheusAddr.ValueString()
+	}
+	if !state.LogLevel.IsNull() {
+		serverConfig.LogLevel = strings.ToUpper(state.LogLevel.ValueString())
+	}
+	if !state.Seal.IsNull() {
+		serverConfig.ShouldSeal = state.Seal.ValueBool()
+	}
+
+	jsonConfig, err := json.MarshalIndent(serverConfig, "", "  ")
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to encode server config as JSON", err.Error())
+		return
+	}
+	yamlConfig, err := yaml.Marshal(serverConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to encode server config as YAML", err.Error())
+		return
+	}
+
+	// Decode both forms the same way polygon-edge reads its config file, so a config it cannot read is never rendered.
+	for format, decode := range map[string]func() (*config.Config, error){
+		"JSON": func() (*config.Config, error) { return readConfig(jsonConfig, json.Unmarshal) },
+		"YAML": func() (*config.Config, error) { return readConfig(yamlConfig, yaml.Unmarshal) },
+	} {
+		decoded, err := decode()
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to decode %s server config", format), err.Error())
+			return
+		}
+		if !reflect.DeepEqual(decoded, serverConfig) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Invalid %s server config", format),
+				"The rendered config does not decode to the configured values. Please report this issue to the provider developers.",
+			)
+			return
+		}
+	}
+
+	state.JSON = types.StringValue(string(jsonConfig))
+	state.YAML = types.StringValue(string(yamlConfig))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readConfig decodes a server config like polygon-edge config.ReadConfigFile does, without reading a file.
+func readConfig(data []byte, unmarshal func([]byte, interface{}) error) (*config.Config, error) {
+	serverConfig := config.DefaultConfig()
+	serverConfig.Network = new(config.Network)
+	serverConfig.Network.MaxPeers = -1
+	serverConfig.Network.MaxInboundPeers = -1
+	serverConfig.Network.MaxOutboundPeers = -1
+
+	if err := unmarshal(data, serverConfig); err != nil {
+		return nil, err
+	}
+
+	return serverConfig, nil
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/command/server/config"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// readDataSource reads the data source with the given attributes set in its configuration, the others null.
+func readDataSource(t *testing.T, d datasource.DataSource, attrs map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	state, diags := readDataSourceDiagnostics(t, d, attrs)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	return state
+}
+
+// readDataSourceDiagnostics reads the data source like readDataSource, and returns its diagnostics.
+func readDataSourceDiagnostics(t *testing.T, d datasource.DataSource, attrs map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, value := range attrs {
+		values[name] = value
+	}
+
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	return resp.State, resp.Diagnostics
+}
+
+func TestServerConfigDataSourceParsesBack(t *testing.T) {
+	state := readDataSource(t, NewServerConfigDataSource(), map[string]tftypes.Value{
+		"data_dir":            tftypes.NewValue(tftypes.String, "/var/lib/polygon-edge"),
+		"genesis_path":        tftypes.NewValue(tftypes.String, "/etc/polygon-edge/genesis.json"),
+		"secrets_config_path": tftypes.NewValue(tftypes.String, "/etc/polygon-edge/secrets.json"),
+		"grpc_addr":           tftypes.NewValue(tftypes.String, "127.0.0.1:10000"),
+		"jsonrpc_addr":        tftypes.NewValue(tftypes.String, "0.0.0.0:10002"),
+		"libp2p_addr":         tftypes.NewValue(tftypes.String, "0.0.0.0:10001"),
+		"prometheus_addr":     tftypes.NewValue(tftypes.String, "0.0.0.0:5001"),
+		"log_level":           tftypes.NewValue(tftypes.String, "debug"),
+		"seal":                tftypes.NewValue(tftypes.Bool, true),
+	})
+	var model serverConfigDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	// Parse both outputs back with the reader the polygon-edge server uses for its --config file.
+	dir := t.TempDir()
+	for file, content := range map[string]string{
+		"config.json": model.JSON.ValueString(),
+		"config.yaml": model.YAML.ValueString(),
+	} {
+		t.Run(file, func(t *testing.T) {
+			path := filepath.Join(dir, file)
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := config.ReadConfigFile(path)
+			if err != nil {
+				t.Fatalf("polygon-edge cannot read the config: %s", err)
+			}
+
+			for _, field := range []struct {
+				name      string
+				got, want interface{}
+			}{
+				{"data_dir", parsed.DataDir, "/var/lib/polygon-edge"},
+				{"genesis_path", parsed.GenesisPath, "/etc/polygon-edge/genesis.json"},
+				{"secrets_config_path", parsed.SecretsConfigPath, "/etc/polygon-edge/secrets.json"},
+				{"grpc_addr", parsed.GRPCAddr, "127.0.0.1:10000"},
+				{"jsonrpc_addr", parsed.JSONRPCAddr, "0.0.0.0:10002"},
+				{"libp2p_addr", parsed.Network.Libp2pAddr, "0.0.0.0:10001"},
+				{"prometheus_addr", parsed.Telemetry.PrometheusAddr, "0.0.0.0:5001"},
+				{"log_level", parsed.LogLevel, "DEBUG"},
+				{"seal", parsed.ShouldSeal, true},
+				{"max_peers", parsed.Network.MaxPeers, config.DefaultConfig().Network.MaxPeers},
+			} {
+				if field.got != field.want {
+					t.Errorf("%s is %v, want %v", field.name, field.got, field.want)
+				}
+			}
+		})
+	}
+}
+
+func TestServerConfigDataSourceDefaults(t *testing.T) {
+	state := readDataSource(t, NewServerConfigDataSource(), map[string]tftypes.Value{
+		"data_dir": tftypes.NewValue(tftypes.String, "data"),
+	})
+	var model serverConfigDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(model.YAML.ValueString()), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := config.ReadConfigFile(path)
+	if err != nil {
+		t.Fatalf("polygon-edge cannot read the config: %s", err)
+	}
+	if parsed.GRPCAddr != defaultGRPCAddr || parsed.JSONRPCAddr != defaultJSONRPCAddr {
+		t.Errorf("listen addresses are %s and %s, want the server flag defaults %s and %s",
+			parsed.GRPCAddr, parsed.JSONRPCAddr, defaultGRPCAddr, defaultJSONRPCAddr)
+	}
+}
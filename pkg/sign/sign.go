@@ -0,0 +1,62 @@
+package sign
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+)
+
+// personalMessagePrefix is prepended to messages before hashing, as defined by EIP-191.
+const personalMessagePrefix = "\x19Ethereum Signed Message:\n"
+
+// MessageHash returns the EIP-191 hash of a personal message.
+func MessageHash(message []byte) []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("%s%d", personalMessagePrefix, len(message))), message)
+}
+
+// Message signs the EIP-191 hash of a personal message, the same way `personal_sign` does.
+// The signature is returned as r || s || v, with v being 27 or 28.
+func Message(key *ecdsa.PrivateKey, message []byte) ([]byte, error) {
+	signature, err := crypto.Sign(key, MessageHash(message))
+	if err != nil {
+		return nil, err
+	}
+	signature[64] += 27
+
+	return signature, nil
+}
+
+// RecoverPubkey recovers the public key which produced the r || s || v signature of the hash.
+// v may be either 0 or 1, or 27 or 28 as returned by Message.
+func RecoverPubkey(hash, signature []byte) (*ecdsa.PublicKey, error) {
+	if len(hash) != 32 {
+		return nil, fmt.Errorf("hash must be 32 bytes, got %d", len(hash))
+	}
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("signature must be 65 bytes, got %d", len(signature))
+	}
+
+	normalized := append([]byte{}, signature...)
+	recoveryID, err := RecoveryID(normalized[64])
+	if err != nil {
+		return nil, err
+	}
+	normalized[64] = recoveryID
+
+	return crypto.RecoverPubkey(normalized, hash)
+}
+
+// RecoveryID returns the recovery id, 0 or 1, of the v value of a signature, which may be either 0 or 1,
+// or 27 or 28 as returned by Message.
+func RecoveryID(v byte) (byte, error) {
+	switch v {
+	case 0, 1:
+		return v, nil
+	case 27, 28:
+		return v - 27, nil
+	default:
+		return 0, errors.New("signature recovery id v must be 0, 1, 27 or 28")
+	}
+}
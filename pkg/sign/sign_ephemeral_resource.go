@@ -0,0 +1,130 @@
+package sign
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/diagnostics"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/providerdata"
+	"github.com/danielvladco/terraform-provider-polygon-edge/pkg/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &signEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &signEphemeralResource{}
+)
+
+// signEphemeralResourceModel maps the ephemeral resource schema data.
+type signEphemeralResourceModel struct {
+	ValidatorKeyEncoded types.String `tfsdk:"validator_key_encoded"`
+	Message             types.String `tfsdk:"message"`
+
+	Address     types.String `tfsdk:"address"`
+	MessageHash types.String `tfsdk:"message_hash"`
+	Signature   types.String `tfsdk:"signature"`
+}
+
+// NewSignEphemeralResource is a helper function to simplify the provider implementation.
+func NewSignEphemeralResource() ephemeral.EphemeralResource {
+	return &signEphemeralResource{
+		providerData: providerdata.Default(),
+	}
+}
+
+// signEphemeralResource is the ephemeral resource implementation.
+type signEphemeralResource struct {
+	providerData providerdata.Data
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *signEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sign"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *signEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Signs a message with a validator key, as an EIP-191 personal message. " +
+			"The signature is only available during the run and is never stored in state. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"validator_key_encoded": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Encoded validator key used to sign the message.",
+				Validators: []validator.String{
+					validators.ValidatorKey(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Required:    true,
+				Description: "Message to sign.",
+			},
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Address of the signer.",
+			},
+			"message_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "EIP-191 hash of the message that was signed, hex encoded.",
+			},
+			"signature": schema.StringAttribute{
+				Computed:    true,
+				Description: "Signature of the message, hex encoded as `r || s || v` with `v` being 27 or 28.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider data to the ephemeral resource.
+func (e *signEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	e.providerData = data
+}
+
+// Open signs the message.
+func (e *signEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data signEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := crypto.BytesToECDSAPrivateKey([]byte(data.ValidatorKeyEncoded.ValueString()))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.KeyParse(path.Root("validator_key_encoded"), diagnostics.ValidatorKey, err))
+		return
+	}
+
+	message := []byte(data.Message.ValueString())
+	signature, err := Message(key, message)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to sign message", err.Error())
+		return
+	}
+
+	data.Address = types.StringValue(e.providerData.FormatAddress(crypto.PubKeyToAddress(&key.PublicKey)))
+	data.MessageHash = types.StringValue(hex.EncodeToHex(MessageHash(message)))
+	data.Signature = types.StringValue(hex.EncodeToHex(signature))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,70 @@
+package sign
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSignEphemeralResource(t *testing.T) {
+	ctx := context.Background()
+	e := NewSignEphemeralResource()
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	e.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		// The first Hardhat development account.
+		"validator_key_encoded": tftypes.NewValue(tftypes.String, "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"),
+		"message":               tftypes.NewValue(tftypes.String, "enroll node-1"),
+		"address":               tftypes.NewValue(tftypes.String, nil),
+		"message_hash":          tftypes.NewValue(tftypes.String, nil),
+		"signature":             tftypes.NewValue(tftypes.String, nil),
+	})
+
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	e.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected open diagnostics: %v", resp.Diagnostics)
+	}
+
+	var result signEphemeralResourceModel
+	if diags := resp.Result.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("unable to get result: %v", diags)
+	}
+	if got, want := result.Address.ValueString(), "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"; got != want {
+		t.Errorf("address = %s, want %s", got, want)
+	}
+	if got, want := result.MessageHash.ValueString(), hex.EncodeToHex(MessageHash([]byte("enroll node-1"))); got != want {
+		t.Errorf("message_hash = %s, want %s", got, want)
+	}
+
+	signature, err := hex.DecodeHex(result.Signature.ValueString())
+	if err != nil {
+		t.Fatalf("signature is not hex: %v", err)
+	}
+	pubkey, err := RecoverPubkey(MessageHash([]byte("enroll node-1")), signature)
+	if err != nil {
+		t.Fatalf("unable to recover the signer: %v", err)
+	}
+	if got := crypto.PubKeyToAddress(pubkey).String(); got != result.Address.ValueString() {
+		t.Errorf("signature recovers %s, want %s", got, result.Address)
+	}
+}
+
+func TestSignEphemeralResourceKeepsNoData(t *testing.T) {
+	// The signature is only returned in the Open result, which Terraform never writes to state or plan.
+	// Renewing or closing would require keeping it in private data between calls.
+	e := NewSignEphemeralResource()
+	if _, ok := e.(ephemeral.EphemeralResourceWithRenew); ok {
+		t.Error("the sign ephemeral resource can be renewed")
+	}
+	if _, ok := e.(ephemeral.EphemeralResourceWithClose); ok {
+		t.Error("the sign ephemeral resource can be closed")
+	}
+}
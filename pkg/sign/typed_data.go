@@ -0,0 +1,371 @@
+package sign
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+)
+
+// domainType is the name of the EIP-712 domain type.
+const domainType = "EIP712Domain"
+
+// domainFieldTypes are the fields an EIP-712 domain may have, with their types.
+var domainFieldTypes = map[string]string{
+	"name":              "string",
+	"version":           "string",
+	"chainId":           "uint256",
+	"verifyingContract": "address",
+	"salt":              "bytes32",
+}
+
+var (
+	typeNameRegexp  = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+	arrayTypeRegexp = regexp.MustCompile(`^(.+)\[([0-9]*)\]$`)
+	sizedTypeRegexp = regexp.MustCompile(`^(uint|int|bytes)([1-9][0-9]*)$`)
+	addressRegexp   = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+)
+
+// TypedData is an EIP-712 typed data document, in the JSON form accepted by `eth_signTypedData_v4`.
+type TypedData struct {
+	Types       map[string][]TypedDataField `json:"types"`
+	PrimaryType string                      `json:"primaryType"`
+	Domain      map[string]interface{}      `json:"domain"`
+	Message     map[string]interface{}      `json:"message"`
+}
+
+// TypedDataField is a member of an EIP-712 struct type.
+type TypedDataField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// ParseTypedData decodes and validates an EIP-712 typed data JSON document.
+func ParseTypedData(document []byte) (*TypedData, error) {
+	var typedData TypedData
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&typedData); err != nil {
+		return nil, fmt.Errorf("unable to decode typed data: %w", err)
+	}
+	if err := typedData.validate(); err != nil {
+		return nil, err
+	}
+
+	return &typedData, nil
+}
+
+// validate checks the types are well formed and the domain only uses the fields EIP-712 defines.
+func (t *TypedData) validate() error {
+	domainFields, ok := t.Types[domainType]
+	if !ok {
+		return fmt.Errorf("the types must define %s", domainType)
+	}
+	for _, field := range domainFields {
+		if expected, ok := domainFieldTypes[field.Name]; !ok || field.Type != expected {
+			return fmt.Errorf("invalid %s field %q of type %q, the domain fields are name, version, chainId, verifyingContract and salt", domainType, field.Name, field.Type)
+		}
+	}
+	if t.PrimaryType == "" {
+		return fmt.Errorf("the primary type must be set")
+	}
+	if _, ok := t.Types[t.PrimaryType]; !ok {
+		return fmt.Errorf("the primary type %q is not defined", t.PrimaryType)
+	}
+
+	for name, fields := range t.Types {
+		if !typeNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid type name %q", name)
+		}
+		seen := make(map[string]bool, len(fields))
+		for _, field := range fields {
+			if !typeNameRegexp.MatchString(field.Name) || seen[field.Name] {
+				return fmt.Errorf("invalid or duplicate field name %q in type %s", field.Name, name)
+			}
+			seen[field.Name] = true
+			if err := t.validateFieldType(field.Type); err != nil {
+				return fmt.Errorf("field %s of type %s: %w", field.Name, name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateFieldType checks the type is an elementary type, a defined struct type or an array of them.
+func (t *TypedData) validateFieldType(typ string) error {
+	if match := arrayTypeRegexp.FindStringSubmatch(typ); match != nil {
+		if match[2] != "" {
+			if n, err := strconv.Atoi(match[2]); err != nil || n == 0 {
+				return fmt.Errorf("invalid array size %q", match[2])
+			}
+		}
+		return t.validateFieldType(match[1])
+	}
+	if _, ok := t.Types[typ]; ok {
+		return nil
+	}
+	if !elementaryType(typ) {
+		return fmt.Errorf("undefined type %q", typ)
+	}
+	return nil
+}
+
+// elementaryType reports whether the type is an EIP-712 atomic or dynamic type.
+func elementaryType(typ string) bool {
+	switch typ {
+	case "address", "bool", "bytes", "string":
+		return true
+	}
+
+	match := sizedTypeRegexp.FindStringSubmatch(typ)
+	if match == nil {
+		return false
+	}
+	size, _ := strconv.Atoi(match[2])
+	if match[1] == "bytes" {
+		return size <= 32
+	}
+	return size%8 == 0 && size <= 256
+}
+
+// Hash returns the EIP-712 digest of the typed data, the hash which is signed.
+func (t *TypedData) Hash() ([]byte, error) {
+	domainSeparator, err := t.hashStruct(domainType, t.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("domain: %w", err)
+	}
+	if t.PrimaryType == domainType {
+		return crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator), nil
+	}
+
+	messageHash, err := t.hashStruct(t.PrimaryType, t.Message)
+	if err != nil {
+		return nil, fmt.Errorf("message: %w", err)
+	}
+	return crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, messageHash), nil
+}
+
+// hashStruct returns the hash of the encoded struct, prefixed by its type hash.
+func (t *TypedData) hashStruct(typ string, data map[string]interface{}) ([]byte, error) {
+	fields := t.Types[typ]
+	for name := range data {
+		if !hasField(fields, name) {
+			return nil, fmt.Errorf("%s has no field %q", typ, name)
+		}
+	}
+
+	encoded := crypto.Keccak256([]byte(t.encodeType(typ)))
+	for _, field := range fields {
+		value, ok := data[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing %s field %q", typ, field.Name)
+		}
+		word, err := t.encodeValue(field.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s field %q: %w", typ, field.Name, err)
+		}
+		encoded = append(encoded, word...)
+	}
+
+	return crypto.Keccak256(encoded), nil
+}
+
+// hasField reports whether the struct type has a field of the given name.
+func hasField(fields []TypedDataField, name string) bool {
+	for _, field := range fields {
+		if field.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeType returns the type encoding, the type followed by the struct types it references in alphabetical order.
+func (t *TypedData) encodeType(typ string) string {
+	deps := map[string]bool{}
+	t.collectDependencies(typ, deps)
+	delete(deps, typ)
+
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var encoded strings.Builder
+	for _, name := range append([]string{typ}, names...) {
+		members := make([]string, len(t.Types[name]))
+		for i, field := range t.Types[name] {
+			members[i] = field.Type + " " + field.Name
+		}
+		encoded.WriteString(name + "(" + strings.Join(members, ",") + ")")
+	}
+	return encoded.String()
+}
+
+// collectDependencies adds the type and every struct type it references, directly or not, to deps.
+func (t *TypedData) collectDependencies(typ string, deps map[string]bool) {
+	typ = strings.SplitN(typ, "[", 2)[0]
+	if _, ok := t.Types[typ]; !ok || deps[typ] {
+		return
+	}
+	deps[typ] = true
+	for _, field := range t.Types[typ] {
+		t.collectDependencies(field.Type, deps)
+	}
+}
+
+// encodeValue returns the 32 byte encoding of a value of the given type.
+func (t *TypedData) encodeValue(typ string, value interface{}) ([]byte, error) {
+	if match := arrayTypeRegexp.FindStringSubmatch(typ); match != nil {
+		elements, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected an array")
+		}
+		if match[2] != "" {
+			if size, _ := strconv.Atoi(match[2]); len(elements) != size {
+				return nil, fmt.Errorf("expected %d elements, got %d", size, len(elements))
+			}
+		}
+		var encoded []byte
+		for i, element := range elements {
+			word, err := t.encodeValue(match[1], element)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			encoded = append(encoded, word...)
+		}
+		return crypto.Keccak256(encoded), nil
+	}
+
+	if _, ok := t.Types[typ]; ok {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected an object of type %s", typ)
+		}
+		return t.hashStruct(typ, data)
+	}
+
+	switch typ {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string")
+		}
+		return crypto.Keccak256([]byte(s)), nil
+	case "bytes":
+		b, err := hexValue(value)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.Keccak256(b), nil
+	case "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool")
+		}
+		word := make([]byte, 32)
+		if b {
+			word[31] = 1
+		}
+		return word, nil
+	case "address":
+		s, ok := value.(string)
+		if !ok || !addressRegexp.MatchString(s) {
+			return nil, fmt.Errorf("expected a 0x prefixed, 20 byte hex address")
+		}
+		b, _ := hex.DecodeHex(s)
+		return leftPad(b), nil
+	}
+
+	// Only sized types are left, the types are validated.
+	match := sizedTypeRegexp.FindStringSubmatch(typ)
+	size, _ := strconv.Atoi(match[2])
+	if match[1] == "bytes" {
+		b, err := hexValue(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != size {
+			return nil, fmt.Errorf("expected %d bytes, got %d", size, len(b))
+		}
+		return append(b, make([]byte, 32-len(b))...), nil
+	}
+	return encodeInteger(value, match[1] == "int", size)
+}
+
+// hexValue decodes a 0x prefixed hex string value.
+func hexValue(value interface{}) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok || !strings.HasPrefix(s, "0x") {
+		return nil, fmt.Errorf("expected 0x prefixed hex")
+	}
+	return hex.DecodeHex(s)
+}
+
+// encodeInteger returns the 32 byte two's complement encoding of an integer of the given size in bits.
+// Integers are JSON numbers or decimal or 0x prefixed hex strings.
+func encodeInteger(value interface{}, signed bool, bits int) ([]byte, error) {
+	var text string
+	switch v := value.(type) {
+	case json.Number:
+		text = v.String()
+	case string:
+		text = v
+	default:
+		return nil, fmt.Errorf("expected an integer")
+	}
+
+	n, ok := new(big.Int).SetString(text, 10)
+	if digits, isHex := strings.CutPrefix(text, "0x"); isHex {
+		n, ok = new(big.Int).SetString(digits, 16)
+	}
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", text)
+	}
+
+	min, max := big.NewInt(0), new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if signed {
+		max.Rsh(max, 1)
+		min.Neg(max)
+	}
+	if n.Cmp(min) < 0 || n.Cmp(max) >= 0 {
+		return nil, fmt.Errorf("integer %s out of range", text)
+	}
+
+	if n.Sign() < 0 {
+		n.Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	return leftPad(n.Bytes()), nil
+}
+
+// leftPad pads the bytes to a 32 byte word.
+func leftPad(b []byte) []byte {
+	return append(make([]byte, 32-len(b)), b...)
+}
+
+// TypedDataSignature signs the EIP-712 digest of the typed data, the same way `eth_signTypedData_v4` does.
+// The signature is returned as r || s || v, with v being 27 or 28.
+func TypedDataSignature(key *ecdsa.PrivateKey, typedData *TypedData) ([]byte, error) {
+	digest, err := typedData.Hash()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := crypto.Sign(key, digest)
+	if err != nil {
+		return nil, err
+	}
+	signature[64] += 27
+
+	return signature, nil
+}
@@ -0,0 +1,88 @@
+package sign
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+)
+
+// mailTypedData is the example of the EIP-712 specification.
+const mailTypedData = `{
+  "types": {
+    "EIP712Domain": [
+      {"name": "name", "type": "string"},
+      {"name": "version", "type": "string"},
+      {"name": "chainId", "type": "uint256"},
+      {"name": "verifyingContract", "type": "address"}
+    ],
+    "Person": [
+      {"name": "name", "type": "string"},
+      {"name": "wallet", "type": "address"}
+    ],
+    "Mail": [
+      {"name": "from", "type": "Person"},
+      {"name": "to", "type": "Person"},
+      {"name": "contents", "type": "string"}
+    ]
+  },
+  "primaryType": "Mail",
+  "domain": {
+    "name": "Ether Mail",
+    "version": "1",
+    "chainId": 1,
+    "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+  },
+  "message": {
+    "from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+    "to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+    "contents": "Hello, Bob!"
+  }
+}`
+
+func TestTypedDataMailVector(t *testing.T) {
+	typedData, err := ParseTypedData([]byte(mailTypedData))
+	if err != nil {
+		t.Fatalf("unable to parse typed data: %s", err)
+	}
+
+	digest, err := typedData.Hash()
+	if err != nil {
+		t.Fatalf("unable to hash typed data: %s", err)
+	}
+	if got, want := hex.EncodeToHex(digest), "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"; got != want {
+		t.Errorf("digest is %s, want %s", got, want)
+	}
+
+	// The key of the specification example is keccak256("cow").
+	key, err := crypto.ParseECDSAPrivateKey(crypto.Keccak256([]byte("cow")))
+	if err != nil {
+		t.Fatalf("unable to parse key: %s", err)
+	}
+	if got, want := crypto.PubKeyToAddress(&key.PublicKey).String(), "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"; got != want {
+		t.Fatalf("signer is %s, want %s", got, want)
+	}
+	signature, err := TypedDataSignature(key, typedData)
+	if err != nil {
+		t.Fatalf("unable to sign typed data: %s", err)
+	}
+	want := "0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d" +
+		"07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562" + "1c"
+	if got := hex.EncodeToHex(signature); got != want {
+		t.Errorf("signature is %s, want %s", got, want)
+	}
+}
+
+func TestParseTypedDataInvalid(t *testing.T) {
+	for name, document := range map[string]string{
+		"not json":             `{`,
+		"missing primary type": `{"types": {"EIP712Domain": []}, "domain": {}, "message": {}}`,
+		"unknown primary type": `{"types": {"EIP712Domain": []}, "primaryType": "Mail", "domain": {}, "message": {}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseTypedData([]byte(document)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package units
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// EtherDecimals is the power of ten of wei an ether is worth.
+const EtherDecimals = 18
+
+// Pow10 returns 10 to the power of n.
+func Pow10(n int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+}
+
+// FormatDecimal formats the integer divided by 10 to the power of decimals, without trailing zeros.
+func FormatDecimal(n *big.Int, decimals int64) string {
+	quotient, remainder := new(big.Int).QuoRem(n, Pow10(decimals), new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient.String()
+	}
+	fraction := fmt.Sprintf("%0*s", decimals, remainder.String())
+	return quotient.String() + "." + strings.TrimRight(fraction, "0")
+}
+
+// FormatEther formats an amount of wei as an exact decimal amount of ether.
+func FormatEther(wei *big.Int) string {
+	return FormatDecimal(wei, EtherDecimals)
+}
@@ -0,0 +1,48 @@
+package validators
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = addressValidator{}
+)
+
+var addressRegexp = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// addressValidator validates that a string is a 0x prefixed, 20 byte hex address.
+type addressValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v addressValidator) Description(_ context.Context) string {
+	return "value must be a 0x prefixed, 20 byte hex address"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v addressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks the configured value, unknown and null values are skipped.
+func (v addressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !addressRegexp.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid address",
+			"Attribute "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+// Address returns a validator which ensures the value is a hex encoded address.
+func Address() validator.String {
+	return addressValidator{}
+}
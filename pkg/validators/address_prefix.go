@@ -0,0 +1,48 @@
+package validators
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = addressPrefixValidator{}
+)
+
+var addressPrefixRegexp = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{1,40}$`)
+
+// addressPrefixValidator validates that a string is the hex prefix of an address, optionally 0x prefixed.
+type addressPrefixValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v addressPrefixValidator) Description(_ context.Context) string {
+	return "value must be 1 to 40 hex characters, optionally 0x prefixed"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v addressPrefixValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks the configured value, unknown and null values are skipped.
+func (v addressPrefixValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !addressPrefixRegexp.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid address prefix",
+			"Attribute "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+// AddressPrefix returns a validator which ensures the value is a hex address prefix.
+func AddressPrefix() validator.String {
+	return addressPrefixValidator{}
+}
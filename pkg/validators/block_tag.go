@@ -0,0 +1,48 @@
+package validators
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = blockTagValidator{}
+)
+
+var blockTagRegexp = regexp.MustCompile(`^(latest|pending|earliest|[0-9]+|0x[0-9a-fA-F]+)$`)
+
+// blockTagValidator validates that a string is a block tag or a block number.
+type blockTagValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v blockTagValidator) Description(_ context.Context) string {
+	return "value must be latest, pending, earliest, or a decimal or 0x prefixed hex block number"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v blockTagValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks the configured value, unknown and null values are skipped.
+func (v blockTagValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !blockTagRegexp.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid block tag",
+			"Attribute "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+// BlockTag returns a validator which ensures the value is a block tag or number.
+func BlockTag() validator.String {
+	return blockTagValidator{}
+}
@@ -0,0 +1,47 @@
+package validators
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = durationValidator{}
+)
+
+// durationValidator validates that a string is a positive Go duration.
+type durationValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration, such as 30s or 5m"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks the configured value, unknown and null values are skipped.
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			"Attribute "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+// Duration returns a validator which ensures the value is a positive Go duration.
+func Duration() validator.String {
+	return durationValidator{}
+}
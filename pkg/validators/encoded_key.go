@@ -0,0 +1,103 @@
+package validators
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = encodedKeyValidator{}
+)
+
+// encodedKeyValidator validates that a string can be decoded by one of the polygon-edge key parsers.
+type encodedKeyValidator struct {
+	keyType string
+	decode  func([]byte) error
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v encodedKeyValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a polygon-edge encoded %s", v.keyType)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v encodedKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString attempts to decode the configured value, unknown and null values are skipped.
+// The decoding error is not reported, as it can quote characters of the key.
+func (v encodedKeyValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := v.decode([]byte(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			fmt.Sprintf("Invalid encoded %s", v.keyType),
+			invalidKeyDetail(v.keyType, err),
+		)
+	}
+}
+
+// invalidKeyDetail describes why a key could not be decoded without quoting the decoding error.
+func invalidKeyDetail(keyType string, err error) string {
+	var invalidByte hex.InvalidByteError
+	if errors.As(err, &invalidByte) || errors.Is(err, hex.ErrLength) {
+		return fmt.Sprintf("The %s is not valid hex.", keyType)
+	}
+	return fmt.Sprintf("The %s does not decode to a %s as polygon-edge encodes it.", keyType, keyType)
+}
+
+// ValidatorKey returns a validator which ensures the value is a polygon-edge encoded ECDSA validator key.
+func ValidatorKey() validator.String {
+	return encodedKeyValidator{
+		keyType: "validator key",
+		decode: func(b []byte) error {
+			_, err := crypto.BytesToECDSAPrivateKey(b)
+			return err
+		},
+	}
+}
+
+// BLSKey returns a validator which ensures the value is a polygon-edge encoded BLS secret key.
+func BLSKey() validator.String {
+	return encodedKeyValidator{
+		keyType: "BLS key",
+		decode: func(b []byte) error {
+			_, err := crypto.BytesToBLSSecretKey(b)
+			return err
+		},
+	}
+}
+
+// PolyBFTBLSKey returns a validator which ensures the value is a polygon-edge encoded PolyBFT BLS private key.
+func PolyBFTBLSKey() validator.String {
+	return encodedKeyValidator{
+		keyType: "PolyBFT BLS key",
+		decode: func(b []byte) error {
+			_, err := bls.UnmarshalPrivateKey(b)
+			return err
+		},
+	}
+}
+
+// NetworkKey returns a validator which ensures the value is a polygon-edge encoded libp2p network key.
+func NetworkKey() validator.String {
+	return encodedKeyValidator{
+		keyType: "network key",
+		decode: func(b []byte) error {
+			_, err := network.ParseLibp2pKey(b)
+			return err
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEncodedKeyValidatorHidesKey(t *testing.T) {
+	// secret is a 32 byte key with a non hex character, which the hex decoding error would quote.
+	const secret = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ffg0"
+
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     string
+		want      string
+	}{
+		{name: "validator key", validator: ValidatorKey(), value: secret, want: "The validator key is not valid hex."},
+		{name: "BLS key", validator: BLSKey(), value: secret, want: "The BLS key is not valid hex."},
+		{name: "network key", validator: NetworkKey(), value: secret, want: "The network key is not valid hex."},
+		{name: "odd length", validator: ValidatorKey(), value: secret[:63], want: "The validator key is not valid hex."},
+		{name: "PolyBFT BLS key", validator: PolyBFTBLSKey(), value: secret, want: "The PolyBFT BLS key does not decode to a PolyBFT BLS key as polygon-edge encodes it."},
+		{name: "short key", validator: ValidatorKey(), value: secret[:62], want: "The validator key does not decode to a validator key as polygon-edge encodes it."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("key"),
+				ConfigValue: types.StringValue(tt.value),
+			}, resp)
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("ValidateString() diagnostics = %v, want one error", resp.Diagnostics)
+			}
+			detail := resp.Diagnostics.Errors()[0].Detail()
+			if detail != tt.want {
+				t.Errorf("ValidateString() detail = %q, want %q", detail, tt.want)
+			}
+			if strings.Contains(detail, "'g'") || strings.Contains(detail, tt.value[:8]) {
+				t.Errorf("ValidateString() detail %q quotes the key", detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package validators
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = hexValidator{}
+)
+
+var hexRegexp = regexp.MustCompile(`^0x([0-9a-fA-F]{2})*$`)
+
+// hexValidator validates that a string is 0x prefixed, hex encoded bytes.
+type hexValidator struct {
+	// sensitive hides the value from the diagnostic, for private keys.
+	sensitive bool
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v hexValidator) Description(_ context.Context) string {
+	return "value must be 0x prefixed, hex encoded bytes"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v hexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks the configured value, unknown and null values are skipped.
+func (v hexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !hexRegexp.MatchString(req.ConfigValue.ValueString()) {
+		detail := "Attribute " + v.Description(ctx) + ", got: " + req.ConfigValue.ValueString()
+		if v.sensitive {
+			detail = "Attribute " + v.Description(ctx) + "."
+		}
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid hex value", detail)
+	}
+}
+
+// Hex returns a validator which ensures the value is hex encoded bytes.
+func Hex() validator.String {
+	return hexValidator{}
+}
+
+// SensitiveHex returns a validator which ensures the value is hex encoded bytes, without quoting the value
+// in its diagnostic.
+func SensitiveHex() validator.String {
+	return hexValidator{sensitive: true}
+}
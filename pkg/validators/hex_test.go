@@ -0,0 +1,40 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSensitiveHexHidesValue(t *testing.T) {
+	// secret is a private key with a non hex character.
+	const secret = "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ffg0"
+
+	for name, v := range map[string]validator.String{"hex": Hex(), "sensitive hex": SensitiveHex()} {
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("key"),
+			ConfigValue: types.StringValue(secret),
+		}, resp)
+		if resp.Diagnostics.ErrorsCount() != 1 {
+			t.Fatalf("%s: ValidateString() diagnostics = %v, want one error", name, resp.Diagnostics)
+		}
+		quoted := strings.Contains(resp.Diagnostics.Errors()[0].Detail(), secret)
+		if sensitive := name == "sensitive hex"; quoted == sensitive {
+			t.Errorf("%s: ValidateString() detail %q, quoting the value: %t", name, resp.Diagnostics.Errors()[0].Detail(), quoted)
+		}
+	}
+
+	resp := &validator.StringResponse{}
+	SensitiveHex().ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("key"),
+		ConfigValue: types.StringValue(secret[:len(secret)-2] + "00"),
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("ValidateString() of valid hex returned %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,51 @@
+package validators
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = hostPortValidator{}
+)
+
+// hostPortValidator validates that a string is a listen address in the host:port form.
+type hostPortValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v hostPortValidator) Description(_ context.Context) string {
+	return "value must be an address in the host:port form, such as 0.0.0.0:8545"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v hostPortValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks the configured value, unknown and null values are skipped.
+func (v hostPortValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, port, err := net.SplitHostPort(req.ConfigValue.ValueString())
+	if err == nil {
+		_, err = strconv.ParseUint(port, 10, 16)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid address",
+			"Attribute "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+// HostPort returns a validator which ensures the value is an address in the host:port form.
+func HostPort() validator.String {
+	return hostPortValidator{}
+}
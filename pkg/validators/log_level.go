@@ -0,0 +1,52 @@
+package validators
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = logLevelValidator{}
+)
+
+// logLevels are the log levels accepted by polygon-edge.
+var logLevels = []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR"}
+
+// logLevelValidator validates that a string is a polygon-edge log level.
+type logLevelValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v logLevelValidator) Description(_ context.Context) string {
+	return "value must be one of " + strings.Join(logLevels, ", ")
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v logLevelValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks the configured value, unknown and null values are skipped.
+func (v logLevelValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, level := range logLevels {
+		if strings.EqualFold(level, req.ConfigValue.ValueString()) {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid log level",
+		"Attribute "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+	)
+}
+
+// LogLevel returns a validator which ensures the value is a polygon-edge log level.
+func LogLevel() validator.String {
+	return logLevelValidator{}
+}
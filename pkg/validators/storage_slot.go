@@ -0,0 +1,62 @@
+package validators
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = storageSlotValidator{}
+)
+
+// maxStorageSlot is the largest storage slot, slots are 256 bit integers.
+var maxStorageSlot = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// storageSlotValidator validates that a string is a decimal or 0x prefixed hex storage slot.
+type storageSlotValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v storageSlotValidator) Description(_ context.Context) string {
+	return "value must be a storage slot, a decimal or 0x prefixed hex integer between 0 and 2^256-1"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v storageSlotValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks the configured value, unknown and null values are skipped.
+func (v storageSlotValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, ok := ParseStorageSlot(req.ConfigValue.ValueString()); !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid storage slot",
+			"Attribute "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+// StorageSlot returns a validator which ensures the value is a storage slot.
+func StorageSlot() validator.String {
+	return storageSlotValidator{}
+}
+
+// ParseStorageSlot parses a decimal or 0x prefixed hex storage slot, reporting whether it is in range.
+func ParseStorageSlot(s string) (*big.Int, bool) {
+	slot, ok := new(big.Int).SetString(s, 10)
+	if digits, isHex := strings.CutPrefix(s, "0x"); isHex {
+		slot, ok = new(big.Int).SetString(digits, 16)
+	}
+	if !ok || slot.Sign() < 0 || slot.Cmp(maxStorageSlot) > 0 {
+		return nil, false
+	}
+	return slot, true
+}
@@ -0,0 +1,40 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStorageSlot(t *testing.T) {
+	tests := []struct {
+		slot    string
+		wantErr bool
+	}{
+		{slot: "0"},
+		{slot: "42"},
+		{slot: "0x2a"},
+		{slot: "0x" + strings.Repeat("f", 64)},
+		{slot: "0x1" + strings.Repeat("0", 64), wantErr: true},
+		{slot: "-1", wantErr: true},
+		{slot: "0x", wantErr: true},
+		{slot: "0xzz", wantErr: true},
+		{slot: "slot", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.slot, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			StorageSlot().ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("slot"),
+				ConfigValue: types.StringValue(tt.slot),
+			}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateString() diagnostics = %v, want error %t", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package validators
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = weiValidator{}
+)
+
+// weiValidator validates that a string is a non-negative, base 10 amount of wei.
+type weiValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v weiValidator) Description(_ context.Context) string {
+	return "value must be a non-negative integer amount of wei"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v weiValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks the configured value, unknown and null values are skipped.
+func (v weiValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, ok := ParseWei(req.ConfigValue.ValueString()); !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid amount",
+			"Attribute "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+// Wei returns a validator which ensures the value is an amount of wei.
+func Wei() validator.String {
+	return weiValidator{}
+}
+
+// ParseWei parses a base 10 amount of wei, reporting whether it is a valid non-negative integer.
+func ParseWei(s string) (*big.Int, bool) {
+	amount, ok := new(big.Int).SetString(s, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, false
+	}
+	return amount, true
+}